@@ -3,90 +3,1729 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"flag"
+	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/getoutreach/gobox/pkg/events"
 	"github.com/getoutreach/gobox/pkg/log"
+	"github.com/getoutreach/lintroller/docs"
+	"github.com/getoutreach/lintroller/internal/commentedcode"
+	"github.com/getoutreach/lintroller/internal/commentrules"
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/companion"
+	"github.com/getoutreach/lintroller/internal/compliance"
 	"github.com/getoutreach/lintroller/internal/config"
 	"github.com/getoutreach/lintroller/internal/copyright"
+	"github.com/getoutreach/lintroller/internal/ctxstruct"
 	"github.com/getoutreach/lintroller/internal/doculint"
+	"github.com/getoutreach/lintroller/internal/driver"
+	"github.com/getoutreach/lintroller/internal/dupstring"
+	"github.com/getoutreach/lintroller/internal/errorlint"
+	"github.com/getoutreach/lintroller/internal/format"
+	"github.com/getoutreach/lintroller/internal/gogenerate"
+	"github.com/getoutreach/lintroller/internal/gomod"
 	"github.com/getoutreach/lintroller/internal/header"
+	"github.com/getoutreach/lintroller/internal/inventory"
+	"github.com/getoutreach/lintroller/internal/license"
+	"github.com/getoutreach/lintroller/internal/linklint"
+	"github.com/getoutreach/lintroller/internal/logging"
+	"github.com/getoutreach/lintroller/internal/magicnumber"
+	"github.com/getoutreach/lintroller/internal/metricname"
+	"github.com/getoutreach/lintroller/internal/newnolint"
+	"github.com/getoutreach/lintroller/internal/noprint"
+	"github.com/getoutreach/lintroller/internal/receiver"
+	"github.com/getoutreach/lintroller/internal/reflectunsafe"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"github.com/getoutreach/lintroller/internal/signature"
+	"github.com/getoutreach/lintroller/internal/thinmain"
 	"github.com/getoutreach/lintroller/internal/todo"
+	"github.com/getoutreach/lintroller/internal/tracing"
+	"github.com/getoutreach/lintroller/internal/trend"
+	"github.com/getoutreach/lintroller/internal/upload"
 	"github.com/getoutreach/lintroller/internal/why"
+	"github.com/getoutreach/lintroller/pkg/linttest"
+	"github.com/pkg/errors"
 	"golang.org/x/tools/go/analysis"
-	"golang.org/x/tools/go/analysis/multichecker"
 	"golang.org/x/tools/go/analysis/unitchecker"
 )
 
 func main() {
-	const configHelp = "the path to the config file for lintroller. " +
-		"If this is not set it will be assumed lintroller is running as a vet tool."
+	// lint is the explicit form of linting the given targets, which takes the same flags and
+	// never runs lintroller as a vet tool, so that scripts don't depend on how the arguments
+	// are told apart from the .cfg file of go vet.
+	var lint bool
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lint":
+			lint = true
+			os.Args = append(os.Args[:1:1], os.Args[2:]...)
+		case "tier-report":
+			os.Exit(tierReport(os.Args[2:]))
+		case "badge":
+			os.Exit(badge(os.Args[2:]))
+		case "inventory":
+			os.Exit(inventorySuppressions(os.Args[2:]))
+		case "trend":
+			os.Exit(trendHistory(os.Args[2:]))
+		case "config":
+			os.Exit(configCommand(os.Args[2:]))
+		case "file":
+			os.Exit(lintFiles(os.Args[2:]))
+		case "problem-matcher":
+			os.Exit(problemMatcher(os.Args[2:]))
+		case "selftest":
+			os.Exit(selftest(os.Args[2:]))
+		case "explain":
+			os.Exit(explain(os.Args[2:]))
+		case "docs":
+			os.Exit(docsCommand(os.Args[2:]))
+		}
+	}
+
+	const configHelp = "the path to the config file for lintroller. If this is not set the packages given as " +
+		"arguments are analyzed with the options given by flags, or lintroller runs as a vet tool when given the " +
+		".cfg file of go vet."
 	const quietHelp = "if set, emit log statements outside of linting results. " +
 		"Only applies when config is given."
+	const summaryHelp = "if set, print a summary of the issues reported by each linter at the end of the run. " +
+		"Doesn't apply when ran as a vet tool."
+	const evaluateTierHelp = "if set, run with the minimums of the given tier instead of the configured one and " +
+		"print a JSON verdict of whether or not each of its requirements passed, always exiting zero. " +
+		"Only applies when config is given."
+	const minTierHelp = "if set, fail the run when the configuration doesn't declare a tier at least as strict as the " +
+		"given tier, e.g. silver, both at the top-level and for each package tier, regardless of the issues found. " +
+		"Only applies when config is given."
+	const forbidNewNoLintHelp = "if set, fail the run when nolint directives for the linters of forbidNewNolint were " +
+		"added since base without an approved entry added to the suppressions file along with each of them. " +
+		"Only applies when config is given."
+	const profileHelp = "if set, adjust the configuration with the profile of the given name, e.g. ci. " +
+		"Only applies when config is given."
+	const testHelp = "indicates whether test files should be analyzed, too. Doesn't apply when ran as a vet tool."
+	const jsonHelp = "emit JSON output, the same as -format json. Doesn't apply when ran as a vet tool."
+	const fixHelp = "apply all suggested fixes. Doesn't apply when ran as a vet tool."
+	const tagsHelp = "a comma-separated list of build tags to load the packages with, in addition to the tags " +
+		"of the config file. Doesn't apply when ran as a vet tool, give -tags to go vet instead."
+	const contextHelp = "display offending line with this many lines of context, only applies to the text format. " +
+		"Doesn't apply when ran as a vet tool."
+	const noColorHelp = "don't color the text format, which is otherwise colored when stderr is a terminal and the " +
+		"NO_COLOR environment variable isn't set. Doesn't apply when ran as a vet tool."
+	const stableHelp = "write every issue at once after the run, sorted by file, line, column, and rule, even in " +
+		"streaming formats, so that identical runs write identical output, e.g. to compare it to golden files. " +
+		"Doesn't apply when ran as a vet tool."
+	const stdinHelp = "lint the content of a single Go file read from stdin instead of the file on disk, e.g. the " +
+		"unsaved buffer of an editor, reporting only its issues. Its package is loaded from the directory of " +
+		"-stdin-filename. Only applies to lintroller lint."
+	const stdinFilenameHelp = "the path of the Go file whose content is read from stdin when -stdin is set. " +
+		"The file doesn't need to exist, but its directory does."
+	formatHelp := fmt.Sprintf("the format to write issues in, one of: %s. Text is written to stderr, every other "+
+		"format to stdout. Doesn't apply when ran as a vet tool.", strings.Join(format.Names(), ", "))
 	// This needs to be set so that when the analyzers parse their flags they won't error due to
-	// an unknown flag being passed.
+	// an unknown flag being passed. The -json, -c, -tags, and (in newer versions) -fix flags are
+	// already defined by unitchecker.
 	_ = flag.String("config", "", configHelp)
 	_ = flag.Bool("quiet", true, quietHelp)
+	_ = flag.Bool("summary", true, summaryHelp)
+	_ = flag.String("evaluate-tier", "", evaluateTierHelp)
+	_ = flag.String("min-tier", "", minTierHelp)
+	_ = flag.String("profile", "", profileHelp)
+	_ = flag.Bool("forbid-new-nolint", false, forbidNewNoLintHelp)
+	_ = flag.String("format", format.Text, formatHelp)
+	_ = flag.Bool("test", true, testHelp)
+	_ = flag.Bool("no-color", false, noColorHelp)
+	_ = flag.Bool("stable", false, stableHelp)
+	_ = flag.Bool("stdin", false, stdinHelp)
+	_ = flag.String("stdin-filename", "", stdinFilenameHelp)
 
 	mainFs := flag.NewFlagSet("lintroller", flag.ContinueOnError)
+	mainFs.SetOutput(io.Discard)
 
-	var configPath string
-	var quiet bool
+	var configPath, evaluateTier, minTier, profile, formatName, stdinFilename string
+	var quiet, summary, jsonOutput, forbidNewNoLint, stdin bool
 
 	mainFs.StringVar(&configPath, "config", "", configHelp)
 	mainFs.BoolVar(&quiet, "quiet", true, quietHelp)
+	mainFs.BoolVar(&summary, "summary", true, summaryHelp)
+	mainFs.StringVar(&evaluateTier, "evaluate-tier", "", evaluateTierHelp)
+	mainFs.StringVar(&minTier, "min-tier", "", minTierHelp)
+	mainFs.StringVar(&profile, "profile", "", profileHelp)
+	mainFs.BoolVar(&forbidNewNoLint, "forbid-new-nolint", false, forbidNewNoLintHelp)
+	mainFs.StringVar(&formatName, "format", format.Text, formatHelp)
+	mainFs.BoolVar(&driverFlags.tests, "test", true, testHelp)
+	mainFs.BoolVar(&driverFlags.fix, "fix", false, fixHelp)
+	mainFs.StringVar(&driverFlags.tags, "tags", "", tagsHelp)
+	mainFs.BoolVar(&jsonOutput, "json", false, jsonHelp)
+	mainFs.IntVar(&driverFlags.contextLines, "c", -1, contextHelp)
+	mainFs.BoolVar(&driverFlags.noColor, "no-color", false, noColorHelp)
+	mainFs.BoolVar(&driverFlags.stable, "stable", false, stableHelp)
+	mainFs.BoolVar(&stdin, "stdin", false, stdinHelp)
+	mainFs.StringVar(&stdinFilename, "stdin-filename", "", stdinFilenameHelp)
+	registerAnalyzerFlags(mainFs, vetAnalyzers)
 
-	_ = mainFs.Parse(os.Args[1:]) //nolint:errcheck // Why: There is no need to check this error.
+	// When ran as a vet tool the flags are parsed by unitchecker instead, which defines flags
+	// this flag set doesn't, so errors only matter when a config file was given.
+	parseErr := mainFs.Parse(os.Args[1:])
+	if parseErr != nil && configPath != "" {
+		fmt.Fprintf(os.Stderr, "lintroller: %v\n", parseErr)
+		os.Exit(driver.ExitFailure)
+	}
 
-	if configPath != "" {
+	if jsonOutput {
+		formatName = format.JSON
+	}
+
+	// The content of a single file may be given on stdin, which replaces the file on disk
+	// within its package and is the only file issues are reported in.
+	var stdinFile string
+	if stdin && parseErr == nil {
+		var err error
+		if stdinFile, err = readStdin(lint, stdinFilename, mainFs.Args()); err != nil {
+			fmt.Fprintf(os.Stderr, "stdin: %v\n", err)
+			os.Exit(driver.ExitFailure)
+		}
+		common.SetChangedFiles([]string{stdinFile})
+	}
+
+	// Without a config file, linting the given targets is configured by the default
+	// organization config embedded into the binary, if there is one.
+	_, hasOrgConfig := config.OrgConfig()
+	useOrgConfig := configPath == "" && hasOrgConfig && parseErr == nil && (lint || isPatterns(mainFs.Args()))
+
+	if configPath != "" || useOrgConfig {
 		if quiet {
 			log.SetOutput(io.Discard)
 		}
 
-		cfg, err := config.FromFile(configPath)
+		patterns := mainFs.Args()
+		if len(patterns) == 0 {
+			patterns = []string{"./..."}
+		}
+		if stdinFile != "" {
+			patterns = []string{"file=" + stdinFile}
+		}
+
+		if evaluateTier != "" {
+			os.Exit(evaluate(configPath, evaluateTier, patterns, summary))
+		}
+
+		cfg, err := config.FromFileWithProfile(configPath, profile)
 		if err != nil {
 			log.Fatal(context.Background(), "retrieve config from file", events.NewErrorInfo(err))
 		}
 
 		log.Info(context.Background(), "config gathered from file", cfg, log.F{
-			"path": configPath,
+			"path":      configPath,
+			"profile":   profile,
+			"orgConfig": hasOrgConfig,
 		})
 
-		table := []struct {
-			Enabled  bool
-			Analyzer *analysis.Analyzer
-		}{
-			{cfg.Header.Enabled, header.NewAnalyzerWithOptions(strings.Join(cfg.Header.Fields, ","))},
-			{cfg.Copyright.Enabled, copyright.NewAnalyzerWithOptions(cfg.Copyright.Text, cfg.Copyright.Pattern)},
-			{cfg.Doculint.Enabled, doculint.NewAnalyzerWithOptions(cfg.Doculint.MinFunLen,
-				cfg.Doculint.ValidatePackages, cfg.Doculint.ValidateFunctions, cfg.Doculint.ValidateVariables,
-				cfg.Doculint.ValidateConstants, cfg.Doculint.ValidateTypes)},
-			{cfg.Todo.Enabled, &todo.Analyzer},
-			{cfg.Why.Enabled, &why.Analyzer},
-		}
-
-		var analyzers []*analysis.Analyzer
-		for i := range table {
-			if table[i].Enabled {
-				analyzers = append(analyzers, table[i].Analyzer)
+		// The file given on stdin is the scope, so that the changed files aren't looked up.
+		if stdinFile != "" {
+			cfg.Scope = config.ScopeFull
+		}
+
+		if minTier != "" {
+			if err := cfg.Lintroller.ValidateMinTier(minTier); err != nil {
+				fmt.Fprintf(os.Stderr, "min-tier: %v\n", err)
+				os.Exit(driver.ExitFailure)
+			}
+		}
+
+		var violations []newnolint.Violation
+		if forbidNewNoLint {
+			if violations, err = newNoLints(cfg); err != nil {
+				fmt.Fprintf(os.Stderr, "forbid-new-nolint: %v\n", err)
+				os.Exit(driver.ExitFailure)
 			}
 		}
 
-		if len(analyzers) > 0 {
-			multichecker.Main(analyzers...)
+		exitCode := run(cfg, patterns, summary, formatName)
+
+		// New nolint directives are reported after the issues, and fail the run like them.
+		for i := range violations {
+			fmt.Fprintf(os.Stderr, "forbid-new-nolint: %s\n", violations[i].String())
+		}
+		if len(violations) > 0 && exitCode == driver.ExitOK {
+			exitCode = driver.ExitDiagnostics
+		}
+		os.Exit(exitCode)
+	}
+
+	if lint {
+		if parseErr != nil {
+			fmt.Fprintf(os.Stderr, "lint: %v\n", parseErr)
+			os.Exit(driver.ExitFailure)
+		}
+
+		patterns := mainFs.Args()
+		if len(patterns) == 0 {
+			patterns = []string{"./..."}
+		}
+		if stdinFile != "" {
+			patterns = []string{"file=" + stdinFile}
+		}
+		os.Exit(standalone(patterns, summary, formatName))
+	}
+
+	// go vet gives a vet tool a single .cfg file describing the package to analyze, anything
+	// else is a list of package patterns to load and analyze directly.
+	if parseErr == nil && isPatterns(mainFs.Args()) {
+		os.Exit(standalone(mainFs.Args(), summary, formatName))
+	}
+
+	// go vet runs a vet tool once per package, each of which is a run of its own.
+	reporter.SetDedupe(reporter.NewDedupe())
+	unitchecker.Main(vetAnalyzers...)
+}
+
+// isPatterns reports whether or not the given arguments are package patterns, e.g.
+// ./..., rather than the .cfg file go vet gives to vet tools.
+func isPatterns(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	for _, arg := range args {
+		if strings.HasSuffix(arg, ".cfg") {
+			return false
 		}
-		return
 	}
 
-	unitchecker.Main(
-		&doculint.Analyzer,
-		&header.Analyzer,
-		&copyright.Analyzer,
-		&todo.Analyzer,
-		&why.Analyzer,
-	)
+	return true
+}
+
+// standalone loads and analyzes the packages matching the given patterns without a config
+// file, running every analyzer with the options given by flags like it would as a vet tool.
+func standalone(patterns []string, summary bool, formatName string) int {
+	formatter, err := newFormatter(formatName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "format: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	if err := setOwners(); err != nil {
+		fmt.Fprintf(os.Stderr, "codeowners: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	groups := []driver.Group{{
+		Analyzers: func() []*analysis.Analyzer {
+			return vetAnalyzers
+		},
+	}}
+
+	return driver.Run(patterns, groups, &driver.Options{
+		Formatter:     formatter,
+		Summary:       summary,
+		SummaryFiles:  10,
+		Fix:           driverFlags.fix,
+		Tests:         driverFlags.tests,
+		Tags:          buildTags(nil),
+		AnalyzerFlags: driverFlags.analyzerFlags,
+		Stable:        driverFlags.stable,
+		Overlay:       driverFlags.overlay,
+	})
+}
+
+// newFormatter returns the formatter of the format with the given name. Text is meant to be
+// read alongside the summary and is written to stderr, every other format is meant to be
+// piped or redirected on its own and is written to stdout.
+func newFormatter(formatName string) (format.Formatter, error) {
+	if formatName == format.Text {
+		return format.NewTextWithColor(os.Stderr, driverFlags.contextLines, format.ColorEnabled(os.Stderr, driverFlags.noColor)), nil
+	}
+
+	return format.New(formatName, os.Stdout)
+}
+
+// vetAnalyzers are the analyzers ran when lintroller is ran as a vet tool, which are every
+// analyzer with the options given by their flags.
+var vetAnalyzers = []*analysis.Analyzer{
+	&doculint.Analyzer,
+	&header.Analyzer,
+	&copyright.Analyzer,
+	&todo.Analyzer,
+	&why.Analyzer,
+	&commentedcode.Analyzer,
+	&gogenerate.Analyzer,
+	&gomod.Analyzer,
+	&errorlint.Analyzer,
+	&license.Analyzer,
+	&ctxstruct.Analyzer,
+	&logging.Analyzer,
+	&noprint.Analyzer,
+	&thinmain.Analyzer,
+	&metricname.Analyzer,
+	&reflectunsafe.Analyzer,
+	&receiver.Analyzer,
+	&signature.Analyzer,
+	&dupstring.Analyzer,
+	&magicnumber.Analyzer,
+	&commentrules.Analyzer,
+	&linklint.Analyzer,
+}
+
+// driverFlags are the options of the driver given on the command line when lintroller is
+// given a config file. The subcommands run the driver with their defaults.
+var driverFlags = struct {
+	// tests denotes whether or not test files are analyzed, see driver.Options.Tests.
+	tests bool
+
+	// fix denotes whether or not suggested fixes are applied, see driver.Options.Fix.
+	fix bool
+
+	// tags is the comma-separated list of build tags the packages are loaded with, in
+	// addition to the configured ones, see driver.Options.Tags.
+	tags string
+
+	// contextLines is the number of lines of source written around each issue in the text
+	// format, or negative to not write any.
+	contextLines int
+
+	// noColor denotes whether or not coloring the text format is disabled, see
+	// format.ColorEnabled.
+	noColor bool
+
+	// stable denotes whether or not the issues are written all at once after the run, see
+	// driver.Options.Stable.
+	stable bool
+
+	// overlay contains the content of the file given on stdin, see driver.Options.Overlay.
+	overlay map[string][]byte
+
+	// analyzerFlags are the flags of the analyzers, e.g. "-doculint.minFunLen=20", which
+	// take precedence over the configuration.
+	analyzerFlags []driver.AnalyzerFlag
+}{
+	tests:        true,
+	contextLines: -1,
+}
+
+// analyzerFlag is a flag.Value recording the value given to a flag of an analyzer in
+// driverFlags.analyzerFlags, so that it is set after the analyzer is configured.
+type analyzerFlag struct {
+	analyzer string
+	flag     *flag.Flag
+}
+
+// String implements the flag.Value interface.
+func (f *analyzerFlag) String() string {
+	if f.flag == nil {
+		return ""
+	}
+	return f.flag.DefValue
+}
+
+// Set implements the flag.Value interface.
+func (f *analyzerFlag) Set(value string) error {
+	driverFlags.analyzerFlags = append(driverFlags.analyzerFlags, driver.AnalyzerFlag{
+		Analyzer: f.analyzer,
+		Name:     f.flag.Name,
+		Value:    value,
+	})
+	return nil
+}
+
+// IsBoolFlag allows boolean flags of analyzers to be given without a value.
+func (f *analyzerFlag) IsBoolFlag() bool {
+	b, ok := f.flag.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// registerAnalyzerFlags defines the flags of the given analyzers in the given flag set,
+// prefixed with the name of their analyzer like the drivers in
+// golang.org/x/tools/go/analysis do, e.g. "-doculint.minFunLen".
+func registerAnalyzerFlags(fs *flag.FlagSet, analyzers []*analysis.Analyzer) {
+	for _, a := range analyzers {
+		a.Flags.VisitAll(func(f *flag.Flag) {
+			fs.Var(&analyzerFlag{analyzer: a.Name, flag: f}, a.Name+"."+f.Name, f.Usage)
+		})
+	}
+}
+
+// run runs the analyzers enabled by the given configuration over the packages matching the
+// given patterns, writing the issues in the format of the given name, and returns the code
+// the process should exit with.
+func run(cfg *config.Config, patterns []string, summary bool, formatName string) int {
+	return runScored(cfg, patterns, summary, formatName, newScorer(cfg))
+}
+
+// newScorer returns the scorer of packages configured by the given configuration, or nil
+// if scoring isn't enabled.
+func newScorer(cfg *config.Config) *score.Scorer {
+	if !cfg.Scoring.Enabled {
+		return nil
+	}
+
+	return score.NewScorer(cfg.Scoring.Weights, cfg.Scoring.DefaultWeight)
+}
+
+// runScored is run, scoring the packages with the given scorer unless it is nil.
+func runScored(cfg *config.Config, patterns []string, summary bool, formatName string, scorer *score.Scorer) int {
+	shutdown, err := tracing.Init(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tracing: %v\n", err)
+		return driver.ExitFailure
+	}
+	defer func() {
+		if err := shutdown(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "tracing: %v\n", err)
+		}
+	}()
+
+	if cfg.DocsBaseURL != nil {
+		reporter.SetDocsBaseURL(*cfg.DocsBaseURL)
+	}
+
+	if err := setReportPaths(&cfg.ReportPaths); err != nil {
+		fmt.Fprintf(os.Stderr, "config: reportPaths: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	if err := setOwners(); err != nil {
+		fmt.Fprintf(os.Stderr, "codeowners: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	common.SetIgnoredPaths(cfg.IgnorePaths)
+	common.SetThirdPartyPaths(cfg.ThirdPartyPaths)
+	common.SetTestDetection(common.TestDetection{
+		FilePatterns:    cfg.TestDetection.FilePatterns,
+		PackageSuffixes: cfg.TestDetection.PackageSuffixes,
+		LintTests:       cfg.LintTests(),
+	})
+	common.SetLintGenerated(cfg.LintGenerated())
+	common.SetLintExcluded(cfg.Build.LintExcludedFiles)
+	reporter.SetWarnings(cfg.Warnings())
+	reporter.SetWarningRules(cfg.WarningRules())
+	reporter.SetErrorRules(cfg.ErrorRules())
+	reporter.SetEscalations(cfg.EscalateSuppressions)
+	reporter.SetFileWorkers(cfg.FileWorkers)
+
+	suppressions := make([]reporter.Suppression, 0, len(cfg.Suppressions))
+	for i := range cfg.Suppressions {
+		suppressions = append(suppressions, reporter.Suppression{
+			File: cfg.Suppressions[i].File,
+			Line: cfg.Suppressions[i].Line,
+			Rule: cfg.Suppressions[i].Rule,
+		})
+	}
+	reporter.SetSuppressions(suppressions)
+
+	if cfg.Scope == config.ScopeChanged {
+		files, err := changedFiles(cfg.BaseOrDefault())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "config: scope: %v\n", err)
+			return driver.ExitFailure
+		}
+		common.SetChangedFiles(files)
+	}
+
+	// Report every deprecated key of the config file so that it's migrated before the key
+	// is removed.
+	for _, d := range cfg.Deprecations() {
+		fmt.Fprintf(os.Stderr, "config: warning: %s\n", d.String())
+	}
+
+	// Report every field that was raised to meet the minimums of a tier so that it doesn't
+	// happen silently.
+	for _, o := range cfg.Overrides() {
+		fmt.Fprintf(os.Stderr, "config: %s\n", o.String())
+	}
+	if te := cfg.UpcomingTierEscalation(); te != nil {
+		fmt.Fprintf(os.Stderr, "config: warning: %s\n", te.String())
+	}
+	for i := range cfg.PackageTiers {
+		for _, o := range cfg.PackageTiers[i].Config().Overrides() {
+			fmt.Fprintf(os.Stderr, "config: %s (packageTiers[%d])\n", o.String(), i)
+		}
+	}
+
+	// Report every field accepted below the minimums of a tier by an exception, and every
+	// exception that expired, so that exceptions aren't forgotten about.
+	for _, e := range cfg.Excepted() {
+		fmt.Fprintf(os.Stderr, "config: exception: %s\n", e.String())
+	}
+	for i := range cfg.PackageTiers {
+		for _, e := range cfg.PackageTiers[i].Config().Excepted() {
+			fmt.Fprintf(os.Stderr, "config: exception: %s (packageTiers[%d])\n", e.String(), i)
+		}
+	}
+	for _, te := range cfg.ExpiredTierExceptions() {
+		fmt.Fprintf(os.Stderr, "config: warning: the exception for %s expired on %s, its tier minimum applies again\n", te.Rule, te.Expires)
+	}
+
+	// Packages matching a package tier are analyzed with the configuration of that tier,
+	// the rest of the packages are analyzed with the top-level configuration.
+	groups := make([]driver.Group, 0, len(cfg.PackageTiers)+1)
+	for i := range cfg.PackageTiers {
+		pt := &cfg.PackageTiers[i]
+		checker, err := companionChecker(cfg, pt.Config())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "config: packageTiers[%d]: %v\n", i, err)
+			return driver.ExitFailure
+		}
+
+		groups = append(groups, driver.Group{
+			Match: pt.Matches,
+			Analyzers: func() []*analysis.Analyzer {
+				return analyzers(pt.Config())
+			},
+			Companion: checker,
+		})
+	}
+
+	checker, err := companionChecker(cfg, &cfg.Lintroller)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "config: %v\n", err)
+		return driver.ExitFailure
+	}
+	groups = append(groups, driver.Group{
+		Analyzers: func() []*analysis.Analyzer {
+			return analyzers(&cfg.Lintroller)
+		},
+		Companion: checker,
+	})
+
+	formatter, err := newFormatter(formatName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "format: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	// The results are written in the json format as well when they're uploaded, regardless
+	// of the format they're written to the output in.
+	var results bytes.Buffer
+	if cfg.Upload.URL != "" {
+		formatter = format.Tee(formatter, format.NewJSON(&results))
+	}
+
+	opts := driver.Options{
+		Formatter:      formatter,
+		Summary:        summary,
+		SummaryFiles:   10,
+		Scorer:         scorer,
+		Fix:            driverFlags.fix,
+		Tests:          driverFlags.tests,
+		Tags:           buildTags(cfg.Build.Tags),
+		Configurations: buildConfigurations(cfg.Build.Configurations),
+		AnalyzerFlags:  driverFlags.analyzerFlags,
+		Stable:         driverFlags.stable,
+		Overlay:        driverFlags.overlay,
+	}
+	if cfg.CompanionFiles.Enabled {
+		opts.CompanionExtensions = cfg.CompanionFiles.ExtensionsOrDefault()
+	}
+
+	exitCode := driver.Run(patterns, groups, &opts)
+
+	if cfg.Upload.URL != "" {
+		if err := uploadResults(cfg, exitCode, results.Bytes()); err != nil {
+			fmt.Fprintf(os.Stderr, "upload: %v\n", err)
+			if cfg.Upload.Required {
+				return driver.ExitFailure
+			}
+		}
+	}
+
+	return exitCode
+}
+
+// uploadTimeout is how long uploading the results of a run may take.
+const uploadTimeout = 30 * time.Second
+
+// uploadResults uploads the given results of a run, written in the json format, that exited
+// with the given exit code to the endpoint configured by cfg.Upload, along with the
+// repository, the commit, and the tier they were linted at.
+func uploadResults(cfg *config.Config, exitCode int, results []byte) error {
+	// Runs that fail before linting, e.g. because packages couldn't be loaded, have no
+	// results to upload.
+	if len(results) == 0 {
+		return errors.New("the run failed before writing any results")
+	}
+
+	result := upload.Result{
+		Repository: cfg.Upload.Repository,
+		Commit:     upload.Commit(),
+		ExitCode:   exitCode,
+		Results:    results,
+	}
+	if result.Repository == "" {
+		result.Repository = upload.Repository()
+	}
+	if cfg.Tier != nil {
+		result.Tier = strings.ToLower(*cfg.Tier)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uploadTimeout)
+	defer cancel()
+
+	return upload.Send(ctx, http.DefaultClient, cfg.Upload.URL, os.Getenv(cfg.Upload.TokenEnvOrDefault()), &result)
+}
+
+// setReportPaths configures how the paths of files are written in reported issues, see
+// reporter.SetReportPaths, from the given configuration.
+func setReportPaths(cfg *config.ReportPaths) error {
+	var root string
+	if cfg.RelativeToModule {
+		wd, err := os.Getwd()
+		if err != nil {
+			return errors.Wrap(err, "get working directory")
+		}
+
+		goMod := common.FindGoMod(wd)
+		if goMod == "" {
+			return fmt.Errorf("relativeToModule is set, but \"%s\" doesn't belong to a module", wd)
+		}
+		root = filepath.Dir(goMod)
+	}
+
+	rewrites := make([]reporter.PathRewrite, 0, len(cfg.Rewrites))
+	for i := range cfg.Rewrites {
+		rewrites = append(rewrites, reporter.PathRewrite{From: cfg.Rewrites[i].From, To: cfg.Rewrites[i].To})
+	}
+	reporter.SetReportPaths(root, rewrites)
+
+	return nil
+}
+
+// setOwners sets the owners the summary groups issues by to the owners of their files
+// according to the CODEOWNERS file of the repository rooted at the working directory, like
+// the inventory subcommand does. Repositories without one leave issues ungrouped.
+func setOwners() error {
+	co, err := inventory.LoadCodeOwners(".")
+	if err != nil {
+		return err
+	}
+
+	if co == nil {
+		reporter.SetOwners(nil)
+		return nil
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return errors.Wrap(err, "get working directory")
+	}
+
+	reporter.SetOwners(func(filename string) []string {
+		if rel, err := filepath.Rel(wd, filename); err == nil && filepath.IsAbs(filename) {
+			filename = rel
+		}
+		return co.Owners(filepath.ToSlash(filepath.Clean(filename)))
+	})
+
+	return nil
+}
+
+// newNoLints returns the nolint directives added since the base of the given configuration
+// without an approved entry in the suppressions file, see newnolint.Check.
+func newNoLints(cfg *config.Config) ([]newnolint.Violation, error) {
+	common.SetIgnoredPaths(cfg.IgnorePaths)
+
+	return newnolint.Check(&newnolint.Options{
+		Base:             cfg.BaseOrDefault(),
+		Linters:          cfg.ForbidNewNoLint.LintersOrDefault(),
+		SuppressionsFile: cfg.SuppressionsPath,
+		Suppressions:     cfg.Suppressions,
+	})
+}
+
+// changedFiles returns the absolute paths of the files that changed compared to the merge
+// base of the given git revision and the working tree, including untracked files.
+func changedFiles(base string) ([]string, error) {
+	root, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return nil, errors.Wrap(err, "find root of git repository")
+	}
+
+	diff, err := exec.Command("git", "diff", "--name-only", "--merge-base", base).Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "list files changed since \"%s\"", base)
+	}
+
+	untracked, err := exec.Command("git", "ls-files", "--others", "--exclude-standard", "--full-name").Output()
+	if err != nil {
+		return nil, errors.Wrap(err, "list untracked files")
+	}
+
+	// Nothing having changed means nothing is in scope, which a nil slice wouldn't denote.
+	files := []string{}
+	for _, name := range strings.Split(string(diff)+string(untracked), "\n") {
+		if name = strings.TrimSpace(name); name != "" {
+			files = append(files, filepath.Join(strings.TrimSpace(string(root)), filepath.FromSlash(name)))
+		}
+	}
+
+	return files, nil
+}
+
+// companionChecker returns the checker for companion files with the header and copyright
+// requirements of the given linter configuration, or nil if companion files aren't checked.
+func companionChecker(cfg *config.Config, lr *config.Lintroller) (*companion.Checker, error) {
+	if !cfg.CompanionFiles.Enabled || (!lr.Header.Enabled && !lr.Copyright.Enabled) {
+		return nil, nil
+	}
+
+	var rules companion.Rules
+	if lr.Header.Enabled {
+		rules.Fields = lr.Header.Fields
+	}
+	if lr.Copyright.Enabled {
+		rules.CopyrightText = lr.Copyright.Text
+		rules.CopyrightPattern = lr.Copyright.Pattern
+	}
+
+	return companion.New(&rules)
+}
+
+// evaluate runs the analyzers over the packages matching the given patterns with the
+// configuration at the given path raised to the minimums of the given tier, then prints a
+// verdict of whether or not each requirement of the tier was met. The returned exit code is
+// only non-zero if the packages could not be analyzed.
+func evaluate(configPath, tier string, patterns []string, summary bool) int {
+	var verdict *compliance.Verdict
+
+	if cfg, err := config.FromFileWithTier(configPath, tier); err != nil {
+		verdict = compliance.ConfigurationFailure(tier, err)
+	} else {
+		if exitCode := run(cfg, patterns, summary, format.Text); exitCode == driver.ExitFailure {
+			return exitCode
+		}
+
+		s := reporter.Summarize(0)
+		verdict = compliance.Evaluate(tier, &s)
+	}
+
+	if err := verdict.Write(os.Stdout); err != nil {
+		log.Error(context.Background(), "write tier verdict", events.NewErrorInfo(err))
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// copyrightAnalyzer returns the copyright analyzer with its options set from the given
+// configuration.
+func copyrightAnalyzer(cfg *config.Copyright) *analysis.Analyzer {
+	copyright.SetEmbeddedOptions(cfg.Embedded)
+
+	return copyright.NewAnalyzerWithOptions(cfg.Text, cfg.Pattern, cfg.Block, cfg.Entities)
+}
+
+// doculintAnalyzer returns the doculint analyzer with its options set from the given
+// configuration.
+func doculintAnalyzer(cfg *config.Doculint) *analysis.Analyzer {
+	doculint.SetSpellCheckOptions(cfg.Spelling.Enabled, cfg.Spelling.Locale, cfg.Spelling.IgnoreWords)
+	doculint.SetPackageCommentOptions(cfg.MinPackageSentences, cfg.RequirePackageUsage)
+	doculint.SetLineWidthOptions(cfg.LineWidth.Enabled, cfg.LineWidth.Max)
+	doculint.SetHeaderDescriptionOptions(cfg.ValidateHeaderDescriptions)
+	doculint.SetUnreferencedExportOptions(cfg.UnreferencedExports)
+	doculint.SetEnumExceptionOptions(cfg.EnumException)
+	doculint.SetTypeOptions(cfg.SkipAliases, cfg.ValidateEmbedded)
+	doculint.SetTrailingCommentOptions(cfg.AcceptTrailingComments)
+	doculint.SetDirectivePlacementOptions(cfg.ValidateDirectives)
+	doculint.SetDocFileOptions(cfg.DocFile.Paths, cfg.DocFile.Exceptions)
+	doculint.SetDisabledRules(cfg.DisabledRules)
+
+	return doculint.NewAnalyzerWithOptions(cfg.MinFunLen, cfg.ValidatePackages, cfg.ValidateFunctions,
+		cfg.ValidateVariables, cfg.ValidateConstants, cfg.ValidateTypes, cfg.ValidateDocNames)
+}
+
+// errorlintAnalyzer returns the errorlint analyzer with its options set from the given
+// configuration.
+func errorlintAnalyzer(cfg *config.ErrorLint) *analysis.Analyzer {
+	errorlint.SetExternalWrapOptions(cfg.RequireExternalWraps, cfg.PassThroughPackages)
+	errorlint.SetRequireStaticMessages(cfg.RequireStaticMessages)
+	errorlint.SetRequireErrNames(cfg.RequireErrNames)
+	errorlint.SetDeferredCloseOptions(cfg.CheckDeferredClose, cfg.WritableTypes)
+	errorlint.SetValidateMessageStyle(cfg.ValidateMessageStyle)
+	errorlint.SetCapitalizedWords(cfg.CapitalizedWords)
+
+	return errorlint.NewAnalyzerWithOptions(cfg.ValidateSpanNames, cfg.SpanPrefix)
+}
+
+// commentRulesAnalyzer returns the commentrules analyzer with the rules set from the given
+// configuration.
+func commentRulesAnalyzer(cfg *config.CommentRules) *analysis.Analyzer {
+	rules := make([]commentrules.Rule, 0, len(cfg.Rules))
+	for i := range cfg.Rules {
+		rules = append(rules, commentrules.Rule{
+			Name:     cfg.Rules[i].Name,
+			Match:    cfg.Rules[i].Match,
+			Require:  cfg.Rules[i].Require,
+			Message:  cfg.Rules[i].Message,
+			Severity: cfg.Rules[i].Severity,
+		})
+	}
+
+	return commentrules.NewAnalyzerWithOptions(rules)
+}
+
+// headerAnalyzer returns the header analyzer with the fields it requires, globally and for
+// the files matching path globs, set from the given configuration.
+func headerAnalyzer(cfg *config.Header) *analysis.Analyzer {
+	pathFields := make([]header.PathFields, 0, len(cfg.PathFields))
+	for i := range cfg.PathFields {
+		pathFields = append(pathFields, header.PathFields{
+			Paths:  cfg.PathFields[i].Paths,
+			Fields: cfg.PathFields[i].Fields,
+		})
+	}
+
+	return header.NewAnalyzerWithOptions(strings.Join(cfg.Fields, ","), pathFields)
+}
+
+// licenseAnalyzer returns the license analyzer with the copyright it compares the LICENSE
+// file against set from the given configuration, which is only required when the copyright
+// linter is enabled.
+func licenseAnalyzer(cfg *config.Copyright) *analysis.Analyzer {
+	if !cfg.Enabled {
+		return license.NewAnalyzerWithOptions("", "")
+	}
+	return license.NewAnalyzerWithOptions(cfg.Text, cfg.Pattern)
+}
+
+// tierReport implements the tier-report subcommand, which prints how the given config file
+// compares to the minimums of the tiers it selects. The returned exit code is non-zero if
+// the config file could not be read or any of its tiers are not met.
+func tierReport(args []string) int {
+	fs := flag.NewFlagSet("lintroller tier-report", flag.ContinueOnError)
+
+	var configPath, format string
+	fs.StringVar(&configPath, "config", "", "the path to the config file for lintroller.")
+	fs.StringVar(&format, "format", config.ReportFormatJSON,
+		fmt.Sprintf("the format to print the report in, one of %q or %q.", config.ReportFormatJSON, config.ReportFormatText))
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if configPath == "" {
+		fmt.Fprintln(os.Stderr, "tier-report: -config is required")
+		return driver.ExitFailure
+	}
+
+	// Deviations are part of the report, there's no need to log them as well.
+	log.SetOutput(io.Discard)
+
+	report, err := config.ReportFromFile(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tier-report: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	if err := report.Write(os.Stdout, format); err != nil {
+		fmt.Fprintf(os.Stderr, "tier-report: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	if !report.Compliant {
+		return driver.ExitDiagnostics
+	}
+
+	return driver.ExitOK
+}
+
+// badge implements the badge subcommand, which runs the analyzers over the packages matching
+// the given patterns and writes a shields.io endpoint badge of the configured tier and the
+// findings of the run. The returned exit code is only non-zero if the badge could not be
+// written, so that CI can publish a failing badge.
+func badge(args []string) int {
+	fs := flag.NewFlagSet("lintroller badge", flag.ContinueOnError)
+
+	var configPath, output string
+	var summary bool
+	fs.StringVar(&configPath, "config", "", "the path to the config file for lintroller.")
+	fs.StringVar(&output, "output", "lintroller-badge.json", "the path to write the badge to, or \"-\" for stdout.")
+	fs.BoolVar(&summary, "summary", false, "if set, print a summary of the issues reported by each linter at the end of the run.")
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if configPath == "" {
+		fmt.Fprintln(os.Stderr, "badge: -config is required")
+		return driver.ExitFailure
+	}
+
+	log.SetOutput(io.Discard)
+
+	cfg, err := config.FromFile(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "badge: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	if exitCode := run(cfg, patterns, summary, format.Text); exitCode == driver.ExitFailure {
+		return exitCode
+	}
+
+	var tier string
+	if cfg.Tier != nil {
+		tier = *cfg.Tier
+	}
+
+	s := reporter.Summarize(0)
+	b := compliance.NewBadge(tier, &s)
+
+	if err := writeBadge(b, output); err != nil {
+		fmt.Fprintf(os.Stderr, "badge: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// writeBadge writes the given badge to the file at the given path, or to stdout if the path
+// is "-". The file is closed before returning so that errors flushing it aren't lost.
+func writeBadge(b *compliance.Badge, output string) error {
+	if output == "-" {
+		return b.Write(os.Stdout)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+
+	if err := b.Write(f); err != nil {
+		f.Close() //nolint:errcheck // Why: The write error is the one worth reporting.
+		return err
+	}
+
+	return errors.Wrap(f.Close(), "close badge")
+}
+
+// lintFiles implements the file subcommand, which lints the given Go files, e.g. from the
+// save hook of an editor. Only the packages the files belong to are loaded and only the
+// issues within the files are reported, regardless of the configured scope. Without a
+// config file every analyzer runs with the options given by flags, like it does when
+// lintroller is given packages.
+func lintFiles(args []string) int {
+	fs := flag.NewFlagSet("lintroller file", flag.ContinueOnError)
+
+	var configPath, profile, formatName string
+	var summary bool
+	fs.StringVar(&configPath, "config", "", "the path to the config file for lintroller.")
+	fs.StringVar(&profile, "profile", "", "if set, adjust the configuration with the profile of the given name.")
+	fs.StringVar(&formatName, "format", format.Text, "the format to write issues in.")
+	fs.BoolVar(&summary, "summary", false, "if set, print a summary of the issues reported by each linter at the end of the run.")
+	fs.BoolVar(&driverFlags.fix, "fix", false, "apply all suggested fixes.")
+	fs.StringVar(&driverFlags.tags, "tags", "", "a comma-separated list of build tags to load the packages with.")
+	registerAnalyzerFlags(fs, vetAnalyzers)
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if fs.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "file: at least one Go file is required")
+		return driver.ExitFailure
+	}
+
+	files := make([]string, 0, fs.NArg())
+	patterns := make([]string, 0, fs.NArg())
+	for _, name := range fs.Args() {
+		file, err := goFile(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "file: %v\n", err)
+			return driver.ExitFailure
+		}
+
+		files = append(files, file)
+		patterns = append(patterns, "file="+file)
+	}
+
+	if configPath == "" {
+		common.SetChangedFiles(files)
+		return standalone(patterns, summary, formatName)
+	}
+
+	log.SetOutput(io.Discard)
+
+	cfg, err := config.FromFileWithProfile(configPath, profile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "file: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	// The given files are the scope, so that the changed files aren't looked up.
+	cfg.Scope = config.ScopeFull
+	common.SetChangedFiles(files)
+
+	return run(cfg, patterns, summary, formatName)
+}
+
+// readStdin reads the content of the Go file with the given name from stdin into the overlay
+// of the driver, see driver.Options.Overlay, and returns the absolute path of the file. The
+// file doesn't need to exist, but its directory does, since its package is loaded from it.
+// Content can only be read from stdin by the lint command, without targets or -fix.
+func readStdin(lint bool, name string, targets []string) (string, error) {
+	switch {
+	case !lint:
+		return "", errors.New("-stdin only applies to lintroller lint")
+	case name == "":
+		return "", errors.New("-stdin-filename is required")
+	case len(targets) > 0:
+		return "", errors.New("-stdin doesn't take targets, the package of -stdin-filename is linted")
+	case driverFlags.fix:
+		return "", errors.New("-fix can't be combined with -stdin")
+	case filepath.Ext(name) != ".go":
+		return "", fmt.Errorf("%s is not a Go file", name)
+	}
+
+	file, err := filepath.Abs(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "resolve %s", name)
+	}
+
+	info, err := os.Stat(filepath.Dir(file))
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", filepath.Dir(name))
+	}
+
+	content, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", errors.Wrap(err, "read stdin")
+	}
+
+	driverFlags.overlay = map[string][]byte{file: content}
+	return file, nil
+}
+
+// goFile returns the absolute path of the Go file with the given name, or an error if it
+// isn't an existing Go file.
+func goFile(name string) (string, error) {
+	if filepath.Ext(name) != ".go" {
+		return "", fmt.Errorf("%s is not a Go file", name)
+	}
+
+	file, err := filepath.Abs(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "resolve %s", name)
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s is a directory", name)
+	}
+
+	return file, nil
+}
+
+// inventorySuppressions implements the inventory suppressions subcommand, which prints
+// every nolint directive in the given directories, and every entry of the suppressions
+// file when a config file is given, along with their linters, reasons, and owners.
+func inventorySuppressions(args []string) int {
+	if len(args) == 0 || args[0] != "suppressions" {
+		fmt.Fprintln(os.Stderr, "inventory: expected an inventory to print, one of: suppressions")
+		return driver.ExitFailure
+	}
+
+	fs := flag.NewFlagSet("lintroller inventory suppressions", flag.ContinueOnError)
+
+	var configPath, format string
+	var blame bool
+	fs.StringVar(&configPath, "config", "", "the path to the config file for lintroller, "+
+		"whose ignored paths are skipped and suppressions file is included.")
+	fs.BoolVar(&blame, "blame", false, "if set, annotate every suppression with the author and date of its line "+
+		"according to git blame, and count the suppressions of each author.")
+	fs.StringVar(&format, "format", config.ReportFormatJSON,
+		fmt.Sprintf("the format to print the inventory in, one of %q or %q.", config.ReportFormatJSON, config.ReportFormatText))
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return driver.ExitFailure
+	}
+
+	log.SetOutput(io.Discard)
+
+	var entries []config.Suppression
+	var suppressionsFile string
+	if configPath != "" {
+		cfg, err := config.FromFile(configPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "inventory: %v\n", err)
+			return driver.ExitFailure
+		}
+
+		common.SetIgnoredPaths(cfg.IgnorePaths)
+		common.SetThirdPartyPaths(cfg.ThirdPartyPaths)
+		entries = cfg.Suppressions
+		suppressionsFile = cfg.SuppressionsPath
+	}
+
+	co, err := inventory.LoadCodeOwners(".")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "inventory: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	dirs := fs.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	inv, err := inventory.CollectSuppressions(dirs, entries, co)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "inventory: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	if blame {
+		if err := inv.Blame(suppressionsFile); err != nil {
+			fmt.Fprintf(os.Stderr, "inventory: %v\n", err)
+			return driver.ExitFailure
+		}
+	}
+
+	if err := inv.Write(os.Stdout, format); err != nil {
+		fmt.Fprintf(os.Stderr, "inventory: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// problemMatcher implements the problem-matcher subcommand, which prints the problem matcher
+// of an editor matching the output of the editor format.
+func problemMatcher(args []string) int {
+	fs := flag.NewFlagSet("lintroller problem-matcher", flag.ContinueOnError)
+
+	var editor string
+	fs.StringVar(&editor, "editor", format.VSCode, fmt.Sprintf("the editor to print the problem matcher of, one of: %s, %s.",
+		format.IntelliJ, format.VSCode))
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if err := format.ProblemMatcher(os.Stdout, editor); err != nil {
+		fmt.Fprintf(os.Stderr, "problem-matcher: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// selftestFailures records the failures of the fixtures of a single analyzer, see selftest.
+type selftestFailures []string
+
+// Errorf implements the linttest.Testing interface.
+func (f *selftestFailures) Errorf(format string, args ...interface{}) {
+	*f = append(*f, fmt.Sprintf(format, args...))
+}
+
+// selftest implements the selftest subcommand, which runs every analyzer, configured by the
+// given config file or else by flags, over its fixtures, see the linttest package, and
+// prints whether or not each of them behaved as the fixtures expect. The returned exit code
+// is non-zero if any analyzer failed or no analyzer had fixtures.
+func selftest(args []string) int {
+	fs := flag.NewFlagSet("lintroller selftest", flag.ContinueOnError)
+
+	var configPath, fixtures string
+	fs.StringVar(&configPath, "config", "", "the path to the config file for lintroller. If this is not set every "+
+		"analyzer runs with the options given by its flags.")
+	fs.StringVar(&fixtures, "fixtures", filepath.Join("testdata", "lintroller"), "the directory holding the fixtures, "+
+		"with the fixture packages of each analyzer beneath src/<analyzer>.")
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	as := vetAnalyzers
+	if configPath != "" {
+		log.SetOutput(io.Discard)
+
+		cfg, err := config.FromFile(configPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "selftest: %v\n", err)
+			return driver.ExitFailure
+		}
+
+		common.SetTestDetection(common.TestDetection{
+			FilePatterns:    cfg.TestDetection.FilePatterns,
+			PackageSuffixes: cfg.TestDetection.PackageSuffixes,
+			LintTests:       cfg.LintTests(),
+		})
+		common.SetLintGenerated(cfg.LintGenerated())
+		common.SetLintExcluded(cfg.Build.LintExcludedFiles)
+
+		as = analyzers(&cfg.Lintroller)
+	}
+
+	exitCode := driver.ExitOK
+	var tested int
+	for _, a := range as {
+		if info, err := os.Stat(filepath.Join(fixtures, "src", a.Name)); err != nil || !info.IsDir() {
+			continue
+		}
+		tested++
+
+		var failures selftestFailures
+		linttest.RunDir(&failures, fixtures, a, a.Name+"/...")
+
+		if len(failures) == 0 {
+			fmt.Printf("ok\t%s\n", a.Name)
+			continue
+		}
+
+		exitCode = driver.ExitFailure
+		fmt.Printf("FAIL\t%s\n", a.Name)
+		for _, failure := range failures {
+			fmt.Printf("\t%s\n", strings.ReplaceAll(failure, "\n", "\n\t"))
+		}
+	}
+
+	if tested == 0 {
+		fmt.Fprintf(os.Stderr, "selftest: no analyzer has fixtures beneath %s\n", filepath.Join(fixtures, "src"))
+		return driver.ExitFailure
+	}
+
+	return exitCode
+}
+
+// explain implements the explain subcommand, which prints the documentation of the given
+// linter, or of the given rule of a linter in the form of <linter>/<rule>, from the
+// documentation embedded in lintroller.
+func explain(args []string) int {
+	fs := flag.NewFlagSet("lintroller explain", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "explain: expected a linter or a rule in the form of <linter>/<rule>")
+		return driver.ExitFailure
+	}
+
+	linter, rule, isRule := strings.Cut(fs.Arg(0), "/")
+	page, ok := docs.Lookup(linter)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "explain: linter \"%s\" isn't documented\n", linter)
+		return driver.ExitFailure
+	}
+
+	if !isRule {
+		fmt.Print(string(page.Content))
+		return driver.ExitOK
+	}
+
+	r, ok := page.Rule(rule)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "explain: linter \"%s\" has no rule \"%s\"\n", linter, rule)
+		return driver.ExitFailure
+	}
+
+	fmt.Printf("%s/%s: %s\n", linter, r.ID, r.Summary)
+	if fixing, ok := page.Section("Fixing"); ok {
+		fmt.Printf("\n%s\n", fixing)
+	}
+	if url := reporter.DocsURL(linter, r.ID); url != "" {
+		fmt.Printf("\nSee %s\n", url)
+	}
+
+	return driver.ExitOK
+}
+
+// docsCommand implements the docs subcommand, which manages the documentation of the
+// linters.
+func docsCommand(args []string) int {
+	if len(args) > 0 && args[0] == "generate" {
+		return docsGenerate(args[1:])
+	}
+
+	fmt.Fprintln(os.Stderr, "docs: expected a command, one of: generate")
+	return driver.ExitFailure
+}
+
+// docsGenerate implements the docs generate subcommand, which writes the rules reference,
+// the documentation of every linter and an index of them and their rules, as a tree of
+// markdown files.
+func docsGenerate(args []string) int {
+	fs := flag.NewFlagSet("lintroller docs generate", flag.ContinueOnError)
+
+	var output string
+	fs.StringVar(&output, "output", "rules", "the directory to write the rules reference to, created if it doesn't exist.")
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if err := docs.Generate(output); err != nil {
+		fmt.Fprintf(os.Stderr, "docs generate: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// configCommand implements the config subcommand, which manages config files.
+func configCommand(args []string) int {
+	if len(args) > 0 && args[0] == "migrate" {
+		return configMigrate(args[1:])
+	}
+
+	if len(args) > 0 && args[0] == "org" {
+		return configOrg(args[1:])
+	}
+
+	fmt.Fprintln(os.Stderr, "config: expected a command, one of: migrate, org")
+	return driver.ExitFailure
+}
+
+// configOrg implements the config org subcommand, which prints the default organization
+// config embedded into the binary.
+func configOrg(args []string) int {
+	fs := flag.NewFlagSet("lintroller config org", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	content, ok := config.OrgConfig()
+	if !ok {
+		fmt.Fprintln(os.Stderr, "config org: no default organization config is embedded")
+		return driver.ExitFailure
+	}
+
+	if _, err := os.Stdout.Write(content); err != nil {
+		fmt.Fprintf(os.Stderr, "config org: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// configMigrate implements the config migrate subcommand, which rewrites the given config
+// file to the current version of its schema, replacing every deprecated key.
+func configMigrate(args []string) int {
+	fs := flag.NewFlagSet("lintroller config migrate", flag.ContinueOnError)
+
+	var configPath string
+	var dryRun bool
+	fs.StringVar(&configPath, "config", "", "the path to the config file for lintroller.")
+	fs.BoolVar(&dryRun, "dry-run", false, "if set, print the migrated config file to stdout rather than rewriting it.")
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if configPath == "" {
+		fmt.Fprintln(os.Stderr, "config migrate: -config is required")
+		return driver.ExitFailure
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "config migrate: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	migrated, deprecations, err := config.Migrate(content)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "config migrate: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	for i := range deprecations {
+		fmt.Fprintf(os.Stderr, "config migrate: renamed %s to %s\n", deprecations[i].Key, deprecations[i].Replacement)
+	}
+
+	if dryRun {
+		if _, err := os.Stdout.Write(migrated); err != nil {
+			fmt.Fprintf(os.Stderr, "config migrate: %v\n", err)
+			return driver.ExitFailure
+		}
+		return driver.ExitOK
+	}
+
+	if err := os.WriteFile(configPath, migrated, 0o600); err != nil {
+		fmt.Fprintf(os.Stderr, "config migrate: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// trendHistory implements the trend subcommand, which records the summary of a run in a
+// store, or compares two recorded runs.
+func trendHistory(args []string) int {
+	if len(args) > 0 {
+		switch args[0] {
+		case "record":
+			return trendRecord(args[1:])
+		case "compare":
+			return trendCompare(args[1:])
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "trend: expected a command, one of: record, compare")
+	return driver.ExitFailure
+}
+
+// trendRecord implements the trend record subcommand, which runs the analyzers over the
+// packages matching the given patterns and stores the summary of the run. The returned
+// exit code is only non-zero if the run could not be recorded, regressions are found by
+// trend compare instead.
+func trendRecord(args []string) int {
+	fs := flag.NewFlagSet("lintroller trend record", flag.ContinueOnError)
+
+	var configPath, location, id string
+	var summary bool
+	fs.StringVar(&configPath, "config", "", "the path to the config file for lintroller.")
+	fs.StringVar(&location, "store", "", "the store to record the run in, a local directory or \"s3://<bucket>/<prefix>\".")
+	fs.StringVar(&id, "id", "", "the ID of the run, e.g. the commit it is ran on. Defaults to the current time.")
+	fs.BoolVar(&summary, "summary", false, "if set, print a summary of the issues reported by each linter at the end of the run.")
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if configPath == "" || location == "" {
+		fmt.Fprintln(os.Stderr, "trend record: -config and -store are required")
+		return driver.ExitFailure
+	}
+
+	now := time.Now().UTC()
+	if id == "" {
+		id = now.Format("20060102T150405Z")
+	}
+	if err := trend.ValidateID(id); err != nil {
+		fmt.Fprintf(os.Stderr, "trend record: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	store, err := trend.OpenStore(location)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "trend record: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	log.SetOutput(io.Discard)
+
+	cfg, err := config.FromFile(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "trend record: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	scorer := newScorer(cfg)
+	if exitCode := runScored(cfg, patterns, summary, format.Text, scorer); exitCode == driver.ExitFailure {
+		return exitCode
+	}
+
+	var report *score.Report
+	if scorer != nil {
+		report = scorer.Report()
+	}
+
+	s := reporter.Summarize(0)
+	if err := store.Put(trend.NewRecord(id, now, &s, report)); err != nil {
+		fmt.Fprintf(os.Stderr, "trend record: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	return driver.ExitOK
+}
+
+// trendCompare implements the trend compare subcommand, which compares two recorded runs and
+// exits with driver.ExitDiagnostics if the issues of any of the rules given with -fail-on
+// increased.
+func trendCompare(args []string) int {
+	fs := flag.NewFlagSet("lintroller trend compare", flag.ContinueOnError)
+
+	var location, baselineID, currentID, failOn, format string
+	fs.StringVar(&location, "store", "", "the store the runs were recorded in, a local directory or \"s3://<bucket>/<prefix>\".")
+	fs.StringVar(&baselineID, "baseline", "", "the ID of the run to compare to. Defaults to the run recorded before the current one.")
+	fs.StringVar(&currentID, "current", "", "the ID of the run to compare. Defaults to the most recently recorded run.")
+	fs.StringVar(&failOn, "fail-on", "",
+		"comma-separated list of rule IDs, e.g. doculint/spelling, or linters whose issues must not increase, or \"*\" for every rule.")
+	fs.StringVar(&format, "format", config.ReportFormatJSON,
+		fmt.Sprintf("the format to print the comparison in, one of %q or %q.", config.ReportFormatJSON, config.ReportFormatText))
+
+	if err := fs.Parse(args); err != nil {
+		return driver.ExitFailure
+	}
+
+	if location == "" {
+		fmt.Fprintln(os.Stderr, "trend compare: -store is required")
+		return driver.ExitFailure
+	}
+
+	store, err := trend.OpenStore(location)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "trend compare: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	baseline, current, err := comparedRecords(store, baselineID, currentID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "trend compare: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	var rules []string
+	if failOn != "" {
+		rules = strings.Split(failOn, ",")
+	}
+
+	c := trend.Compare(baseline, current, rules)
+	if err := c.Write(os.Stdout, format); err != nil {
+		fmt.Fprintf(os.Stderr, "trend compare: %v\n", err)
+		return driver.ExitFailure
+	}
+
+	if len(c.Regressions) > 0 {
+		return driver.ExitDiagnostics
+	}
+
+	return driver.ExitOK
+}
+
+// comparedRecords returns the records with the given baseline and current IDs from the
+// given store. The current record defaults to the most recent one, and the baseline record
+// to the one recorded before it.
+func comparedRecords(store trend.Store, baselineID, currentID string) (baseline, current *trend.Record, err error) {
+	if baselineID != "" && currentID != "" {
+		if baseline, err = store.Get(baselineID); err != nil {
+			return nil, nil, err
+		}
+		current, err = store.Get(currentID)
+		return baseline, current, err
+	}
+
+	latest, err := trend.Latest(store, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if currentID == "" {
+		if len(latest) == 0 {
+			return nil, nil, errors.New("no runs have been recorded")
+		}
+		current = latest[0]
+	} else if current, err = store.Get(currentID); err != nil {
+		return nil, nil, err
+	}
+
+	if baselineID != "" {
+		baseline, err = store.Get(baselineID)
+		return baseline, current, err
+	}
+
+	// The baseline is the run recorded before the current one.
+	for i := range latest {
+		if latest[i].ID != current.ID && !latest[i].Time.After(current.Time) {
+			return latest[i], current, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("no run was recorded before %q to compare it to", current.ID)
+}
+
+// analyzers returns the analyzers enabled by the given configuration, with their options
+// set accordingly. The options of the analyzers are package-level variables, so the
+// returned analyzers are only configured this way until this is called again.
+func analyzers(cfg *config.Lintroller) []*analysis.Analyzer {
+	table := []struct {
+		Enabled  bool
+		Analyzer *analysis.Analyzer
+	}{
+		{cfg.Header.Enabled, headerAnalyzer(&cfg.Header)},
+		{cfg.Copyright.Enabled, copyrightAnalyzer(&cfg.Copyright)},
+		{cfg.Doculint.Enabled, doculintAnalyzer(&cfg.Doculint)},
+		{cfg.Todo.Enabled, &todo.Analyzer},
+		{cfg.Why.Enabled, why.NewAnalyzerWithOptions(cfg.Why.MaxCopies)},
+		{cfg.CommentedCode.Enabled, commentedcode.NewAnalyzerWithOptions(cfg.CommentedCode.MinLines)},
+		{cfg.GoGenerate.Enabled, gogenerate.NewAnalyzerWithOptions(cfg.GoGenerate.AllowedCommands)},
+		{cfg.GoMod.Enabled, gomod.NewAnalyzerWithOptions(&gomod.Policy{
+			ForbidReplace:          cfg.GoMod.ForbidReplace,
+			AllowedReplace:         cfg.GoMod.AllowedReplace,
+			MinGoVersion:           cfg.GoMod.MinGoVersion,
+			BannedModules:          cfg.GoMod.BannedModules,
+			ForbidPseudoVersions:   cfg.GoMod.ForbidPseudoVersions,
+			PseudoVersionAllowlist: cfg.GoMod.PseudoVersionAllowlist,
+		})},
+		{cfg.ErrorLint.Enabled, errorlintAnalyzer(&cfg.ErrorLint)},
+		{cfg.License.Enabled, licenseAnalyzer(&cfg.Copyright)},
+		{cfg.CtxStruct.Enabled, &ctxstruct.Analyzer},
+		{cfg.Logging.Enabled, logging.NewAnalyzerWithOptions(cfg.Logging.AllowedPackages)},
+		{cfg.NoPrint.Enabled, &noprint.Analyzer},
+		{cfg.ThinMain.Enabled, thinmain.NewAnalyzerWithOptions(cfg.ThinMain.MaxLines, cfg.ThinMain.DeniedImports)},
+		{cfg.MetricName.Enabled, metricname.NewAnalyzerWithOptions(cfg.MetricName.Functions, cfg.MetricName.Pattern,
+			cfg.MetricName.Prefixes)},
+		{cfg.ReflectUnsafe.Enabled, reflectunsafe.NewAnalyzerWithOptions(cfg.ReflectUnsafe.AllowedPackages)},
+		{cfg.Receiver.Enabled, receiver.NewAnalyzerWithOptions(cfg.Receiver.MaxLength)},
+		{cfg.Signature.Enabled, signature.NewAnalyzerWithOptions(cfg.Signature.MaxParams, cfg.Signature.MaxResults,
+			cfg.Signature.ExportedOnly, cfg.Signature.ExemptConstructors)},
+		{cfg.DupString.Enabled, dupstring.NewAnalyzerWithOptions(cfg.DupString.MaxOccurrences, cfg.DupString.MinLength)},
+		{cfg.MagicNumber.Enabled, magicnumber.NewAnalyzerWithOptions(cfg.MagicNumber.AllowedValues,
+			cfg.MagicNumber.AllowPowersOfTwo, cfg.MagicNumber.AllowHTTPStatusCodes)},
+		{cfg.CommentRules.Enabled, commentRulesAnalyzer(&cfg.CommentRules)},
+		{cfg.LinkLint.Enabled, linklint.NewAnalyzerWithOptions(cfg.LinkLint.DeprecatedDomains, cfg.LinkLint.Online,
+			cfg.LinkLint.CacheFile)},
+	}
+
+	var analyzers []*analysis.Analyzer
+	for i := range table {
+		if table[i].Enabled {
+			analyzers = append(analyzers, table[i].Analyzer)
+		}
+	}
+
+	return analyzers
+}
+
+// buildTags returns the given configured build tags followed by the ones given with the
+// -tags flag.
+func buildTags(configured []string) []string {
+	tags := append([]string(nil), configured...)
+	for _, tag := range strings.Split(driverFlags.tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// buildConfigurations returns the given configured build configurations as the
+// configurations of the driver.
+func buildConfigurations(configured []config.BuildConfiguration) []driver.Configuration {
+	configurations := make([]driver.Configuration, 0, len(configured))
+	for i := range configured {
+		configurations = append(configurations, driver.Configuration{
+			GOOS:   configured[i].GOOS,
+			GOARCH: configured[i].GOARCH,
+			Tags:   configured[i].Tags,
+		})
+	}
+
+	return configurations
 }
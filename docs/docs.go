@@ -0,0 +1,233 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package docs embeds the documentation of each lintroller linter, the markdown pages
+// beneath docs/rules, in the binary. The pages are the single source of the rules
+// reference generated by lintroller docs generate, of what lintroller explain prints, and
+// of which documentation links are attached to diagnostics, so that none of them can
+// drift from the others.
+package docs
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// rulesDir is the directory, relative to this package, holding a page per linter named
+// after the linter.
+const rulesDir = "rules"
+
+// rules holds the page of each linter.
+//
+//go:embed rules/*.md
+var rules embed.FS
+
+// Page is the documentation page of a linter.
+type Page struct {
+	// Linter is the name of the linter, e.g. doculint.
+	Linter string
+
+	// Summary is the first paragraph of the page, describing what the linter checks.
+	Summary string
+
+	// Rules are the rules of the linter listed in the table of its "Rules" section, in the
+	// order they're listed in, for linters that are made up of rules.
+	Rules []Rule
+
+	// Content is the markdown of the whole page.
+	Content []byte
+}
+
+// Rule is a rule of a linter, as listed in the "Rules" section of its page.
+type Rule struct {
+	// ID is the ID of the rule, e.g. spelling, attached as the category of the diagnostics
+	// it reports.
+	ID string
+
+	// Summary is what the rule reports.
+	Summary string
+}
+
+// Rule returns the rule of the page with the given ID, or false if the page doesn't list
+// it.
+func (p *Page) Rule(id string) (Rule, bool) {
+	for _, r := range p.Rules {
+		if r.ID == id {
+			return r, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// Section returns the content of the second-level section of the page with the given
+// heading, e.g. "Fixing", without the heading itself, or false if the page has no such
+// section.
+func (p *Page) Section(heading string) (string, bool) {
+	var section []string
+	var found, fenced bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(p.Content))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// Lines within code blocks, e.g. shell comments, are never headings.
+		if strings.HasPrefix(line, "```") {
+			fenced = !fenced
+		}
+
+		if !fenced && strings.HasPrefix(line, "## ") {
+			if found {
+				break
+			}
+			found = strings.TrimPrefix(line, "## ") == heading
+			continue
+		}
+
+		if found {
+			section = append(section, line)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(section, "\n")), found
+}
+
+// pages are the pages of every linter, keyed by linter.
+var pages = mustLoadPages()
+
+// Lookup returns the page of the given linter, or false if the linter has none.
+func Lookup(linter string) (*Page, bool) {
+	p, ok := pages[linter]
+	return p, ok
+}
+
+// Pages returns the page of every linter, sorted by linter.
+func Pages() []*Page {
+	all := make([]*Page, 0, len(pages))
+	for _, p := range pages {
+		all = append(all, p)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Linter < all[j].Linter
+	})
+
+	return all
+}
+
+// mustLoadPages parses the embedded pages, panicking if they can't be read since that can
+// only be the result of a programming error.
+func mustLoadPages() map[string]*Page {
+	entries, err := rules.ReadDir(rulesDir)
+	if err != nil {
+		panic(errors.Wrap(err, "read embedded rule documentation"))
+	}
+
+	loaded := make(map[string]*Page, len(entries))
+	for _, entry := range entries {
+		content, err := rules.ReadFile(path.Join(rulesDir, entry.Name()))
+		if err != nil {
+			panic(errors.Wrapf(err, "read embedded rule documentation %s", entry.Name()))
+		}
+
+		p := parsePage(strings.TrimSuffix(entry.Name(), ".md"), content)
+		loaded[p.Linter] = p
+	}
+
+	return loaded
+}
+
+// parsePage parses the page of the given linter with the given content.
+func parsePage(linter string, content []byte) *Page {
+	p := Page{
+		Linter:  linter,
+		Content: content,
+	}
+
+	lines := strings.Split(string(content), "\n")
+
+	// The summary is the first paragraph following the title.
+	var summary []string
+	for _, line := range lines[1:] {
+		if strings.TrimSpace(line) == "" {
+			if len(summary) > 0 {
+				break
+			}
+			continue
+		}
+		summary = append(summary, strings.TrimSpace(line))
+	}
+	p.Summary = strings.Join(summary, " ")
+
+	// The rules are the rows of the table of the "Rules" section whose first cell is an ID,
+	// which leaves out its header and delimiter rows.
+	if section, ok := p.Section("Rules"); ok {
+		for _, line := range strings.Split(section, "\n") {
+			// Escaped pipes are part of a cell rather than separate cells.
+			line = strings.ReplaceAll(line, `\|`, "\x00")
+			cells := strings.Split(strings.Trim(strings.TrimSpace(line), "|"), "|")
+			if len(cells) != 2 {
+				continue
+			}
+
+			id := strings.TrimSpace(cells[0])
+			if len(id) < 3 || !strings.HasPrefix(id, "`") || !strings.HasSuffix(id, "`") {
+				continue
+			}
+
+			p.Rules = append(p.Rules, Rule{
+				ID:      strings.Trim(id, "`"),
+				Summary: strings.ReplaceAll(strings.TrimSpace(cells[1]), "\x00", "|"),
+			})
+		}
+	}
+
+	return &p
+}
+
+// Generate writes the rules reference to the given directory: the page of every linter,
+// named after the linter, along with a README.md indexing the linters and their rules.
+func Generate(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errors.Wrap(err, "create rules reference directory")
+	}
+
+	var index bytes.Buffer
+	index.WriteString("# Rules reference\n\n")
+	index.WriteString("Generated by `lintroller docs generate` from the documentation embedded in lintroller.\n\n")
+	index.WriteString("## Linters\n\n| Linter | Checks |\n| ------ | ------ |\n")
+
+	all := Pages()
+	for _, p := range all {
+		if err := os.WriteFile(filepath.Join(dir, p.Linter+".md"), p.Content, 0o644); err != nil {
+			return errors.Wrapf(err, "write documentation of %s", p.Linter)
+		}
+
+		fmt.Fprintf(&index, "| [%s](%s.md) | %s |\n", p.Linter, p.Linter, escapeCell(p.Summary))
+	}
+
+	index.WriteString("\n## Rules\n\n")
+	index.WriteString("Rules are referenced as `<linter>/<rule>`, e.g. in nolint directives and severities.\n\n")
+	index.WriteString("| Rule | Reports |\n| ---- | ------- |\n")
+	for _, p := range all {
+		for _, r := range p.Rules {
+			fmt.Fprintf(&index, "| [`%s/%s`](%s.md#rules) | %s |\n", p.Linter, r.ID, p.Linter, escapeCell(r.Summary))
+		}
+	}
+
+	return errors.Wrap(os.WriteFile(filepath.Join(dir, "README.md"), index.Bytes(), 0o644), "write rules reference index")
+}
+
+// escapeCell escapes the given text for a cell of a markdown table.
+func escapeCell(text string) string {
+	return strings.ReplaceAll(text, "|", "\\|")
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const page = "# foo\n\nChecks that foo\nis bar.\n\n## Configuration\n\n```yaml\n## not a heading\n```\n\n" +
+	"## Rules\n\n| ID | Reports |\n| -- | ------- |\n| `bar` | Foos that aren't bar |\n| `baz` | Foos \\| bazzes |\n\n" +
+	"## Fixing\n\nMake foo bar.\n"
+
+func TestParsePage(t *testing.T) {
+	p := parsePage("foo", []byte(page))
+
+	assert.Equal(t, p.Linter, "foo")
+	assert.Equal(t, p.Summary, "Checks that foo is bar.")
+	assert.DeepEqual(t, p.Rules, []Rule{
+		{ID: "bar", Summary: "Foos that aren't bar"},
+		{ID: "baz", Summary: "Foos | bazzes"},
+	})
+
+	configuration, ok := p.Section("Configuration")
+	assert.Assert(t, ok)
+	assert.Equal(t, configuration, "```yaml\n## not a heading\n```")
+
+	fixing, ok := p.Section("Fixing")
+	assert.Assert(t, ok)
+	assert.Equal(t, fixing, "Make foo bar.")
+
+	_, ok = p.Section("Companion files")
+	assert.Assert(t, !ok)
+}
+
+func TestPages(t *testing.T) {
+	all := Pages()
+	assert.Assert(t, len(all) > 0)
+
+	for i, p := range all {
+		if i > 0 {
+			assert.Assert(t, all[i-1].Linter < p.Linter)
+		}
+
+		// Each page is titled after the linter it documents and says what it checks.
+		assert.Assert(t, strings.HasPrefix(string(p.Content), "# "+p.Linter+"\n"), p.Linter)
+		assert.Assert(t, p.Summary != "", p.Linter)
+	}
+
+	_, ok := Lookup("doculint")
+	assert.Assert(t, ok)
+
+	_, ok = Lookup("unknown")
+	assert.Assert(t, !ok)
+}
+
+func TestGenerate(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "rules")
+	assert.NilError(t, Generate(dir))
+
+	for _, p := range Pages() {
+		content, err := os.ReadFile(filepath.Join(dir, p.Linter+".md"))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, content, p.Content)
+	}
+
+	index, err := os.ReadFile(filepath.Join(dir, "README.md"))
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(index), "| [doculint](doculint.md) | Checks that packages"))
+	assert.Assert(t, strings.Contains(string(index), "| [`doculint/spelling`](doculint.md#rules) | Misspellings"))
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package commentedcode contains the necessary logic for the commentedcode linter. The
+// commentedcode linter heuristically detects blocks of commented-out Go code, which should
+// be removed rather than left to rot since version control already keeps it around.
+package commentedcode
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the commentedcode linter.
+const name = "commentedcode"
+
+// doc defines the help text for the commentedcode linter.
+const doc = `Reports blocks of commented-out Go code, comments made up entirely of lines that
+parse as Go statements or declarations.
+
+Commented-out code that needs to stay can be kept with a nolint directive:
+
+	//nolint:commentedcode // Why: Kept as a reference for the v2 migration.`
+
+// DefaultMinLines is the minimum number of lines of commented-out code reported when no
+// minimum is given.
+const DefaultMinLines = 3
+
+// Analyzer exports the commentedcode analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      commentedcode,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_minLines int) *analysis.Analyzer {
+	minLines = _minLines
+	return &Analyzer
+}
+
+// minLines is a variable that gets collected via flags. This variable contains the minimum
+// number of lines of commented-out code that are reported, smaller blocks are ignored since
+// short snippets of code are common in prose.
+var minLines int
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.IntVar(&minLines, "minLines", DefaultMinLines, "the minimum number of lines of commented-out code that are reported")
+}
+
+// commentedcode is the function that gets passed to the Analyzer which runs the actual
+// analysis for the commentedcode linter on a set of files.
+func commentedcode(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	threshold := minLines
+	if threshold <= 0 {
+		threshold = DefaultMinLines
+	}
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		for _, group := range file.Comments {
+			if pos, lines := findCode(group, threshold); pos.IsValid() {
+				pass.Reportf(pos, "%d lines of commented-out code should be removed, version control already keeps it around", lines)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// commentLine is a single line of text within a comment group.
+type commentLine struct {
+	// text is the line with its comment markers removed.
+	text string
+
+	// pos is the position of the comment the line belongs to.
+	pos token.Pos
+}
+
+// findCode returns the position and number of lines of the first block of commented-out
+// code in the given comment group with at least threshold lines. The whole group is
+// considered first, followed by each of its paragraphs.
+func findCode(group *ast.CommentGroup, threshold int) (token.Pos, int) {
+	lines := groupLines(group)
+
+	if n := countCode(lines); n >= threshold {
+		return lines[0].pos, n
+	}
+
+	for start := 0; start < len(lines); {
+		end := start
+		for end < len(lines) && strings.TrimSpace(lines[end].text) != "" {
+			end++
+		}
+
+		if end > start {
+			if n := countCode(lines[start:end]); n >= threshold {
+				return lines[start].pos, n
+			}
+		}
+
+		start = end + 1
+	}
+
+	return token.NoPos, 0
+}
+
+// groupLines returns the lines of text in the given comment group, skipping directives such
+// as //go:generate and //nolint which aren't prose or code.
+func groupLines(group *ast.CommentGroup) []commentLine {
+	var lines []commentLine
+
+	for _, c := range group.List {
+		if strings.HasPrefix(c.Text, "/*") {
+			for _, line := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(c.Text, "/*"), "*/"), "\n") {
+				lines = append(lines, commentLine{text: line, pos: c.Slash})
+			}
+			continue
+		}
+
+		text := strings.TrimPrefix(c.Text, "//")
+		if text != "" && !strings.HasPrefix(text, " ") && !strings.HasPrefix(text, "\t") {
+			// Directives, e.g. //go:build or //nolint:why.
+			continue
+		}
+
+		if strings.HasPrefix(text, "\t") {
+			// Code blocks in doc comments, e.g. usage examples, are indented with a tab and
+			// are meant to be there, treat them as blank lines.
+			text = ""
+		}
+
+		lines = append(lines, commentLine{text: strings.TrimPrefix(text, " "), pos: c.Slash})
+	}
+
+	return lines
+}
+
+// countCode returns the number of non-blank lines in the given lines if all of them
+// together parse as Go statements or declarations, and zero otherwise.
+func countCode(lines []commentLine) int {
+	var n int
+	var hasCodePunctuation bool
+
+	texts := make([]string, 0, len(lines))
+	for i := range lines {
+		texts = append(texts, lines[i].text)
+
+		trimmed := strings.TrimSpace(lines[i].text)
+		if trimmed == "" {
+			continue
+		}
+		n++
+
+		// A run of lines containing nothing but words parses as a list of expressions, so
+		// require at least one line that looks like code.
+		if strings.ContainsAny(trimmed, "(){}=;[]") || strings.HasPrefix(trimmed, "return") {
+			hasCodePunctuation = true
+		}
+	}
+
+	if n == 0 || !hasCodePunctuation {
+		return 0
+	}
+
+	src := strings.Join(texts, "\n")
+
+	fset := token.NewFileSet()
+	if _, err := parser.ParseFile(fset, "", "package p\nfunc _() {\n"+src+"\n}", 0); err == nil {
+		return n
+	}
+	if _, err := parser.ParseFile(fset, "", "package p\n"+src, 0); err == nil {
+		return n
+	}
+
+	return 0
+}
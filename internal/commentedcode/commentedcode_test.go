@@ -0,0 +1,61 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package commentedcode
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFindCode(t *testing.T) {
+	tt := []struct {
+		name     string
+		comment  string
+		expected int
+	}{
+		{
+			name:     "Reports commented-out statements",
+			comment:  "// x := foo()\n// if x > 1 {\n// \treturn x\n// }",
+			expected: 4,
+		},
+		{
+			name:     "Reports commented-out declarations after prose",
+			comment:  "// Old implementation:\n//\n// func foo() int {\n// \treturn 1\n// }",
+			expected: 3,
+		},
+		{
+			name:     "Ignores prose",
+			comment:  "// This is a comment that talks about foo().\n// It is not code,\n// even if it mentions x := 1.",
+			expected: 0,
+		},
+		{
+			name:     "Ignores lists of words",
+			comment:  "// foo\n// bar\n// baz",
+			expected: 0,
+		},
+		{
+			name:     "Ignores small snippets",
+			comment:  "// x := foo()\n// return x",
+			expected: 0,
+		},
+		{
+			name:     "Ignores code blocks in doc comments",
+			comment:  "// Foo does things, e.g.\n//\n//\tx := Foo()\n//\tif x {\n//\t\treturn\n//\t}",
+			expected: 0,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", "package foo\n\n"+test.comment+"\nvar _ = 1\n", parser.ParseComments)
+			assert.NilError(t, err)
+
+			_, lines := findCode(file.Comments[0], DefaultMinLines)
+			assert.Equal(t, lines, test.expected)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package commentrules contains the necessary logic for the commentrules linter. The
+// commentrules linter runs rules defined in the config file over comments, so that
+// conventions of a single team, e.g. justifying every "SAFETY:" comment, don't need a
+// linter of their own.
+package commentrules
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the commentrules linter.
+const name = "commentrules"
+
+// doc defines the help text for the commentrules linter.
+const doc = `Runs the rules given with -rule over every line of every comment. A line matching
+the match pattern of a rule is reported unless the rule has a require pattern the line
+also matches. Issues are reported with the message of the rule, under the name of the rule,
+and as warnings when the severity of the rule is "warning".`
+
+// SeverityWarning is the severity of rules whose issues are reported as warnings rather
+// than errors.
+const SeverityWarning = "warning"
+
+// Analyzer exports the commentrules analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      commentrules,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// Rule is a rule that is ran over every line of every comment.
+type Rule struct {
+	// Name identifies the rule in reports, suppressions, and nolint directives.
+	Name string `json:"name"`
+
+	// Match is the pattern of the lines the rule applies to.
+	Match string `json:"match"`
+
+	// Require is the pattern the lines matching Match must also match. When empty, every
+	// line matching Match is reported.
+	Require string `json:"require"`
+
+	// Message is the message lines breaking the rule are reported with.
+	Message string `json:"message"`
+
+	// Severity is either "error", the default, or SeverityWarning.
+	Severity string `json:"severity"`
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_rules []Rule) *analysis.Analyzer {
+	rules = append(rulesFlag(nil), _rules...)
+	reporter.SetRuleWarnings(name, rules.warnings())
+	return &Analyzer
+}
+
+// rules is a variable that gets collected via flags. This variable contains the rules ran
+// over comments.
+var rules rulesFlag
+
+// rulesFlag is a flag.Value that collects the -rule flag, which is given once per rule as
+// a JSON object with the fields of Rule, e.g. {"name":"safety","match":"^SAFETY"}.
+type rulesFlag []Rule
+
+// String implements the flag.Value interface.
+func (r *rulesFlag) String() string {
+	values := make([]string, 0, len(*r))
+	for i := range *r {
+		b, err := json.Marshal((*r)[i])
+		if err != nil {
+			continue
+		}
+		values = append(values, string(b))
+	}
+
+	return strings.Join(values, " ")
+}
+
+// Set implements the flag.Value interface.
+func (r *rulesFlag) Set(value string) error {
+	var rule Rule
+	if err := json.Unmarshal([]byte(value), &rule); err != nil {
+		return errors.Wrapf(err, "parse rule \"%s\"", value)
+	}
+
+	*r = append(*r, rule)
+	reporter.SetRuleWarnings(name, r.warnings())
+	return nil
+}
+
+// warnings returns the names of the rules whose issues are reported as warnings.
+func (r rulesFlag) warnings() []string {
+	var names []string
+	for i := range r {
+		if r[i].Severity == SeverityWarning {
+			names = append(names, r[i].Name)
+		}
+	}
+
+	return names
+}
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.Var(&rules, "rule",
+		"a rule ran over comments, as a JSON object with the name, match, require, message, and severity of the rule, "+
+			"may be given more than once")
+}
+
+// compiledRule is a Rule whose patterns have been compiled.
+type compiledRule struct {
+	name    string
+	match   *regexp.Regexp
+	require *regexp.Regexp
+	message string
+}
+
+// compile returns the given rules with their patterns compiled.
+func compile(rules []Rule) ([]compiledRule, error) {
+	compiled := make([]compiledRule, 0, len(rules))
+	for i := range rules {
+		cr := compiledRule{
+			name:    rules[i].Name,
+			message: rules[i].Message,
+		}
+
+		var err error
+		if cr.match, err = regexp.Compile(rules[i].Match); err != nil {
+			return nil, errors.Wrapf(err, "compile match pattern of rule \"%s\"", rules[i].Name)
+		}
+
+		if rules[i].Require != "" {
+			if cr.require, err = regexp.Compile(rules[i].Require); err != nil {
+				return nil, errors.Wrapf(err, "compile require pattern of rule \"%s\"", rules[i].Name)
+			}
+		}
+
+		if cr.message == "" {
+			cr.message = fmt.Sprintf("comment matches \"%s\"", rules[i].Match)
+			if cr.require != nil {
+				cr.message += fmt.Sprintf(" but not \"%s\"", rules[i].Require)
+			}
+		}
+
+		compiled = append(compiled, cr)
+	}
+
+	return compiled, nil
+}
+
+// commentrules is the function that gets passed to the Analyzer which runs the actual
+// analysis for the commentrules linter on a set of files.
+func commentrules(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) || len(rules) == 0 {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	compiled, err := compile(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, file, compiled)
+	}
+
+	return nil, nil
+}
+
+// checkFile reports every line of every comment within the given file that breaks any of
+// the given rules, under the name of the rule.
+func checkFile(r reporter.Reporter, file *ast.File, rules []compiledRule) {
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			for _, l := range commentLines(c) {
+				for i := range rules {
+					if !rules[i].match.MatchString(l.text) {
+						continue
+					}
+
+					if rules[i].require != nil && rules[i].require.MatchString(l.text) {
+						continue
+					}
+
+					reporter.ReportRule(r, rules[i].name, l.pos, "%s", rules[i].message)
+				}
+			}
+		}
+	}
+}
+
+// line is a line of a comment without its comment markers.
+type line struct {
+	pos  token.Pos
+	text string
+}
+
+// commentLines returns the lines of the given comment without their comment markers or
+// surrounding whitespace, and without the leading asterisks of block comment lines.
+func commentLines(c *ast.Comment) []line {
+	if strings.HasPrefix(c.Text, "//") {
+		return []line{{pos: c.Pos(), text: strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))}}
+	}
+
+	text := strings.TrimSuffix(strings.TrimPrefix(c.Text, "/*"), "*/")
+
+	var lines []line
+	offset := len("/*")
+	for _, l := range strings.Split(text, "\n") {
+		lines = append(lines, line{
+			pos:  c.Pos() + token.Pos(offset),
+			text: strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(l), "*")),
+		})
+		offset += len(l) + len("\n")
+	}
+
+	return lines
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package commentrules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+type diagnosticRecorder struct {
+	fset        *token.FileSet
+	diagnostics []string
+}
+
+func (r *diagnosticRecorder) Reportf(_ token.Pos, _ string, _ ...interface{}) {
+	panic("diagnostics must be reported with their rule")
+}
+
+func (r *diagnosticRecorder) Report(d analysis.Diagnostic) {
+	position := r.fset.PositionFor(d.Pos, false)
+	r.diagnostics = append(r.diagnostics, position.String()+": "+d.Category+": "+d.Message)
+}
+
+func TestCheckFile(t *testing.T) {
+	src := `package foo
+
+// SAFETY: the pointer outlives the call.
+var a int
+
+// SAFETY:
+var b int
+
+/*
+ * SAFETY:
+ */
+var c int
+
+// HACK remove once the migration is done.
+var d int
+`
+
+	rules, err := compile([]Rule{
+		{
+			Name:    "safety",
+			Match:   `^SAFETY:`,
+			Require: `^SAFETY: \S`,
+			Message: "SAFETY comments must justify why the code is safe",
+		},
+		{
+			Name:  "hack",
+			Match: `^HACK\b`,
+		},
+	})
+	assert.NilError(t, err)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+	assert.NilError(t, err)
+
+	r := diagnosticRecorder{fset: fset}
+	checkFile(&r, file, rules)
+
+	assert.DeepEqual(t, r.diagnostics, []string{
+		"foo.go:6:1: safety: SAFETY comments must justify why the code is safe",
+		"foo.go:10:1: safety: SAFETY comments must justify why the code is safe",
+		"foo.go:14:1: hack: comment matches \"^HACK\\b\"",
+	})
+}
+
+func TestCompile(t *testing.T) {
+	_, err := compile([]Rule{{Name: "broken", Match: "("}})
+	assert.ErrorContains(t, err, "compile match pattern of rule \"broken\"")
+
+	_, err = compile([]Rule{{Name: "broken", Match: "a", Require: "["}})
+	assert.ErrorContains(t, err, "compile require pattern of rule \"broken\"")
+}
+
+func TestRulesFlag(t *testing.T) {
+	var r rulesFlag
+	assert.NilError(t, r.Set(`{"name":"safety","match":"^SAFETY:","severity":"warning"}`))
+	assert.NilError(t, r.Set(`{"name":"hack","match":"^HACK"}`))
+	assert.ErrorContains(t, r.Set(`safety`), "parse rule")
+
+	assert.DeepEqual(t, []Rule(r), []Rule{
+		{Name: "safety", Match: "^SAFETY:", Severity: SeverityWarning},
+		{Name: "hack", Match: "^HACK"},
+	})
+	assert.DeepEqual(t, r.warnings(), []string{"safety"})
+}
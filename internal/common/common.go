@@ -1,6 +1,7 @@
 // Copyright 2022 Outreach Corporation. All Rights Reserved.
 
-// Description: See package comment for this one file package.
+// Description: This file contains the constants and functions used by more than one linter
+// to decide what to lint.
 
 // Package common contains constants, functions, and types that are used in more than
 // one linter.
@@ -9,8 +10,10 @@ package common
 import (
 	"go/ast"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"golang.org/x/tools/go/analysis"
@@ -54,18 +57,324 @@ func IsGenerated(file *ast.File) bool {
 	return false
 }
 
-// IsTestFile returns true if the filename is either test_*.go or *_test.go.
+// DefaultTestFilePatterns are the patterns, matched against the base name of a file using
+// path.Match, that denote a test file unless configured otherwise.
+var DefaultTestFilePatterns = []string{"test_*.go", "*_test.go"}
+
+// DefaultTestPackageSuffixes are the package name suffixes that denote a test package
+// unless configured otherwise.
+var DefaultTestPackageSuffixes = []string{"test"}
+
+// TestDetection configures how IsTestFile and IsTestPackage detect tests.
+type TestDetection struct {
+	// FilePatterns are patterns denoting test files in addition to DefaultTestFilePatterns.
+	FilePatterns []string
+
+	// PackageSuffixes replaces DefaultTestPackageSuffixes when it is non-nil.
+	PackageSuffixes []string
+
+	// LintTests contains the names of the linters that lint tests rather than skipping them.
+	LintTests []string
+}
+
+// testDetection is the process-wide test detection configuration, see SetTestDetection.
+var testDetection = struct {
+	mu              sync.RWMutex
+	filePatterns    []string
+	packageSuffixes []string
+	lintTests       map[string]bool
+}{
+	filePatterns:    DefaultTestFilePatterns,
+	packageSuffixes: DefaultTestPackageSuffixes,
+}
+
+// SetTestDetection replaces the configuration IsTestFile and IsTestPackage detect tests
+// with.
+func SetTestDetection(td TestDetection) {
+	testDetection.mu.Lock()
+	defer testDetection.mu.Unlock()
+
+	testDetection.filePatterns = append(append([]string(nil), DefaultTestFilePatterns...), td.FilePatterns...)
+
+	testDetection.packageSuffixes = DefaultTestPackageSuffixes
+	if td.PackageSuffixes != nil {
+		testDetection.packageSuffixes = td.PackageSuffixes
+	}
+
+	testDetection.lintTests = make(map[string]bool, len(td.LintTests))
+	for _, linter := range td.LintTests {
+		testDetection.lintTests[linter] = true
+	}
+}
+
+// lintsTests reports whether or not the analyzer of the given pass has been configured to
+// lint tests rather than skip them. The caller must hold testDetection.mu.
+func lintsTests(pass *analysis.Pass) bool {
+	return pass.Analyzer != nil && testDetection.lintTests[pass.Analyzer.Name]
+}
+
+// IsTestFile returns true if the filename matches any of the test file patterns, which by
+// default are test_*.go and *_test.go. It always returns false for analyzers configured to
+// lint tests, see SetTestDetection.
 func IsTestFile(pass *analysis.Pass, file *ast.File) bool {
-	fn := filepath.Base(strings.TrimSuffix(pass.Fset.PositionFor(file.Package, false).Filename, ".go"))
-	return strings.HasPrefix(fn, "test_") || strings.HasSuffix(fn, "_test")
+	testDetection.mu.RLock()
+	defer testDetection.mu.RUnlock()
+
+	if lintsTests(pass) {
+		return false
+	}
+
+	return matchesTestFile(filepath.Base(pass.Fset.PositionFor(file.Package, false).Filename))
+}
+
+// matchesTestFile reports whether the given base filename matches any of the test file
+// patterns. The caller must hold testDetection.mu.
+func matchesTestFile(fn string) bool {
+	for _, pattern := range testDetection.filePatterns {
+		if matched, err := path.Match(pattern, fn); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
 }
 
 // IsTestPackage determines whether or not the package for the current pass is a test
 // package. The analysis.Analyzer is already smart enough to ignore "*_test.go" files,
 // but sometimes there are explicit packages only meant to be used in tests. These are,
-// or at least should be, suffixed with "test" (usually "_test").
+// or at least should be, suffixed with "test" (usually "_test"), though the suffixes can
+// be configured using SetTestDetection. It always returns false for analyzers configured
+// to lint tests.
 func IsTestPackage(pass *analysis.Pass) bool {
-	return strings.HasSuffix(pass.Pkg.Name(), "test")
+	testDetection.mu.RLock()
+	defer testDetection.mu.RUnlock()
+
+	if lintsTests(pass) {
+		return false
+	}
+
+	for _, suffix := range testDetection.packageSuffixes {
+		if strings.HasSuffix(pass.Pkg.Name(), suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lintGenerated is the process-wide set of linters that lint generated files, see
+// SetLintGenerated.
+var lintGenerated = struct {
+	mu      sync.RWMutex
+	linters map[string]bool
+}{}
+
+// SetLintGenerated sets the names of the linters that lint generated files rather than
+// skipping them, e.g. because legal requirements apply to them regardless.
+func SetLintGenerated(linters []string) {
+	lintGenerated.mu.Lock()
+	defer lintGenerated.mu.Unlock()
+
+	lintGenerated.linters = make(map[string]bool, len(linters))
+	for _, linter := range linters {
+		lintGenerated.linters[linter] = true
+	}
+}
+
+// LintsGenerated reports whether or not the analyzer of the given pass has been configured
+// to lint generated files rather than skip them, see SetLintGenerated.
+func LintsGenerated(pass *analysis.Pass) bool {
+	lintGenerated.mu.RLock()
+	defer lintGenerated.mu.RUnlock()
+
+	return pass.Analyzer != nil && lintGenerated.linters[pass.Analyzer.Name]
+}
+
+// DefaultIgnoredPaths are the path globs, see MatchGlob, of the files that are never linted
+// regardless of configuration since they aren't maintained as part of the module.
+var DefaultIgnoredPaths = []string{"**/vendor/**", "**/third_party/**", "**/testdata/**"}
+
+// ignoredPaths is the process-wide list of ignored path globs, see SetIgnoredPaths.
+var ignoredPaths = struct {
+	mu    sync.RWMutex
+	globs []string
+}{
+	globs: DefaultIgnoredPaths,
+}
+
+// SetIgnoredPaths sets the path globs, in addition to DefaultIgnoredPaths, of the files
+// that are never linted. Each glob is matched against the path of a file relative to the
+// working directory, which is usually the root of the module being linted.
+func SetIgnoredPaths(globs []string) {
+	ignoredPaths.mu.Lock()
+	defer ignoredPaths.mu.Unlock()
+
+	ignoredPaths.globs = append(append([]string(nil), DefaultIgnoredPaths...), globs...)
+}
+
+// IsIgnoredPath returns true if the given filename matches any of the ignored path globs.
+func IsIgnoredPath(filename string) bool {
+	if filename == "" {
+		return false
+	}
+	filename = RelativePath(filename)
+
+	ignoredPaths.mu.RLock()
+	defer ignoredPaths.mu.RUnlock()
+
+	for i, glob := range ignoredPaths.globs {
+		if !MatchGlob(glob, filename) {
+			continue
+		}
+
+		// Third-party code is linted even within the directories ignored by default, e.g.
+		// third_party, see SetThirdPartyPaths.
+		if i < len(DefaultIgnoredPaths) && IsThirdPartyPath(filename) {
+			continue
+		}
+		return true
+	}
+
+	return false
+}
+
+// thirdPartyPaths is the process-wide list of third-party path globs, see
+// SetThirdPartyPaths.
+var thirdPartyPaths = struct {
+	mu    sync.RWMutex
+	globs []string
+}{}
+
+// SetThirdPartyPaths sets the path globs of the files holding third-party code, e.g. code
+// copied from another project, whose issues are reported at info severity: they're visible
+// in reports, but never fail the run. Unlike ignored paths, see SetIgnoredPaths, issues
+// such as missing license headers in third-party code can still be tracked. Third-party
+// files are linted even within the directories of DefaultIgnoredPaths. Each glob is
+// matched against the path of a file relative to the working directory.
+func SetThirdPartyPaths(globs []string) {
+	thirdPartyPaths.mu.Lock()
+	defer thirdPartyPaths.mu.Unlock()
+
+	thirdPartyPaths.globs = append([]string(nil), globs...)
+}
+
+// IsThirdPartyPath returns true if the given filename matches any of the third-party path
+// globs, see SetThirdPartyPaths.
+func IsThirdPartyPath(filename string) bool {
+	if filename == "" {
+		return false
+	}
+	filename = RelativePath(filename)
+
+	thirdPartyPaths.mu.RLock()
+	defer thirdPartyPaths.mu.RUnlock()
+
+	for _, glob := range thirdPartyPaths.globs {
+		if MatchGlob(glob, filename) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RelativePath returns the given filename relative to the working directory, which is
+// usually the root of the module being linted, for matching against path globs. Filenames
+// outside of the working directory are returned as they are.
+func RelativePath(filename string) string {
+	if filepath.IsAbs(filename) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, filename); err == nil && !strings.HasPrefix(rel, "..") {
+				return rel
+			}
+		}
+	}
+
+	return filename
+}
+
+// changedFiles is the process-wide set of files issues are limited to, see SetChangedFiles.
+var changedFiles = struct {
+	mu    sync.RWMutex
+	files map[string]bool
+}{}
+
+// SetChangedFiles limits the issues that are reported to those in the given files, e.g.
+// the files changed on a branch, which are given as absolute paths. A nil slice lifts the
+// limit so that issues in every file are reported.
+func SetChangedFiles(files []string) {
+	changedFiles.mu.Lock()
+	defer changedFiles.mu.Unlock()
+
+	if files == nil {
+		changedFiles.files = nil
+		return
+	}
+
+	changedFiles.files = make(map[string]bool, len(files))
+	for _, file := range files {
+		changedFiles.files[filepath.Clean(file)] = true
+	}
+}
+
+// InScope returns true if issues in the given file are reported, which is the case for
+// every file unless they were limited with SetChangedFiles.
+func InScope(filename string) bool {
+	changedFiles.mu.RLock()
+	defer changedFiles.mu.RUnlock()
+
+	if changedFiles.files == nil {
+		return true
+	}
+
+	if abs, err := filepath.Abs(filename); err == nil {
+		filename = abs
+	}
+
+	return changedFiles.files[filename]
+}
+
+// SkipPackage returns true if the package for the current pass should not be linted at
+// all, which is the case for test packages.
+func SkipPackage(pass *analysis.Pass) bool {
+	return IsTestPackage(pass)
+}
+
+// SkipFile returns true if the given file should not be linted, which is the case for
+// generated files, test files, and files in ignored paths. Every linter consults this
+// before linting a file so that they all skip the same files. Generated files and test
+// files are only linted by the linters configured to, see SetLintGenerated and
+// SetTestDetection.
+func SkipFile(pass *analysis.Pass, file *ast.File) bool {
+	class := ClassifyFile(pass, file)
+	if class.Ignored || (class.Generated && !LintsGenerated(pass)) {
+		return true
+	}
+
+	if !class.Test {
+		return false
+	}
+
+	testDetection.mu.RLock()
+	defer testDetection.mu.RUnlock()
+
+	return !lintsTests(pass)
+}
+
+// FindGoMod returns the path of the go.mod file of the module the given directory belongs
+// to, found by looking in the directory and each of its parents, or an empty string if it
+// doesn't belong to a module.
+func FindGoMod(dir string) string {
+	for current := dir; ; current = filepath.Dir(current) {
+		candidate := filepath.Join(current, "go.mod")
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate
+		}
+
+		if parent := filepath.Dir(current); parent == current {
+			return ""
+		}
+	}
 }
 
 // LoadOtherFilesIntoFset loads all files found in *analysis.Pass.OtherFiles into the
@@ -83,3 +392,60 @@ func LoadOtherFilesIntoFset(pass *analysis.Pass) error {
 
 	return nil
 }
+
+// MatchGlob reports whether the given slash-separated path matches the given glob pattern.
+// Each path segment is matched using the syntax of path.Match, with the addition of the
+// "**" segment which matches zero or more path segments, e.g. "pkg/public/**" matches
+// "pkg/public" as well as everything beneath it. A malformed pattern never matches.
+func MatchGlob(pattern, name string) bool {
+	return matchGlobSegments(splitGlobPath(pattern), splitGlobPath(name))
+}
+
+// ValidateGlob returns an error if the given glob pattern is malformed.
+func ValidateGlob(pattern string) error {
+	for _, segment := range splitGlobPath(pattern) {
+		if _, err := path.Match(segment, ""); err != nil {
+			return errors.Wrapf(err, "invalid glob pattern \"%s\"", pattern)
+		}
+	}
+
+	return nil
+}
+
+// splitGlobPath splits a slash-separated path into its segments, the current directory
+// having no segments at all.
+func splitGlobPath(name string) []string {
+	name = path.Clean(filepath.ToSlash(name))
+	if name == "." {
+		return nil
+	}
+	return strings.Split(strings.TrimPrefix(name, "/"), "/")
+}
+
+// matchGlobSegments matches path segments against glob pattern segments, see MatchGlob.
+func matchGlobSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// "**" matches zero or more segments, try to match the rest of the pattern against
+			// every possible remainder of the path.
+			for i := 0; i <= len(name); i++ {
+				if matchGlobSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if matched, err := path.Match(pattern[0], name[0]); err != nil || !matched {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
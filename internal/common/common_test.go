@@ -0,0 +1,251 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package common
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tt := []struct {
+		name     string
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "Matches an exact path",
+			pattern:  "internal/legacy",
+			path:     "internal/legacy",
+			expected: true,
+		},
+		{
+			name:     "Matches a single segment wildcard",
+			pattern:  "internal/*/api",
+			path:     "internal/foo/api",
+			expected: true,
+		},
+		{
+			name:     "Does not match a single segment wildcard across segments",
+			pattern:  "internal/*",
+			path:     "internal/foo/api",
+			expected: false,
+		},
+		{
+			name:     "Matches the directory itself with a trailing double star",
+			pattern:  "pkg/public/**",
+			path:     "pkg/public",
+			expected: true,
+		},
+		{
+			name:     "Matches nested directories with a trailing double star",
+			pattern:  "pkg/public/**",
+			path:     "pkg/public/foo/bar",
+			expected: true,
+		},
+		{
+			name:     "Matches a leading double star",
+			pattern:  "**/testdata",
+			path:     "internal/foo/testdata",
+			expected: true,
+		},
+		{
+			name:     "Matches the current directory with a double star",
+			pattern:  "**",
+			path:     ".",
+			expected: true,
+		},
+		{
+			name:     "Does not match a sibling directory",
+			pattern:  "pkg/public/**",
+			path:     "pkg/publicity",
+			expected: false,
+		},
+		{
+			name:     "Does not match with a malformed pattern",
+			pattern:  "pkg/[",
+			path:     "pkg/[",
+			expected: false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, MatchGlob(test.pattern, test.path), test.expected)
+		})
+	}
+}
+
+func TestIsTestFile(t *testing.T) {
+	tt := []struct {
+		name      string
+		filename  string
+		detection TestDetection
+		expected  bool
+	}{
+		{
+			name:     "Detects _test suffixed files by default",
+			filename: "foo_test.go",
+			expected: true,
+		},
+		{
+			name:     "Detects test_ prefixed files by default",
+			filename: "test_foo.go",
+			expected: true,
+		},
+		{
+			name:     "Ignores other files by default",
+			filename: "foo_it.go",
+			expected: false,
+		},
+		{
+			name:      "Detects files matching extra patterns",
+			filename:  "foo_it.go",
+			detection: TestDetection{FilePatterns: []string{"*_it.go"}},
+			expected:  true,
+		},
+		{
+			name:      "Never detects tests for linters that lint them",
+			filename:  "foo_test.go",
+			detection: TestDetection{LintTests: []string{"linter"}},
+			expected:  false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetTestDetection(test.detection)
+			t.Cleanup(func() { SetTestDetection(TestDetection{}) })
+
+			fset := token.NewFileSet()
+			tf := fset.AddFile(test.filename, -1, 1)
+			pass := &analysis.Pass{
+				Analyzer: &analysis.Analyzer{Name: "linter"},
+				Fset:     fset,
+			}
+
+			assert.Equal(t, IsTestFile(pass, &ast.File{Package: tf.Pos(0)}), test.expected)
+		})
+	}
+}
+
+func TestIsTestPackage(t *testing.T) {
+	tt := []struct {
+		name      string
+		pkg       string
+		detection TestDetection
+		expected  bool
+	}{
+		{
+			name:     "Detects test suffixed packages by default",
+			pkg:      "foo_test",
+			expected: true,
+		},
+		{
+			name:     "Ignores other packages by default",
+			pkg:      "testutils",
+			expected: false,
+		},
+		{
+			name:      "Configured suffixes replace the defaults",
+			pkg:       "testutils",
+			detection: TestDetection{PackageSuffixes: []string{"testutils"}},
+			expected:  true,
+		},
+		{
+			name:      "Never detects tests for linters that lint them",
+			pkg:       "foo_test",
+			detection: TestDetection{LintTests: []string{"linter"}},
+			expected:  false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetTestDetection(test.detection)
+			t.Cleanup(func() { SetTestDetection(TestDetection{}) })
+
+			pass := &analysis.Pass{
+				Analyzer: &analysis.Analyzer{Name: "linter"},
+				Pkg:      types.NewPackage("example.com/"+test.pkg, test.pkg),
+			}
+
+			assert.Equal(t, IsTestPackage(pass), test.expected)
+		})
+	}
+}
+
+func TestIsIgnoredPath(t *testing.T) {
+	tt := []struct {
+		name       string
+		filename   string
+		globs      []string
+		thirdParty []string
+		expected   bool
+	}{
+		{
+			name:     "Ignores vendored files",
+			filename: "vendor/github.com/foo/bar/bar.go",
+			expected: true,
+		},
+		{
+			name:     "Ignores testdata at any depth",
+			filename: "internal/foo/testdata/src/a/a.go",
+			expected: true,
+		},
+		{
+			name:     "Lints other files by default",
+			filename: "internal/foo/foo.go",
+			expected: false,
+		},
+		{
+			name:     "Ignores files matching configured globs",
+			filename: "internal/gen/api.go",
+			globs:    []string{"internal/gen/**"},
+			expected: true,
+		},
+		{
+			name:       "Lints third-party files in directories ignored by default",
+			filename:   "third_party/foo/foo.go",
+			thirdParty: []string{"third_party/foo/**"},
+			expected:   false,
+		},
+		{
+			name:       "Ignores third-party files matching configured globs",
+			filename:   "third_party/foo/foo.go",
+			globs:      []string{"third_party/foo/**"},
+			thirdParty: []string{"third_party/foo/**"},
+			expected:   true,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetIgnoredPaths(test.globs)
+			SetThirdPartyPaths(test.thirdParty)
+			t.Cleanup(func() {
+				SetIgnoredPaths(nil)
+				SetThirdPartyPaths(nil)
+			})
+
+			assert.Equal(t, IsIgnoredPath(test.filename), test.expected)
+		})
+	}
+}
+
+func TestInScope(t *testing.T) {
+	assert.Equal(t, InScope("/src/foo/foo.go"), true)
+
+	SetChangedFiles([]string{"/src/foo/foo.go", "/src/bar/../bar/bar.go"})
+	t.Cleanup(func() { SetChangedFiles(nil) })
+
+	assert.Equal(t, InScope("/src/foo/foo.go"), true)
+	assert.Equal(t, InScope("/src/bar/bar.go"), true)
+	assert.Equal(t, InScope("/src/foo/other.go"), false)
+}
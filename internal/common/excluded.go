@@ -0,0 +1,115 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements parsing the Go files of a package that are excluded
+// by build constraints, so that linters that don't need type information can lint them.
+
+package common
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// lintExcluded is the process-wide switch for linting the Go files excluded by build
+// constraints, see SetLintExcluded.
+var lintExcluded = struct {
+	mu      sync.RWMutex
+	enabled bool
+}{}
+
+// SetLintExcluded sets whether or not the Go files of a package that are excluded by build
+// constraints, e.g. by a //go:build ignore line, are returned by ExcludedFiles so that the
+// linters that only look at comments lint them as well.
+func SetLintExcluded(enabled bool) {
+	lintExcluded.mu.Lock()
+	defer lintExcluded.mu.Unlock()
+
+	lintExcluded.enabled = enabled
+}
+
+// LintsExcluded reports whether or not the Go files excluded by build constraints are
+// linted, see SetLintExcluded.
+func LintsExcluded() bool {
+	lintExcluded.mu.RLock()
+	defer lintExcluded.mu.RUnlock()
+
+	return lintExcluded.enabled
+}
+
+// excludedFileKey identifies a parsed excluded file. Files are parsed once per FileSet so
+// that every linter, and every package the file is reported for, sees the same positions.
+type excludedFileKey struct {
+	fset     *token.FileSet
+	filename string
+}
+
+// excludedFiles caches the parsed excluded files, see ExcludedFiles. Files that failed to
+// parse are cached as nil.
+var excludedFiles = struct {
+	mu    sync.Mutex
+	files map[excludedFileKey]*ast.File
+}{
+	files: make(map[excludedFileKey]*ast.File),
+}
+
+// ExcludedFiles returns the Go files of the package of the given pass that are excluded by
+// build constraints, which drivers list in pass.IgnoredFiles (and some in pass.OtherFiles),
+// parsed with their comments into pass.Fset. These files aren't type checked, so only
+// linters that look at nothing but syntax and comments can lint them. Files that don't
+// parse are left out. It returns nil unless enabled with SetLintExcluded.
+func ExcludedFiles(pass *analysis.Pass) []*ast.File {
+	if !LintsExcluded() {
+		return nil
+	}
+
+	return ParseExcludedFiles(pass)
+}
+
+// ParseExcludedFiles returns the same files as ExcludedFiles whether or not they're enabled
+// with SetLintExcluded, for linters whose policy applies to every file regardless of the
+// build configuration, e.g. copyright.
+func ParseExcludedFiles(pass *analysis.Pass) []*ast.File {
+	excludedFiles.mu.Lock()
+	defer excludedFiles.mu.Unlock()
+
+	var files []*ast.File
+	seen := make(map[string]bool)
+	for _, filename := range append(append([]string(nil), pass.IgnoredFiles...), pass.OtherFiles...) {
+		if !strings.HasSuffix(filename, ".go") || seen[filename] {
+			continue
+		}
+		seen[filename] = true
+
+		key := excludedFileKey{fset: pass.Fset, filename: filename}
+		file, ok := excludedFiles.files[key]
+		if !ok {
+			parsed, err := parser.ParseFile(pass.Fset, filename, nil, parser.ParseComments)
+			if err == nil {
+				file = parsed
+			}
+			excludedFiles.files[key] = file
+		}
+
+		if file != nil {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+// FilesWithExcluded returns the files of the package of the given pass followed by the Go
+// files excluded by build constraints, see ExcludedFiles.
+func FilesWithExcluded(pass *analysis.Pass) []*ast.File {
+	excluded := ExcludedFiles(pass)
+	if len(excluded) == 0 {
+		return pass.Files
+	}
+
+	return append(append([]*ast.File(nil), pass.Files...), excluded...)
+}
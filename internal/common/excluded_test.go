@@ -0,0 +1,48 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package common
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+func TestExcludedFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"ignore.go":  "//go:build ignore\n\n// Copyright\npackage main\n",
+		"broken.go":  "//go:build ignore\n\npackage\n",
+		"schema.sql": "-- Copyright\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pass := &analysis.Pass{
+		Fset:         token.NewFileSet(),
+		IgnoredFiles: []string{filepath.Join(dir, "ignore.go"), filepath.Join(dir, "broken.go")},
+		OtherFiles:   []string{filepath.Join(dir, "schema.sql"), filepath.Join(dir, "ignore.go")},
+	}
+
+	assert.Equal(t, len(ExcludedFiles(pass)), 0)
+	assert.Equal(t, len(ParseExcludedFiles(pass)), 1, "excluded files regardless of SetLintExcluded")
+
+	SetLintExcluded(true)
+	t.Cleanup(func() { SetLintExcluded(false) })
+
+	excluded := ExcludedFiles(pass)
+	assert.Equal(t, len(excluded), 1)
+	assert.Equal(t, excluded[0].Name.Name, "main")
+	assert.Equal(t, len(excluded[0].Comments), 2)
+
+	// Every linter sees the same file, so the positions of its issues line up.
+	assert.Equal(t, ExcludedFiles(pass)[0], excluded[0])
+	assert.DeepEqual(t, FilesWithExcluded(pass), excluded)
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the analyzer that classifies each file of a package
+// once for every linter that needs to know which files to skip.
+
+package common
+
+import (
+	"go/ast"
+	"go/build/constraint"
+	"go/token"
+	"path/filepath"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// FileClass is the classification of a single file of a package.
+type FileClass struct {
+	// Generated denotes whether or not the file was generated, see IsGenerated.
+	Generated bool
+
+	// Test denotes whether or not the file matches the test file patterns, see
+	// SetTestDetection. Unlike IsTestFile, this doesn't account for linters that lint tests.
+	Test bool
+
+	// Constrained denotes whether or not the file has a //go:build constraint, meaning it
+	// is only built for some configurations.
+	Constrained bool
+
+	// Ignored denotes whether or not the file is in an ignored path, see IsIgnoredPath.
+	Ignored bool
+
+	// Markers contains the markers the file may contain, see HasMarker.
+	Markers Markers
+}
+
+// FileClasses maps each file of a package to its classification.
+type FileClasses map[*ast.File]FileClass
+
+// FilesAnalyzer is the analyzer that classifies each file of a package. Linters list it in
+// their Requires so that SkipFile and ClassifyFile can look up the classification of a
+// file in its result rather than every linter classifying every file itself.
+var FilesAnalyzer = analysis.Analyzer{
+	Name:       "fileclasses",
+	Doc:        "Classifies the files of a package and scans them for markers, for the lintroller linters.",
+	Run:        classifyFiles,
+	ResultType: reflect.TypeOf(FileClasses(nil)),
+}
+
+// classifyFiles is the function that gets passed to FilesAnalyzer which builds the
+// FileClasses of a package.
+func classifyFiles(pass *analysis.Pass) (interface{}, error) {
+	classes := make(FileClasses, len(pass.Files))
+	for _, file := range pass.Files {
+		class := classifyFile(pass.Fset, file)
+		class.Markers = scanFile(pass.Fset, file, pass.ReadFile)
+		classes[file] = class
+	}
+
+	return classes, nil
+}
+
+// ClassifyFile returns the classification of the given file of the package of the given
+// pass, using the result of FilesAnalyzer when the analyzer of the pass requires it.
+func ClassifyFile(pass *analysis.Pass, file *ast.File) FileClass {
+	if classes, ok := pass.ResultOf[&FilesAnalyzer].(FileClasses); ok {
+		if class, ok := classes[file]; ok {
+			return class
+		}
+	}
+
+	return classifyFile(pass.Fset, file)
+}
+
+// classifyFile returns the classification of the given file, which may contain every
+// marker since its source isn't scanned, see scanFile.
+func classifyFile(fset *token.FileSet, file *ast.File) FileClass {
+	filename := fset.PositionFor(file.Package, false).Filename
+
+	testDetection.mu.RLock()
+	test := matchesTestFile(filepath.Base(filename))
+	testDetection.mu.RUnlock()
+
+	return FileClass{
+		Generated:   IsGenerated(file),
+		Test:        test,
+		Constrained: hasBuildConstraint(file),
+		Ignored:     IsIgnoredPath(filename),
+		Markers:     AllMarkers,
+	}
+}
+
+// hasBuildConstraint reports whether or not the given file has a //go:build constraint,
+// which must appear before its package clause.
+func hasBuildConstraint(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+
+		for _, comment := range group.List {
+			if constraint.IsGoBuild(comment.Text) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package common
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+func TestClassifyFile(t *testing.T) {
+	tt := []struct {
+		name     string
+		filename string
+		src      string
+		expected FileClass
+	}{
+		{
+			name:     "Classifies regular files",
+			filename: "foo.go",
+			src:      "package foo\n",
+			expected: FileClass{Markers: AllMarkers},
+		},
+		{
+			name:     "Classifies generated files",
+			filename: "foo.go",
+			src:      "// Code generated by foo. DO NOT EDIT.\n\npackage foo\n",
+			expected: FileClass{Generated: true, Markers: AllMarkers},
+		},
+		{
+			name:     "Classifies test files",
+			filename: "foo_test.go",
+			src:      "package foo\n",
+			expected: FileClass{Test: true, Markers: AllMarkers},
+		},
+		{
+			name:     "Classifies constrained files",
+			filename: "foo_linux.go",
+			src:      "//go:build linux\n\npackage foo\n",
+			expected: FileClass{Constrained: true, Markers: AllMarkers},
+		},
+		{
+			name:     "Ignores constraints after the package clause",
+			filename: "foo.go",
+			src:      "package foo\n\n//go:build linux\n",
+			expected: FileClass{Markers: AllMarkers},
+		},
+		{
+			name:     "Classifies files in ignored paths",
+			filename: "vendor/foo/foo.go",
+			src:      "package foo\n",
+			expected: FileClass{Ignored: true, Markers: AllMarkers},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, test.filename, test.src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			assert.Equal(t, ClassifyFile(&analysis.Pass{Fset: fset}, file), test.expected)
+		})
+	}
+}
+
+func TestSkipFileUsesFilesAnalyzer(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", "package foo\n", 0)
+	assert.NilError(t, err)
+
+	pass := &analysis.Pass{
+		Analyzer: &analysis.Analyzer{Name: "linter"},
+		Fset:     fset,
+		ResultOf: map[*analysis.Analyzer]interface{}{
+			&FilesAnalyzer: FileClasses{file: {Test: true}},
+		},
+	}
+	assert.Equal(t, SkipFile(pass, file), true)
+
+	SetTestDetection(TestDetection{LintTests: []string{"linter"}})
+	t.Cleanup(func() { SetTestDetection(TestDetection{}) })
+	assert.Equal(t, SkipFile(pass, file), false)
+
+	// Files missing from the result are classified on the spot.
+	assert.Equal(t, SkipFile(pass, &ast.File{Package: file.Package}), false)
+}
+
+func TestSkipFileLintsGenerated(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", "// Code generated by foo. DO NOT EDIT.\n\npackage foo\n", parser.ParseComments)
+	assert.NilError(t, err)
+
+	pass := &analysis.Pass{Analyzer: &analysis.Analyzer{Name: "copyright"}, Fset: fset}
+	assert.Equal(t, SkipFile(pass, file), true)
+
+	SetLintGenerated([]string{"copyright"})
+	t.Cleanup(func() { SetLintGenerated(nil) })
+	assert.Equal(t, SkipFile(pass, file), false)
+}
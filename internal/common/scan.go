@@ -0,0 +1,92 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the pre-scan of the raw source of each file for the
+// markers the comment-based linters look for, so they can skip the files without any.
+
+package common
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"os"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Markers is a set of the markers the comment-based linters look for in the source of a
+// file, see scanMarkers.
+type Markers uint8
+
+// The markers found by scanMarkers.
+const (
+	// MarkerTodo is the marker of a file that may contain a TODO comment.
+	MarkerTodo Markers = 1 << iota
+
+	// MarkerNoLint is the marker of a file that may contain a nolint directive.
+	MarkerNoLint
+
+	// MarkerHeader is the marker of a file that may contain fields in its header, the
+	// comments before its package clause, e.g. "Description: ...".
+	MarkerHeader
+
+	// AllMarkers is the set of every marker, which is what a file that hasn't been scanned
+	// may contain.
+	AllMarkers = MarkerTodo | MarkerNoLint | MarkerHeader
+)
+
+// HasMarker reports whether or not the given file of the package of the given pass may
+// contain the given marker, according to the scan done by FilesAnalyzer. Linters skip the
+// files that don't, rather than walking every one of their comments. Files that weren't
+// scanned, e.g. when the analyzer of the pass doesn't require FilesAnalyzer, may contain
+// every marker.
+func HasMarker(pass *analysis.Pass, file *ast.File, marker Markers) bool {
+	return ClassifyFile(pass, file).Markers&marker != 0
+}
+
+// scanFile returns the markers found in the raw source of the given file, read with the
+// given function, or AllMarkers if it can't be read or has changed since it was parsed.
+// The position of its package clause is found using the line table of the file, so that
+// header fields are only looked for before it.
+func scanFile(fset *token.FileSet, file *ast.File, readFile func(filename string) ([]byte, error)) Markers {
+	tf := fset.File(file.Package)
+	if tf == nil {
+		return AllMarkers
+	}
+
+	if readFile == nil {
+		readFile = os.ReadFile
+	}
+
+	src, err := readFile(tf.Name())
+	if err != nil || len(src) != tf.Size() {
+		return AllMarkers
+	}
+
+	return scanMarkers(src, tf.Offset(file.Package))
+}
+
+// scanMarkers returns the markers found in the given source of a file whose package clause
+// starts at the given offset. The raw bytes are searched rather than the comments, which
+// is far cheaper but may find markers outside of comments, e.g. in string literals, so a
+// file with a marker may turn out to have nothing to report. A file without one never has.
+func scanMarkers(src []byte, packageOffset int) Markers {
+	var markers Markers
+
+	if bytes.Contains(src, []byte("TODO")) {
+		markers |= MarkerTodo
+	}
+
+	if bytes.Contains(src, []byte("nolint")) {
+		markers |= MarkerNoLint
+	}
+
+	if packageOffset > len(src) {
+		packageOffset = len(src)
+	}
+	if bytes.Contains(src[:packageOffset], []byte(": ")) {
+		markers |= MarkerHeader
+	}
+
+	return markers
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package common
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestScanFile(t *testing.T) {
+	tt := []struct {
+		name     string
+		src      string
+		read     string
+		readErr  error
+		expected Markers
+	}{
+		{
+			name:     "Finds no markers in plain files",
+			src:      "// Package foo does a thing.\npackage foo\n",
+			expected: 0,
+		},
+		{
+			name:     "Finds TODO comments",
+			src:      "package foo\n\n// TODO(foo): Do a thing.\n",
+			expected: MarkerTodo,
+		},
+		{
+			name:     "Finds nolint directives",
+			src:      "package foo\n\nvar x = 1 //nolint:gochecknoglobals // Why: Because.\n",
+			expected: MarkerNoLint,
+		},
+		{
+			name:     "Finds header fields before the package clause",
+			src:      "// Copyright 2026 Outreach Corporation. All Rights Reserved.\n\n// Description: A thing.\n\npackage foo\n",
+			expected: MarkerHeader,
+		},
+		{
+			name:     "Ignores header fields after the package clause",
+			src:      "package foo\n\n// Description: A thing.\n",
+			expected: 0,
+		},
+		{
+			name:     "Assumes every marker in files that can't be read",
+			src:      "package foo\n",
+			readErr:  errors.New("not found"),
+			expected: AllMarkers,
+		},
+		{
+			name:     "Assumes every marker in files changed since they were parsed",
+			src:      "package foo\n",
+			read:     "package foo\n\n// TODO(foo): Do a thing.\n",
+			expected: AllMarkers,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", test.src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			read := test.read
+			if read == "" {
+				read = test.src
+			}
+
+			markers := scanFile(fset, file, func(filename string) ([]byte, error) {
+				assert.Equal(t, filename, "foo.go")
+				return []byte(read), test.readErr
+			})
+			assert.Equal(t, markers, test.expected)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package companion implements the checks of the header and copyright linters for the
+// non-Go files that live alongside Go code in a module, e.g. .proto, .sh, and .sql files.
+// The analysis framework only covers .go files, so rather than being an analyzer this is
+// a plain file-based checker that the driver runs over every module it loads packages
+// from.
+//
+// Only line comments are considered. The header and copyright of a file are read from the
+// comments at the very top of the file, after the shebang line of a shell script.
+package companion
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/pkg/errors"
+)
+
+// CommentPrefixes maps the extensions of the companion files that can be checked to the
+// prefix of a line comment in files with that extension.
+var CommentPrefixes = map[string]string{
+	".proto": "//",
+	".sh":    "#",
+	".sql":   "--",
+}
+
+// DefaultExtensions are the extensions of the companion files that are checked when no
+// extensions are configured.
+var DefaultExtensions = []string{".proto", ".sh", ".sql"}
+
+// Names of the linters that issues found by a Checker are reported as.
+const (
+	// LinterHeader is the name of the header linter.
+	LinterHeader = "header"
+
+	// LinterCopyright is the name of the copyright linter.
+	LinterCopyright = "copyright"
+)
+
+// Rules are the requirements companion files are checked against, mirroring the options
+// of the header and copyright linters.
+type Rules struct {
+	// Fields are the fields required to be filled out in the header of each file. No
+	// header is required when empty.
+	Fields []string
+
+	// CopyrightText is the copyright string required as the first comment of each file.
+	CopyrightText string
+
+	// CopyrightPattern is the copyright regular expression required to match the first
+	// comment of each file. It takes precedence over CopyrightText. No copyright is
+	// required when both are empty.
+	CopyrightPattern string
+}
+
+// Issue is a single issue found in a companion file.
+type Issue struct {
+	// Linter is the name of the linter the issue is reported as, LinterHeader or
+	// LinterCopyright.
+	Linter string
+
+	// Line is the line of the file the issue is reported at.
+	Line int
+
+	// Message describes the issue.
+	Message string
+}
+
+// Checker checks companion files against a set of Rules.
+type Checker struct {
+	fields  []string
+	text    string
+	pattern *regexp.Regexp
+}
+
+// New returns a Checker for the given rules, or an error if the copyright pattern isn't a
+// valid regular expression.
+func New(rules *Rules) (*Checker, error) {
+	c := Checker{
+		text: strings.TrimSpace(rules.CopyrightText),
+	}
+
+	for _, field := range rules.Fields {
+		if field = strings.TrimSpace(field); field != "" {
+			c.fields = append(c.fields, field)
+		}
+	}
+
+	if pattern := strings.TrimSpace(rules.CopyrightPattern); pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "compile copyright pattern")
+		}
+		c.pattern = re
+	}
+
+	return &c, nil
+}
+
+// commentLine is a single line comment at the top of a companion file.
+type commentLine struct {
+	// line is the line number of the comment.
+	line int
+
+	// text is the text of the comment without its prefix and surrounding space.
+	text string
+}
+
+// Check returns the issues found in the companion file with the given name and content.
+// Generated files, and files with an extension that isn't in CommentPrefixes, never have
+// any issues.
+func (c *Checker) Check(filename string, content []byte) []Issue {
+	prefix, ok := CommentPrefixes[filepath.Ext(filename)]
+	if !ok {
+		return nil
+	}
+
+	groups, firstLine, bodyLine := leadingComments(content, prefix)
+	for _, group := range groups {
+		for _, comment := range group {
+			if strings.Contains(strings.ToLower(comment.text), "code generated") {
+				return nil
+			}
+		}
+	}
+
+	var issues []Issue
+
+	if c.pattern != nil || c.text != "" {
+		var found bool
+		if len(groups) > 0 && groups[0][0].line == firstLine {
+			if c.pattern != nil {
+				found = c.pattern.MatchString(groups[0][0].text)
+			} else {
+				found = groups[0][0].text == c.text
+			}
+		}
+
+		if !found {
+			matchType, literal := "string", c.text
+			if c.pattern != nil {
+				matchType, literal = "regular expression", c.pattern.String()
+			}
+
+			issues = append(issues, Issue{
+				Linter: LinterCopyright,
+				Line:   firstLine,
+				Message: fmt.Sprintf("file \"%s\" does not contain the required copyright %s [%s] (sans-brackets) as a comment on line %d",
+					filename, matchType, literal, firstLine),
+			})
+		}
+	}
+
+	for _, field := range c.missingFields(groups) {
+		issues = append(issues, Issue{
+			Linter: LinterHeader,
+			Line:   bodyLine,
+			Message: fmt.Sprintf("file \"%s\" does not contain the required header key \"%s\" and corresponding value "+
+				"in the comments at the top of the file", filename, field),
+		})
+	}
+
+	return issues
+}
+
+// missingFields returns the header fields that aren't filled out in the given comment
+// groups. Like the header linter, every field must be found within the same group.
+func (c *Checker) missingFields(groups [][]commentLine) []string {
+	if len(c.fields) == 0 {
+		return nil
+	}
+
+	valid := make(map[string]bool, len(c.fields))
+	for _, group := range groups {
+		var numFound int
+		for _, field := range c.fields {
+			for _, comment := range group {
+				if strings.Contains(comment.text, field+": ") || strings.HasPrefix(comment.text, field+":") {
+					numFound++
+					break
+				}
+			}
+		}
+
+		if numFound != len(c.fields) {
+			continue
+		}
+
+		for _, comment := range group {
+			for _, field := range c.fields {
+				if value, ok := strings.CutPrefix(comment.text, field+":"); ok && strings.TrimSpace(value) != "" {
+					valid[field] = true
+				}
+			}
+		}
+
+		// We found a comment group containing all fields, we don't need to search any further.
+		break
+	}
+
+	var missing []string
+	for _, field := range c.fields {
+		if !valid[field] {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
+
+// leadingComments returns the groups of line comments, separated by blank lines, at the
+// top of the given content along with the line the comments are expected to start on, which
+// is after the shebang line if there is one, and the first line that isn't a comment.
+func leadingComments(content []byte, prefix string) (groups [][]commentLine, firstLine, bodyLine int) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+
+	firstLine = 1
+	var group []commentLine
+	var line int
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+
+		if line == 1 && strings.HasPrefix(text, "#!") {
+			firstLine = 2
+			continue
+		}
+
+		switch {
+		case text == "":
+			if len(group) > 0 {
+				groups = append(groups, group)
+				group = nil
+			}
+		case strings.HasPrefix(text, prefix):
+			group = append(group, commentLine{
+				line: line,
+				text: strings.TrimSpace(strings.TrimPrefix(text, prefix)),
+			})
+		default:
+			if len(group) > 0 {
+				groups = append(groups, group)
+			}
+			return groups, firstLine, line
+		}
+	}
+
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+
+	// The file is nothing but comments, report at the line after the last one.
+	return groups, firstLine, line + 1
+}
+
+// Find returns the companion files with the given extensions within the module rooted at
+// the given directory. Hidden directories, nested modules, and ignored paths (see
+// common.IsIgnoredPath) are skipped.
+func Find(root string, extensions []string) ([]string, error) {
+	wanted := make(map[string]bool, len(extensions))
+	for _, ext := range extensions {
+		wanted[ext] = true
+	}
+
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path == root {
+				return nil
+			}
+
+			if strings.HasPrefix(d.Name(), ".") || common.IsIgnoredPath(path) {
+				return filepath.SkipDir
+			}
+
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				// Nested modules are checked along with the packages loaded from them.
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if wanted[filepath.Ext(path)] && !common.IsIgnoredPath(path) {
+			files = append(files, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "find companion files in \"%s\"", root)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package companion
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCheck(t *testing.T) {
+	c, err := New(&Rules{
+		Fields:           []string{"Description"},
+		CopyrightPattern: `^Copyright 20[2-9][0-9] Outreach Corporation\. All Rights Reserved\.$`,
+	})
+	assert.NilError(t, err)
+
+	tt := []struct {
+		name     string
+		filename string
+		content  string
+		expected []Issue
+	}{
+		{
+			name:     "Accepts a proto file with a copyright and header",
+			filename: "api/foo.proto",
+			content: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: Defines the foo service.
+syntax = "proto3";
+`,
+		},
+		{
+			name:     "Accepts a shell script with a shebang",
+			filename: "scripts/gen.sh",
+			content: `#!/usr/bin/env bash
+# Copyright 2026 Outreach Corporation. All Rights Reserved.
+# Description: Generates the foo client.
+set -e
+`,
+		},
+		{
+			name:     "Ignores generated files",
+			filename: "schema.sql",
+			content: `-- Code generated by sqlc. DO NOT EDIT.
+SELECT 1;
+`,
+		},
+		{
+			name:     "Ignores unknown extensions",
+			filename: "README.md",
+			content:  "# Hello\n",
+		},
+		{
+			name:     "Rejects a missing copyright and header",
+			filename: "schema.sql",
+			content: `CREATE TABLE foo (id INT);
+`,
+			expected: []Issue{
+				{
+					Linter: LinterCopyright,
+					Line:   1,
+					Message: "file \"schema.sql\" does not contain the required copyright regular expression " +
+						"[^Copyright 20[2-9][0-9] Outreach Corporation\\. All Rights Reserved\\.$] (sans-brackets) as a comment on line 1",
+				},
+				{
+					Linter: LinterHeader,
+					Line:   1,
+					Message: "file \"schema.sql\" does not contain the required header key \"Description\" and corresponding value " +
+						"in the comments at the top of the file",
+				},
+			},
+		},
+		{
+			name:     "Rejects a copyright that isn't on the first line",
+			filename: "scripts/gen.sh",
+			content: `#!/usr/bin/env bash
+
+# Copyright 2026 Outreach Corporation. All Rights Reserved.
+# Description: Generates the foo client.
+set -e
+`,
+			expected: []Issue{
+				{
+					Linter: LinterCopyright,
+					Line:   2,
+					Message: "file \"scripts/gen.sh\" does not contain the required copyright regular expression " +
+						"[^Copyright 20[2-9][0-9] Outreach Corporation\\. All Rights Reserved\\.$] (sans-brackets) as a comment on line 2",
+				},
+			},
+		},
+		{
+			name:     "Rejects a header field without a value",
+			filename: "api/foo.proto",
+			content: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+// Description:
+syntax = "proto3";
+`,
+			expected: []Issue{
+				{
+					Linter: LinterHeader,
+					Line:   3,
+					Message: "file \"api/foo.proto\" does not contain the required header key \"Description\" and corresponding value " +
+						"in the comments at the top of the file",
+				},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, c.Check(test.filename, []byte(test.content)), test.expected)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the shields.io badge that summarizes the tier of a
+// repository and whether or not its last run was free of errors.
+
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+)
+
+// BadgeLabel is the label on the left-hand side of every badge.
+const BadgeLabel = "lintroller"
+
+// Colors used by badges, see https://shields.io/badges/endpoint-badge.
+const (
+	// BadgeColorPassing is the color of a badge for a run without any findings.
+	BadgeColorPassing = "brightgreen"
+
+	// BadgeColorWarnings is the color of a badge for a run with warnings but no errors.
+	BadgeColorWarnings = "yellow"
+
+	// BadgeColorFailing is the color of a badge for a run with errors.
+	BadgeColorFailing = "red"
+)
+
+// Badge is the JSON document read by the shields.io endpoint badge, which repositories
+// publish from CI to show their tier and lint status in their README.
+type Badge struct {
+	// SchemaVersion is the version of the endpoint badge schema, which is always 1.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Label is the text on the left-hand side of the badge.
+	Label string `json:"label"`
+
+	// Message is the text on the right-hand side of the badge, the tier followed by
+	// whether or not the run passed and the number of findings.
+	Message string `json:"message"`
+
+	// Color is the color of the right-hand side of the badge.
+	Color string `json:"color"`
+}
+
+// NewBadge returns the badge for a run with the given tier and summary. A run passes when
+// no errors were reported, warnings are counted but don't fail it. An empty tier is shown
+// as "untiered".
+func NewBadge(tier string, summary *reporter.Summary) *Badge {
+	if tier == "" {
+		tier = "untiered"
+	}
+
+	var errs, warnings int
+	for i := range summary.Linters {
+		errs += summary.Linters[i].Errors
+		warnings += summary.Linters[i].Warnings
+	}
+
+	b := Badge{
+		SchemaVersion: 1,
+		Label:         BadgeLabel,
+	}
+
+	switch {
+	case errs > 0:
+		b.Message = fmt.Sprintf("%s | failing (%s, %s)", tier, plural(errs, "error"), plural(warnings, "warning"))
+		b.Color = BadgeColorFailing
+	case warnings > 0:
+		b.Message = fmt.Sprintf("%s | passing (%s)", tier, plural(warnings, "warning"))
+		b.Color = BadgeColorWarnings
+	default:
+		b.Message = fmt.Sprintf("%s | passing", tier)
+		b.Color = BadgeColorPassing
+	}
+
+	return &b
+}
+
+// plural returns the given count followed by the given noun, pluralized if necessary.
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// Write writes the badge as JSON to w.
+func (b *Badge) Write(w io.Writer) error {
+	return errors.Wrap(json.NewEncoder(w).Encode(b), "encode badge")
+}
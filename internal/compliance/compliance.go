@@ -0,0 +1,117 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package compliance determines whether or not the result of a lintroller run complies
+// with the requirements of a tier, for use in reports that are consumed by tooling rather
+// than people.
+package compliance
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+)
+
+// RequirementConfiguration is the name of the requirement that the configuration itself
+// meets the minimums of the tier being evaluated.
+const RequirementConfiguration = "configuration"
+
+// Requirement is the verdict for a single requirement of a tier.
+type Requirement struct {
+	// Name is the name of the requirement, which is either RequirementConfiguration or the
+	// name of a linter the tier requires.
+	Name string `json:"name"`
+
+	// Pass denotes whether or not the requirement was met.
+	Pass bool `json:"pass"`
+
+	// Errors is the number of errors reported by the linter this requirement is for.
+	Errors int `json:"errors"`
+
+	// Reason explains why the requirement was not met, if it wasn't.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Verdict is the result of evaluating a run against a tier.
+type Verdict struct {
+	// Tier is the name of the tier that was evaluated.
+	Tier string `json:"tier"`
+
+	// Pass denotes whether or not every requirement of the tier was met.
+	Pass bool `json:"pass"`
+
+	// Requirements contains the verdict for each requirement of the tier.
+	Requirements []Requirement `json:"requirements"`
+}
+
+// ConfigurationFailure returns the verdict for a tier when the configuration could not be
+// brought up to the minimums of the tier, in which case nothing else can be evaluated.
+func ConfigurationFailure(tier string, err error) *Verdict {
+	return &Verdict{
+		Tier: tier,
+		Pass: false,
+		Requirements: []Requirement{
+			{
+				Name:   RequirementConfiguration,
+				Pass:   false,
+				Reason: err.Error(),
+			},
+		},
+	}
+}
+
+// Evaluate returns the verdict for a tier given the summary of a run made with the
+// configuration raised to the minimums of that tier. Every linter the tier requires to be
+// enabled is a requirement, which is met when the linter reported no errors.
+func Evaluate(tier string, summary *reporter.Summary) *Verdict {
+	v := Verdict{
+		Tier: tier,
+		Pass: true,
+		Requirements: []Requirement{
+			{
+				Name: RequirementConfiguration,
+				Pass: true,
+			},
+		},
+	}
+
+	var required []string
+	if definition, ok := config.TierDefinition(tier); ok {
+		required = definition.EnabledLinters()
+	}
+
+	for _, linter := range required {
+		r := Requirement{
+			Name: linter,
+			Pass: true,
+		}
+
+		for i := range summary.Linters {
+			if summary.Linters[i].Linter == linter {
+				r.Errors = summary.Linters[i].Errors
+			}
+		}
+
+		if r.Errors > 0 {
+			r.Pass = false
+			r.Reason = "linter reported errors"
+			v.Pass = false
+		}
+
+		v.Requirements = append(v.Requirements, r)
+	}
+
+	return &v
+}
+
+// Write writes the verdict as indented JSON to w.
+func (v *Verdict) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return errors.Wrap(encoder.Encode(v), "encode verdict")
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package compliance
+
+import (
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"gotest.tools/v3/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	summary := reporter.Summary{
+		Linters: []reporter.LinterSummary{
+			{Linter: "doculint", Errors: 2, Suppressed: 1},
+			{Linter: "todo", Warnings: 3},
+		},
+	}
+
+	v := Evaluate(config.TierSilver, &summary)
+	assert.Equal(t, v.Pass, false)
+
+	verdicts := make(map[string]Requirement)
+	for _, r := range v.Requirements {
+		verdicts[r.Name] = r
+	}
+
+	assert.Equal(t, verdicts[RequirementConfiguration].Pass, true)
+	assert.Equal(t, verdicts["doculint"].Pass, false)
+	assert.Equal(t, verdicts["doculint"].Errors, 2)
+	assert.Equal(t, verdicts["todo"].Pass, true)
+	assert.Equal(t, verdicts["header"].Pass, true)
+}
+
+func TestEvaluateBronzeHasNoLinterRequirements(t *testing.T) {
+	v := Evaluate(config.TierBronze, &reporter.Summary{})
+	assert.Equal(t, v.Pass, true)
+	assert.Equal(t, len(v.Requirements), 1)
+}
+
+func TestNewBadge(t *testing.T) {
+	tt := []struct {
+		name     string
+		tier     string
+		summary  reporter.Summary
+		expected Badge
+	}{
+		{
+			name: "Passes without findings",
+			tier: config.TierGold,
+			expected: Badge{
+				SchemaVersion: 1,
+				Label:         BadgeLabel,
+				Message:       "gold | passing",
+				Color:         BadgeColorPassing,
+			},
+		},
+		{
+			name: "Passes with warnings",
+			tier: config.TierGold,
+			summary: reporter.Summary{
+				Linters: []reporter.LinterSummary{{Linter: "todo", Warnings: 1, Suppressed: 4}},
+			},
+			expected: Badge{
+				SchemaVersion: 1,
+				Label:         BadgeLabel,
+				Message:       "gold | passing (1 warning)",
+				Color:         BadgeColorWarnings,
+			},
+		},
+		{
+			name: "Fails with errors",
+			summary: reporter.Summary{
+				Linters: []reporter.LinterSummary{
+					{Linter: "doculint", Errors: 2},
+					{Linter: "header", Errors: 1, Warnings: 2},
+				},
+			},
+			expected: Badge{
+				SchemaVersion: 1,
+				Label:         BadgeLabel,
+				Message:       "untiered | failing (3 errors, 2 warnings)",
+				Color:         BadgeColorFailing,
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, *NewBadge(test.tier, &test.summary), test.expected)
+		})
+	}
+}
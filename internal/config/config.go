@@ -8,12 +8,51 @@
 package config
 
 import (
+	"bytes"
+	"fmt"
+	"go/version"
+	"io"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/companion"
+	"github.com/getoutreach/lintroller/internal/doculint"
+	"github.com/getoutreach/lintroller/internal/errorlint"
+	"github.com/getoutreach/lintroller/internal/magicnumber"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 )
 
+// Linters contains the name of every linter in lintroller.
+var Linters = []string{
+	"header",
+	"copyright",
+	"doculint",
+	"todo",
+	"why",
+	"commentedcode",
+	"gogenerate",
+	"gomod",
+	"errorlint",
+	"license",
+	"ctxstruct",
+	"logging",
+	"noprint",
+	"thinmain",
+	"metricname",
+	"reflectunsafe",
+	"receiver",
+	"signature",
+	"dupstring",
+	"magicnumber",
+	"commentrules",
+	"linklint",
+}
+
 // Config is parent type we use to unmarshal YAML files into to gather config
 // for the lintroller.
 type Config struct {
@@ -27,150 +66,2139 @@ func (c *Config) MarshalLog(addField func(key string, value interface{})) {
 
 // FromFile decodes a Config type given a file path.
 func FromFile(path string) (*Config, error) {
-	f, err := os.Open(path)
+	return FromFileWithProfile(path, "")
+}
+
+// FromFileWithProfile decodes a Config type given a file path, adjusted with the profile of
+// the given name, see Profile. An empty name doesn't apply any profile.
+func FromFileWithProfile(path, profile string) (*Config, error) {
+	cfg, err := decodeFile(path)
 	if err != nil {
-		return nil, errors.Wrap(err, "open config file")
+		return nil, err
 	}
-	defer f.Close()
 
-	var cfg Config
-	if err := yaml.NewDecoder(f).Decode(&cfg); err != nil {
-		return nil, errors.Wrap(err, "decode config file")
+	if profile != "" {
+		if err := cfg.Lintroller.ApplyProfile(profile); err != nil {
+			return nil, errors.Wrap(err, "apply the profile given to lintroller")
+		}
+	}
+
+	// Package tiers are validated first, they are derived from the configuration as it was
+	// given rather than the configuration after it has been raised to the minimums of the
+	// top-level tier.
+	if err := cfg.Lintroller.ValidatePackageTiers(); err != nil {
+		return nil, errors.Wrap(err, "validate the package tiers given to lintroller")
 	}
 
 	if err := cfg.Lintroller.ValidateTier(); err != nil {
 		return nil, errors.Wrap(err, "validate the tier given to lintroller")
 	}
 
+	return cfg, nil
+}
+
+// FromFileWithTier decodes a Config type given a file path, replacing the tier given in the
+// file with the given tier. Package tiers are dropped so that every package is held to the
+// given tier.
+func FromFileWithTier(path, tier string) (*Config, error) {
+	cfg, err := decodeFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := TierDefinition(tier); !ok {
+		return nil, fmt.Errorf("tier \"%s\" is not one of: %s", tier, strings.Join(TierNames(), ", "))
+	}
+
+	cfg.Lintroller.Tier = &tier
+	cfg.Lintroller.TierSchedule = nil
+	cfg.Lintroller.PackageTiers = nil
+
+	if err := cfg.Lintroller.ValidateTier(); err != nil {
+		return nil, errors.Wrapf(err, "validate the configuration against the %s tier", tier)
+	}
+
+	return cfg, nil
+}
+
+// decodeFile decodes a Config type given a file path, loading any tier definitions it
+// refers to, without validating it against its tier. The file is decoded over the default
+// organization config, see OrgConfig, when one is embedded, so that its keys override the
+// keys of the organization config: lists are replaced, maps are merged. An empty path only
+// decodes the default organization config, relative paths in it resolve relative to the
+// working directory then.
+func decodeFile(path string) (*Config, error) {
+	var cfg Config
+
+	if org, ok := OrgConfig(); ok {
+		deprecations, err := decodeOver(&cfg, bytes.NewReader(org))
+		if err != nil {
+			return nil, errors.Wrap(err, "default organization config")
+		}
+		cfg.Lintroller.deprecations = deprecations
+	} else if path == "" {
+		return nil, errors.New("no config file given and no default organization config embedded")
+	}
+
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "open config file")
+		}
+		defer f.Close()
+
+		deprecations, err := decodeOver(&cfg, f)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Lintroller.deprecations = append(cfg.Lintroller.deprecations, deprecations...)
+	}
+
+	for i, glob := range cfg.Lintroller.IgnorePaths {
+		if err := common.ValidateGlob(glob); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.ignorePaths[%d]", i)
+		}
+	}
+
+	for i, glob := range cfg.Lintroller.ThirdPartyPaths {
+		if err := common.ValidateGlob(glob); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.thirdPartyPaths[%d]", i)
+		}
+	}
+
+	switch locale := cfg.Lintroller.Doculint.Spelling.Locale; locale {
+	case "", "en-US", "en-GB":
+	default:
+		return nil, fmt.Errorf("lintroller.doculint.spelling.locale %q is not one of \"en-US\" or \"en-GB\"", locale)
+	}
+
+	if mode := cfg.Lintroller.Doculint.EnumException; mode != "" {
+		var found bool
+		for _, m := range doculint.EnumExceptions {
+			if mode == m {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("lintroller.doculint.enumException %q is not one of: %s", mode,
+				strings.Join(doculint.EnumExceptions, ", "))
+		}
+	}
+
+	if cfg.Lintroller.Doculint.MinPackageSentences < 0 {
+		return nil, errors.New("lintroller.doculint.minPackageSentences must not be negative")
+	}
+
+	if cfg.Lintroller.Doculint.LineWidth.Max < 0 {
+		return nil, errors.New("lintroller.doculint.lineWidth.max must not be negative")
+	}
+
+	for i, rule := range cfg.Lintroller.Doculint.DisabledRules {
+		var found bool
+		for _, id := range doculint.Rules {
+			if rule == id {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("lintroller.doculint.disabledRules[%d] \"%s\" is not one of: %s",
+				i, rule, strings.Join(doculint.Rules, ", "))
+		}
+	}
+
+	for i, pattern := range cfg.Lintroller.Doculint.DocFile.Paths {
+		if err := common.ValidateGlob(pattern); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.doculint.docFile.paths[%d]", i)
+		}
+	}
+
+	for i, pattern := range cfg.Lintroller.Logging.AllowedPackages {
+		if err := common.ValidateGlob(pattern); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.logging.allowedPackages[%d]", i)
+		}
+	}
+
+	for i, line := range cfg.Lintroller.Copyright.Block {
+		if _, err := regexp.Compile(line); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.copyright.block[%d]", i)
+		}
+	}
+
+	for i, entity := range cfg.Lintroller.Copyright.Entities {
+		if strings.TrimSpace(entity) == "" {
+			return nil, fmt.Errorf("lintroller.copyright.entities[%d] must not be empty", i)
+		}
+	}
+
+	if cfg.Lintroller.CommentedCode.MinLines < 0 {
+		return nil, errors.New("lintroller.commentedCode.minLines must not be negative")
+	}
+
+	if cfg.Lintroller.Receiver.MaxLength < 0 {
+		return nil, errors.New("lintroller.receiver.maxLength must not be negative")
+	}
+
+	if cfg.Lintroller.Signature.MaxParams < 0 || cfg.Lintroller.Signature.MaxResults < 0 {
+		return nil, errors.New("lintroller.signature.maxParams and lintroller.signature.maxResults must not be negative")
+	}
+
+	if cfg.Lintroller.DupString.MaxOccurrences < 0 || cfg.Lintroller.DupString.MinLength < 0 {
+		return nil, errors.New("lintroller.dupString.maxOccurrences and lintroller.dupString.minLength must not be negative")
+	}
+
+	for i, value := range cfg.Lintroller.MagicNumber.AllowedValues {
+		if _, err := magicnumber.ParseValue(value); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.magicNumber.allowedValues[%d]", i)
+		}
+	}
+
+	if cfg.Lintroller.ThinMain.MaxLines < 0 {
+		return nil, errors.New("lintroller.thinMain.maxLines must not be negative")
+	}
+
+	if cfg.Lintroller.MetricName.Enabled && len(cfg.Lintroller.MetricName.Functions) == 0 {
+		return nil, errors.New("lintroller.metricName.functions must not be empty when the metricname linter is enabled")
+	}
+
+	if _, err := regexp.Compile(cfg.Lintroller.MetricName.Pattern); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.metricName.pattern")
+	}
+
+	for i, pattern := range cfg.Lintroller.ReflectUnsafe.AllowedPackages {
+		if err := common.ValidateGlob(pattern); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.reflectUnsafe.allowedPackages[%d]", i)
+		}
+	}
+
+	for i, pattern := range cfg.Lintroller.ThinMain.DeniedImports {
+		if err := common.ValidateGlob(pattern); err != nil {
+			return nil, errors.Wrapf(err, "validate lintroller.thinMain.deniedImports[%d]", i)
+		}
+	}
+
+	if prefix := cfg.Lintroller.ErrorLint.SpanPrefix; prefix != "" && !errorlint.ValidSpanName(prefix) {
+		return nil, fmt.Errorf("lintroller.errorLint.spanPrefix %q must be lowercase and dot-separated without spaces", prefix)
+	}
+
+	if err := cfg.Lintroller.Header.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.header")
+	}
+
+	if err := cfg.Lintroller.Scoring.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.scoring")
+	}
+
+	if err := cfg.Lintroller.Build.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.build")
+	}
+
+	if err := cfg.Lintroller.ReportPaths.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.reportPaths")
+	}
+
+	if err := cfg.Lintroller.Upload.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.upload")
+	}
+
+	if err := cfg.Lintroller.CommentRules.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.commentRules")
+	}
+
+	if err := cfg.Lintroller.LinkLint.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate lintroller.linkLint")
+	}
+
+	if err := cfg.Lintroller.GoMod.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate the go.mod policy given to lintroller")
+	}
+
+	if err := cfg.Lintroller.TestDetection.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate the test detection given to lintroller")
+	}
+
+	if err := cfg.Lintroller.CompanionFiles.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate the companion files given to lintroller")
+	}
+
+	if err := validateSeverities(cfg.Lintroller.Severities); err != nil {
+		return nil, fmt.Errorf("lintroller.severities%v", err)
+	}
+
+	if err := validateGraceUntil(cfg.Lintroller.GraceUntil); err != nil {
+		return nil, fmt.Errorf("lintroller.graceUntil%v", err)
+	}
+
+	if err := validateEscalations(cfg.Lintroller.EscalateSuppressions); err != nil {
+		return nil, fmt.Errorf("lintroller.escalateSuppressions%v", err)
+	}
+
+	if cfg.Lintroller.FileWorkers < 0 {
+		return nil, errors.New("lintroller.fileWorkers must not be negative")
+	}
+
+	if err := validateScope(cfg.Lintroller.Scope); err != nil {
+		return nil, fmt.Errorf("lintroller.scope %v", err)
+	}
+
+	if err := cfg.Lintroller.ForbidNewNoLint.Validate(); err != nil {
+		return nil, fmt.Errorf("lintroller.forbidNewNolint.%v", err)
+	}
+
+	for name := range cfg.Lintroller.Profiles {
+		profile := cfg.Lintroller.Profiles[name]
+		if err := profile.Validate("lintroller.profiles." + name); err != nil {
+			return nil, err
+		}
+	}
+
+	suppressionsFile, required := cfg.Lintroller.SuppressionsFile, true
+	if suppressionsFile == "" {
+		suppressionsFile, required = DefaultSuppressionsFile, false
+	}
+	if !filepath.IsAbs(suppressionsFile) {
+		suppressionsFile = filepath.Join(filepath.Dir(path), suppressionsFile)
+	}
+
+	var err error
+	cfg.Lintroller.SuppressionsPath = suppressionsFile
+	if cfg.Lintroller.Suppressions, err = LoadSuppressions(suppressionsFile, required); err != nil {
+		return nil, errors.Wrap(err, "load suppressions")
+	}
+
+	if exceptionsFile := cfg.Lintroller.Doculint.DocFile.ExceptionsFile; exceptionsFile != "" {
+		if !filepath.IsAbs(exceptionsFile) {
+			exceptionsFile = filepath.Join(filepath.Dir(path), exceptionsFile)
+		}
+
+		if cfg.Lintroller.Doculint.DocFile.Exceptions, err = LoadExceptions(exceptionsFile); err != nil {
+			return nil, errors.Wrap(err, "load lintroller.doculint.docFile.exceptionsFile")
+		}
+	}
+
+	if cfg.Lintroller.TierDefinitions != "" {
+		dir := cfg.Lintroller.TierDefinitions
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(path), dir)
+		}
+
+		if err := OverrideTierDefinitions(dir); err != nil {
+			return nil, errors.Wrap(err, "override tier definitions")
+		}
+	}
+
 	return &cfg, nil
 }
 
+// decodeOver decodes the config file read from the given reader into the given Config,
+// overriding the keys it sets, and returns the deprecated keys it used.
+func decodeOver(cfg *Config, r io.Reader) ([]Deprecation, error) {
+	var doc yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, errors.Wrap(err, "decode config file")
+	}
+
+	// Deprecated keys are renamed to their replacements before decoding, so config files
+	// written for older versions of the schema keep working, with a warning.
+	deprecations, err := migrateNode(&doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "migrate config file")
+	}
+
+	if err := doc.Decode(cfg); err != nil {
+		return nil, errors.Wrap(err, "decode config file")
+	}
+
+	return deprecations, nil
+}
+
 // Lintroller contains the actually configuration required by lintroller, used
 // by Config. The reason these fields aren't directly in Config is because we
 // want to the ability utilize the golangci.yml file for lintroller configuration
 // as well, so lintroller configuration needs to be "namespaced" accordingly.
 type Lintroller struct {
+	// Version is the version of the config file schema the config file was written for, see
+	// CurrentVersion. Defaults to CurrentVersion.
+	Version int `yaml:"version"`
+
 	// Tier is the desired tier you desire your service to pass for in ops-level.
 	Tier *string `yaml:"tier"`
 
+	// TierSchedule raises Tier over time, e.g. to silver now and gold from a given date,
+	// without having to change the config file on that date. From the date of each entry
+	// onwards its tier is required instead of Tier. Entries are in chronological order.
+	TierSchedule []TierEscalation `yaml:"tierSchedule"`
+
+	// DocsBaseURL is the base URL that the documentation link appended to each reported
+	// issue is built from, e.g. an internal wiki. The link for a given linter takes the
+	// form of <DocsBaseURL>/<linter>.md. Setting this to an empty string disables the
+	// links. Defaults to the rule documentation in the lintroller repository.
+	DocsBaseURL *string `yaml:"docsBaseURL"`
+
+	// TierMode denotes how a configuration below the minimums of Tier is handled, either
+	// TierModeAutofix, which raises it to the minimums and reports each field that was
+	// raised, or TierModeStrict, which fails. Defaults to TierModeAutofix.
+	TierMode string `yaml:"tierMode"`
+
+	// TierDefinitions is the path to a directory of tier definition files, relative to the
+	// config file, that override or add to the built-in tier definitions. See
+	// LoadTierDefinitions for the format of these files. Defaults to an empty string, which
+	// only uses the built-in tier definitions.
+	TierDefinitions string `yaml:"tierDefinitions"`
+
+	// TierExceptions accepts fields of this configuration that are below the minimums of
+	// its tier as they are, rather than raising them, each with a recorded justification.
+	// Fields accepted by an exception are reported along with the exception, see Excepted.
+	TierExceptions []TierException `yaml:"exceptions"`
+
+	// PackageTiers assigns tiers other than Tier to the packages matching a set of path
+	// globs, e.g. to hold a public API to a higher standard than legacy code within the
+	// same module. The first entry matching a package wins, packages not matching any
+	// entry use Tier.
+	PackageTiers []PackageTier `yaml:"packageTiers"`
+
+	// IgnorePaths contains path globs, relative to the root of the module, of files that no
+	// linter should lint, in addition to vendor, third_party, and testdata directories which
+	// are ignored unless they match ThirdPartyPaths.
+	IgnorePaths []string `yaml:"ignorePaths"`
+
+	// ThirdPartyPaths contains path globs, relative to the root of the module, of files
+	// holding third-party code, e.g. code copied from another project. Their issues are
+	// reported at info severity, visible in reports without ever failing the run, so that
+	// issues such as missing license headers can still be tracked. IgnorePaths takes
+	// precedence over this. Defaults to an empty list.
+	ThirdPartyPaths []string `yaml:"thirdPartyPaths"`
+
+	// IncludeTests denotes whether or not every linter lints test files and test packages
+	// rather than skipping them. Each linter can also include them with its own
+	// includeTests. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// Severities maps the names of linters, or rule IDs such as "errorlint/wrap-message",
+	// to the severity of their issues, either SeverityError or SeverityWarning. The
+	// severity of a rule takes precedence over the severity of its linter. Defaults to
+	// SeverityError for every linter.
+	Severities map[string]string `yaml:"severities"`
+
+	// GraceUntil maps linter names, or rule IDs such as "doculint/spelling", to a date in
+	// the form of 2006-01-02, in UTC, until which their issues are reported as warnings,
+	// e.g. while the issues of a newly enabled linter are being fixed. From that date
+	// onwards their issues are reported with their severity in Severities.
+	GraceUntil map[string]string `yaml:"graceUntil"`
+
+	// EscalateSuppressions maps linter names to the number of nolint directives for the
+	// linter a file may contain before the warnings of the linter in that file are reported
+	// as errors, a forcing function for files drowning in suppressions. Defaults to never
+	// escalating the warnings of a linter.
+	EscalateSuppressions map[string]int `yaml:"escalateSuppressions"`
+
+	// FileWorkers is the number of files of a package the todo, why, copyright, and header
+	// linters process concurrently. Defaults to 0, which uses the number of CPUs available,
+	// while 1 processes the files of a package sequentially.
+	FileWorkers int `yaml:"fileWorkers"`
+
+	// Scope is the scope of the files whose issues are reported, either ScopeFull or
+	// ScopeChanged. Defaults to ScopeFull.
+	Scope string `yaml:"scope"`
+
+	// Base is the git revision, e.g. "origin/main", changed files are compared to when
+	// Scope is ScopeChanged. Defaults to DefaultBase.
+	Base string `yaml:"base"`
+
+	// SuppressionsFile is the path, relative to the config file, of the file listing the
+	// issues that are suppressed outside of the source code, see SuppressionsFile. Defaults
+	// to DefaultSuppressionsFile, which is only loaded if it exists.
+	SuppressionsFile string `yaml:"suppressionsFile"`
+
+	// Suppressions contains the suppressions loaded from SuppressionsFile.
+	Suppressions []Suppression `yaml:"-"`
+
+	// SuppressionsPath is the path SuppressionsFile resolved to, which Suppressions were
+	// loaded from if it exists.
+	SuppressionsPath string `yaml:"-"`
+
+	// ForbidNewNoLint configures rejecting new nolint directives when lintroller is ran
+	// with -forbid-new-nolint, see ForbidNewNoLint.
+	ForbidNewNoLint ForbidNewNoLint `yaml:"forbidNewNolint"`
+
+	// Profiles contains named adjustments to this configuration for the contexts
+	// lintroller is ran in, e.g. "ci" or "pre-commit", selected with the -profile flag.
+	Profiles map[string]Profile `yaml:"profiles"`
+
+	// TestDetection configures how test files and test packages, which linters skip, are
+	// detected.
+	TestDetection TestDetection `yaml:"testDetection"`
+
+	// CompanionFiles configures the header and copyright checks of the non-Go files in the
+	// module, e.g. .proto files, which the analysis framework doesn't cover.
+	CompanionFiles CompanionFiles `yaml:"companionFiles"`
+
+	// Scoring configures the quality score of every package and of the repository, see
+	// Scoring.
+	Scoring Scoring `yaml:"scoring"`
+
+	// Build configures the build tags and target platforms the packages are loaded with,
+	// so that files guarded by build constraints are linted too.
+	Build Build `yaml:"build"`
+
+	// ReportPaths configures how the paths of files are written in reported issues, e.g.
+	// relative to the root of the module rather than the absolute paths of a build sandbox.
+	ReportPaths ReportPaths `yaml:"reportPaths"`
+
+	// Upload configures uploading the results of each run to a central service, e.g. an
+	// inventory of the lint results of every repository.
+	Upload Upload `yaml:"upload"`
+
 	// Configuration for individual linters proceeding:
 	Header    Header    `yaml:"header"`
 	Copyright Copyright `yaml:"copyright"`
 	Doculint  Doculint  `yaml:"doculint"`
 	Todo      Todo      `yaml:"todo"`
 	Why       Why       `yaml:"why"`
+
+	CommentedCode CommentedCode `yaml:"commentedCode"`
+	GoGenerate    GoGenerate    `yaml:"goGenerate"`
+	GoMod         GoMod         `yaml:"goMod"`
+	ErrorLint     ErrorLint     `yaml:"errorLint"`
+	License       License       `yaml:"license"`
+	CtxStruct     CtxStruct     `yaml:"ctxStruct"`
+	Logging       Logging       `yaml:"logging"`
+	NoPrint       NoPrint       `yaml:"noPrint"`
+	ThinMain      ThinMain      `yaml:"thinMain"`
+	MetricName    MetricName    `yaml:"metricName"`
+	ReflectUnsafe ReflectUnsafe `yaml:"reflectUnsafe"`
+	Receiver      Receiver      `yaml:"receiver"`
+	Signature     Signature     `yaml:"signature"`
+	DupString     DupString     `yaml:"dupString"`
+	MagicNumber   MagicNumber   `yaml:"magicNumber"`
+	CommentRules  CommentRules  `yaml:"commentRules"`
+	LinkLint      LinkLint      `yaml:"linkLint"`
+
+	// minimums records how each field compared to the minimums of Tier, see Minimums.
+	minimums []Minimum
+
+	// upcoming is the entry of TierSchedule that starts soon, see UpcomingTierEscalation.
+	upcoming *TierEscalation
+
+	// deprecations records the deprecated keys of the config file, see Deprecations.
+	deprecations []Deprecation
 }
 
 // MarshalLog implements the log.Marshaler interface.
 func (lr *Lintroller) MarshalLog(addField func(key string, value interface{})) {
+	addField("version", lr.Version)
+	if lr.DocsBaseURL != nil {
+		addField("docsBaseURL", *lr.DocsBaseURL)
+	}
+	addField("tierSchedule", lr.TierSchedule)
+	addField("tierMode", lr.TierMode)
+	addField("tierDefinitions", lr.TierDefinitions)
+	addField("exceptions", lr.TierExceptions)
+	addField("packageTiers", lr.PackageTiers)
+	addField("ignorePaths", lr.IgnorePaths)
+	addField("thirdPartyPaths", lr.ThirdPartyPaths)
+	addField("includeTests", lr.IncludeTests)
+	addField("severities", lr.Severities)
+	addField("graceUntil", lr.GraceUntil)
+	addField("escalateSuppressions", lr.EscalateSuppressions)
+	addField("fileWorkers", lr.FileWorkers)
+	addField("scope", lr.Scope)
+	addField("base", lr.Base)
+	addField("profiles", lr.Profiles)
+	addField("suppressionsFile", lr.SuppressionsFile)
+	addField("suppressions", lr.Suppressions)
+	addField("forbidNewNolint", lr.ForbidNewNoLint)
+	addField("testDetection", lr.TestDetection)
+	addField("companionFiles", lr.CompanionFiles)
+	addField("scoring", lr.Scoring)
+	addField("build", lr.Build)
+	addField("reportPaths", lr.ReportPaths)
+	addField("upload", lr.Upload)
 	addField("header", lr.Header)
 	addField("copyright", lr.Copyright)
 	addField("doculint", lr.Doculint)
 	addField("todo", lr.Todo)
 	addField("why", lr.Why)
+	addField("commentedCode", lr.CommentedCode)
+	addField("goGenerate", lr.GoGenerate)
+	addField("goMod", lr.GoMod)
+	addField("errorLint", lr.ErrorLint)
+	addField("license", lr.License)
+	addField("ctxStruct", lr.CtxStruct)
+	addField("logging", lr.Logging)
+	addField("noPrint", lr.NoPrint)
+	addField("thinMain", lr.ThinMain)
+	addField("metricName", lr.MetricName)
+	addField("reflectUnsafe", lr.ReflectUnsafe)
+	addField("receiver", lr.Receiver)
+	addField("signature", lr.Signature)
+	addField("dupString", lr.DupString)
+	addField("magicNumber", lr.MagicNumber)
+	addField("commentRules", lr.CommentRules)
+	addField("linkLint", lr.LinkLint)
 }
 
-// Header is the configuration type that matches the flags exposed by the header
-// linter.
-type Header struct {
-	// Enabled denotes whether or not this linter is enabled. Defaults to true.
-	Enabled bool `yaml:"enabled"`
+// EnabledLinters returns the names of the linters enabled by the receiver.
+func (lr *Lintroller) EnabledLinters() []string {
+	table := []struct {
+		Enabled bool
+		Name    string
+	}{
+		{lr.Header.Enabled, "header"},
+		{lr.Copyright.Enabled, "copyright"},
+		{lr.Doculint.Enabled, "doculint"},
+		{lr.Todo.Enabled, "todo"},
+		{lr.Why.Enabled, "why"},
+		{lr.CommentedCode.Enabled, "commentedcode"},
+		{lr.GoGenerate.Enabled, "gogenerate"},
+		{lr.GoMod.Enabled, "gomod"},
+		{lr.ErrorLint.Enabled, "errorlint"},
+		{lr.License.Enabled, "license"},
+		{lr.CtxStruct.Enabled, "ctxstruct"},
+		{lr.Logging.Enabled, "logging"},
+		{lr.NoPrint.Enabled, "noprint"},
+		{lr.ThinMain.Enabled, "thinmain"},
+		{lr.MetricName.Enabled, "metricname"},
+		{lr.ReflectUnsafe.Enabled, "reflectunsafe"},
+		{lr.Receiver.Enabled, "receiver"},
+		{lr.Signature.Enabled, "signature"},
+		{lr.DupString.Enabled, "dupstring"},
+		{lr.MagicNumber.Enabled, "magicnumber"},
+		{lr.CommentRules.Enabled, "commentrules"},
+		{lr.LinkLint.Enabled, "linklint"},
+	}
 
-	// Fields is a list of fields required to be filled out in the header. Defaults
-	// to []string{"Description"}.
-	Fields []string `yaml:"fields"`
-}
+	var linters []string
+	for i := range table {
+		if table[i].Enabled {
+			linters = append(linters, table[i].Name)
+		}
+	}
 
-// MarshalLog implements the log.Marshaler interface.
-func (h *Header) MarshalLog(addField func(key string, value interface{})) {
-	addField("enabled", h.Enabled)
-	addField("fields", h.Fields)
+	return linters
 }
 
-// Copyright is the configuration type that matches the flags exposed by the copyright
-// linter.
-type Copyright struct {
-	// Enabled denotes whether or not this linter is enabled. Defaults to true.
-	Enabled bool `yaml:"enabled"`
+// LintTests returns the names of the linters that lint test files and test packages rather
+// than skipping them: every linter if IncludeTests is set, otherwise the linters that set
+// their own IncludeTests along with those named by TestDetection.LintTests.
+func (lr *Lintroller) LintTests() []string {
+	if lr.IncludeTests {
+		return Linters
+	}
 
-	// Text is the copyright literal string required at the top of each .go file. If this
-	// and pattern are empty this linter is a no-op. Pattern will always take precedence
-	// over text if both are provided. Defaults to an empty string.
-	Text string `yaml:"text"`
+	table := []struct {
+		IncludeTests bool
+		Name         string
+	}{
+		{lr.Header.IncludeTests, "header"},
+		{lr.Copyright.IncludeTests, "copyright"},
+		{lr.Doculint.IncludeTests, "doculint"},
+		{lr.Todo.IncludeTests, "todo"},
+		{lr.Why.IncludeTests, "why"},
+		{lr.CommentedCode.IncludeTests, "commentedcode"},
+		{lr.ErrorLint.IncludeTests, "errorlint"},
+		{lr.License.IncludeTests, "license"},
+		{lr.CtxStruct.IncludeTests, "ctxstruct"},
+		{lr.Logging.IncludeTests, "logging"},
+		{lr.NoPrint.IncludeTests, "noprint"},
+		{lr.ThinMain.IncludeTests, "thinmain"},
+		{lr.MetricName.IncludeTests, "metricname"},
+		{lr.ReflectUnsafe.IncludeTests, "reflectunsafe"},
+		{lr.Receiver.IncludeTests, "receiver"},
+		{lr.Signature.IncludeTests, "signature"},
+		{lr.DupString.IncludeTests, "dupstring"},
+		{lr.MagicNumber.IncludeTests, "magicnumber"},
+		{lr.CommentRules.IncludeTests, "commentrules"},
+		{lr.LinkLint.IncludeTests, "linklint"},
+	}
 
-	// Pattern is the copyright pattern as a regular expression required at the top of each
-	// .go file. If this and pattern are empty this linter is a no-op. Pattern will always
-	// take precedence over text if both are provided. Defaults to an empty string.
-	Pattern string `yaml:"pattern"`
-}
+	linters := append([]string(nil), lr.TestDetection.LintTests...)
+	for i := range table {
+		if table[i].IncludeTests {
+			linters = append(linters, table[i].Name)
+		}
+	}
 
-// MarshalLog implements the log.Marshaler interface.
-func (c *Copyright) MarshalLog(addField func(key string, value interface{})) {
-	addField("enabled", c.Enabled)
-	addField("text", c.Text)
-	addField("pattern", c.Pattern)
+	return linters
 }
 
-// Doculint is the configuration type that matches the flags exposed by the doculint
-// linter.
-type Doculint struct {
-	// Enabled denotes whether or not this linter is enabled. Defaults to true.
-	Enabled bool `yaml:"enabled"`
+// LintGenerated returns the names of the linters that lint generated files rather than
+// skipping them, which are those that set their own IncludeGenerated.
+func (lr *Lintroller) LintGenerated() []string {
+	table := []struct {
+		IncludeGenerated bool
+		Name             string
+	}{
+		{lr.Header.IncludeGenerated, "header"},
+		{lr.Copyright.IncludeGenerated, "copyright"},
+		{lr.Doculint.IncludeGenerated, "doculint"},
+		{lr.Todo.IncludeGenerated, "todo"},
+		{lr.Why.IncludeGenerated, "why"},
+		{lr.CommentedCode.IncludeGenerated, "commentedcode"},
+		{lr.GoGenerate.IncludeGenerated, "gogenerate"},
+		{lr.ErrorLint.IncludeGenerated, "errorlint"},
+		{lr.License.IncludeGenerated, "license"},
+		{lr.CtxStruct.IncludeGenerated, "ctxstruct"},
+		{lr.Logging.IncludeGenerated, "logging"},
+		{lr.NoPrint.IncludeGenerated, "noprint"},
+		{lr.ThinMain.IncludeGenerated, "thinmain"},
+		{lr.MetricName.IncludeGenerated, "metricname"},
+		{lr.ReflectUnsafe.IncludeGenerated, "reflectunsafe"},
+		{lr.Receiver.IncludeGenerated, "receiver"},
+		{lr.Signature.IncludeGenerated, "signature"},
+		{lr.DupString.IncludeGenerated, "dupstring"},
+		{lr.MagicNumber.IncludeGenerated, "magicnumber"},
+		{lr.CommentRules.IncludeGenerated, "commentrules"},
+		{lr.LinkLint.IncludeGenerated, "linklint"},
+	}
 
-	// MinFunLen is the minimum function length that doculint will report on if said
-	// function has no related documentation. Defaults to 10.
-	MinFunLen int `yaml:"minFunLen"`
+	var linters []string
+	for i := range table {
+		if table[i].IncludeGenerated {
+			linters = append(linters, table[i].Name)
+		}
+	}
 
-	// ValidatePackages denotes whether or not package comments should be validated.
-	// Defaults to true.
-	ValidatePackages bool `yaml:"validatePackages"`
+	return linters
+}
 
-	// ValidateFunctions denotes whether or not function comments should be validated.
-	// Defaults to true.
-	ValidateFunctions bool `yaml:"validateFunctions"`
+// PackageTier is the configuration type that assigns a tier to the packages matching a set
+// of path globs.
+type PackageTier struct {
+	// Paths is a list of globs matched against the directory of each package, relative to
+	// the root of the module, e.g. "pkg/public/**". See common.MatchGlob for the supported
+	// syntax.
+	Paths []string `yaml:"paths"`
 
-	// ValidateVariables denotes whether or not variable comments should be validated.
-	// Defaults to true.
-	ValidateVariables bool `yaml:"validateVariables"`
+	// Tier is the tier the matching packages are required to pass for.
+	Tier string `yaml:"tier"`
 
-	// ValidateConstants denotes whether or not constant comments should be validated.
-	// Defaults to true.
-	ValidateConstants bool `yaml:"validateConstants"`
+	// lintroller is the configuration that applies to the matching packages, which is the
+	// configuration this PackageTier belongs to raised to the minimums of Tier. This is set
+	// by ValidatePackageTiers.
+	lintroller *Lintroller
+}
 
-	// ValidateTypes denotes whether or not type comments should be validated. Defaults
-	// to true.
-	ValidateTypes bool `yaml:"validateTypes"`
+// MarshalLog implements the log.Marshaler interface.
+func (pt *PackageTier) MarshalLog(addField func(key string, value interface{})) {
+	addField("paths", pt.Paths)
+	addField("tier", pt.Tier)
+}
+
+// TierEscalation is an entry of Lintroller.TierSchedule, requiring a tier from a date
+// onwards.
+type TierEscalation struct {
+	// Tier is the tier required from From onwards.
+	Tier string `yaml:"tier"`
+
+	// From is the date, in the form of 2006-01-02 and in UTC, the tier is required from.
+	From string `yaml:"from"`
 }
 
 // MarshalLog implements the log.Marshaler interface.
-func (d *Doculint) MarshalLog(addField func(key string, value interface{})) {
-	addField("enabled", d.Enabled)
-	addField("minFunLen", d.MinFunLen)
-	addField("validatePackages", d.ValidatePackages)
-	addField("validateFunctions", d.ValidateFunctions)
-	addField("validateVariables", d.ValidateVariables)
-	addField("validateConstants", d.ValidateConstants)
-	addField("validateTypes", d.ValidateTypes)
+func (te *TierEscalation) MarshalLog(addField func(key string, value interface{})) {
+	addField("tier", te.Tier)
+	addField("from", te.From)
 }
 
-// Todo is the configuration type that matches the flags exposed by the todo linter.
-type Todo struct {
-	// Enabled denotes whether or not this linter is enabled. Defaults to true.
-	Enabled bool `yaml:"enabled"`
+// String returns a human readable description of the escalation.
+func (te *TierEscalation) String() string {
+	return fmt.Sprintf("the %s tier is required from %s onwards", strings.ToLower(te.Tier), te.From)
+}
+
+// ReportPaths is the configuration type for how the paths of files are written in reported
+// issues, in every format and in the summary.
+type ReportPaths struct {
+	// RelativeToModule denotes whether or not paths are written relative to the root of the
+	// module, the directory of the go.mod file the working directory belongs to, rather than
+	// as each format writes them by default. Defaults to false.
+	RelativeToModule bool `yaml:"relativeToModule"`
+
+	// Rewrites replaces prefixes of paths, e.g. the execution root of a Bazel sandbox, which
+	// don't map back to the repository. The first rewrite whose from prefixes a path
+	// applies, and takes precedence over RelativeToModule. Defaults to an empty list.
+	Rewrites []PathRewrite `yaml:"rewrites"`
 }
 
 // MarshalLog implements the log.Marshaler interface.
-func (t *Todo) MarshalLog(addField func(key string, value interface{})) {
-	addField("enabled", t.Enabled)
+func (rp *ReportPaths) MarshalLog(addField func(key string, value interface{})) {
+	addField("relativeToModule", rp.RelativeToModule)
+	addField("rewrites", rp.Rewrites)
 }
 
-// Why is the configuration type that matches the flags exposed by the why linter.
-type Why struct {
-	// Enabled denotes whether or not this linter is enabled. Defaults to true.
-	Enabled bool `yaml:"enabled"`
+// Validate ensures that every rewrite of the receiver has a prefix to replace.
+func (rp *ReportPaths) Validate() error {
+	for i := range rp.Rewrites {
+		if rp.Rewrites[i].From == "" {
+			return fmt.Errorf("rewrites[%d].from must not be empty", i)
+		}
+	}
+
+	return nil
+}
+
+// PathRewrite is an entry of ReportPaths.Rewrites, replacing a prefix of paths.
+type PathRewrite struct {
+	// From is the prefix replaced, e.g. "/sandbox/execroot/__main__/".
+	From string `yaml:"from"`
+
+	// To replaces From. Defaults to an empty string, which strips From.
+	To string `yaml:"to"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (pr *PathRewrite) MarshalLog(addField func(key string, value interface{})) {
+	addField("from", pr.From)
+	addField("to", pr.To)
+}
+
+// DefaultUploadTokenEnv is the environment variable the token results are uploaded with is
+// read from, unless Upload.TokenEnv names another.
+const DefaultUploadTokenEnv = "LINTROLLER_UPLOAD_TOKEN"
+
+// Upload configures uploading the results of each run, along with the repository, the
+// commit, and the tier they were linted at, see the upload package.
+type Upload struct {
+	// URL is the HTTP endpoint the results of each run are posted to. Defaults to an empty
+	// string, which disables uploading.
+	URL string `yaml:"url"`
+
+	// TokenEnv is the environment variable holding the bearer token results are uploaded
+	// with, so that the token never has to be written to the config file. Results are
+	// uploaded without a token when the variable isn't set. Defaults to
+	// DefaultUploadTokenEnv.
+	TokenEnv string `yaml:"tokenEnv"`
+
+	// Repository is the repository the results are uploaded for, e.g.
+	// getoutreach/lintroller. Defaults to an empty string, which detects the repository
+	// from the environment of the CI system or the origin remote, see upload.Repository.
+	Repository string `yaml:"repository"`
+
+	// Required denotes whether or not a failed upload fails the run, rather than only being
+	// reported. Defaults to false.
+	Required bool `yaml:"required"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (u *Upload) MarshalLog(addField func(key string, value interface{})) {
+	addField("url", u.URL)
+	addField("tokenEnv", u.TokenEnv)
+	addField("repository", u.Repository)
+	addField("required", u.Required)
+}
+
+// TokenEnvOrDefault returns TokenEnv, or DefaultUploadTokenEnv if it is empty.
+func (u *Upload) TokenEnvOrDefault() string {
+	if u.TokenEnv == "" {
+		return DefaultUploadTokenEnv
+	}
+
+	return u.TokenEnv
+}
+
+// Validate ensures that the URL of the receiver, if any, is an HTTP or HTTPS URL.
+func (u *Upload) Validate() error {
+	if u.URL == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(u.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("url \"%s\" must be an http or https URL", u.URL)
+	}
+
+	return nil
+}
+
+// TierException is an entry of Lintroller.TierExceptions, accepting a field that is below
+// the minimum required by the tier as it is until it expires.
+type TierException struct {
+	// Rule is the path of the field the exception accepts, relative to the lintroller key,
+	// e.g. "doculint.validateFunctions".
+	Rule string `yaml:"rule" json:"rule"`
+
+	// Reason is why the field can't meet the minimum of the tier.
+	Reason string `yaml:"reason" json:"reason"`
+
+	// ApprovedBy is who approved the exception, e.g. a team or a person.
+	ApprovedBy string `yaml:"approvedBy" json:"approvedBy"`
+
+	// Expires is the date, in the form of 2006-01-02 and in UTC, from which the exception
+	// no longer applies. Defaults to an empty string, which never expires.
+	Expires string `yaml:"expires" json:"expires,omitempty"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (te *TierException) MarshalLog(addField func(key string, value interface{})) {
+	addField("rule", te.Rule)
+	addField("reason", te.Reason)
+	addField("approvedBy", te.ApprovedBy)
+	addField("expires", te.Expires)
+}
+
+// Header is the configuration type that matches the flags exposed by the header
+// linter.
+type Header struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to true.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// Fields is a list of fields required to be filled out in the header. Defaults
+	// to []string{"Description"}.
+	Fields []string `yaml:"fields"`
+
+	// PathFields requires additional fields in the headers of the files matching path
+	// globs, on top of Fields. Unlike Fields, they are also required in package main.
+	// Defaults to an empty list.
+	PathFields []HeaderPathFields `yaml:"pathFields"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (h *Header) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", h.Enabled)
+	addField("includeTests", h.IncludeTests)
+	addField("includeGenerated", h.IncludeGenerated)
+	addField("fields", h.Fields)
+	addField("pathFields", h.PathFields)
+}
+
+// Validate ensures that every entry of PathFields has well-formed path globs and
+// non-empty fields.
+func (h *Header) Validate() error {
+	for i := range h.PathFields {
+		pf := &h.PathFields[i]
+
+		if len(pf.Paths) == 0 {
+			return fmt.Errorf("pathFields[%d].paths must not be empty", i)
+		}
+
+		for j, pattern := range pf.Paths {
+			if err := common.ValidateGlob(pattern); err != nil {
+				return errors.Wrapf(err, "pathFields[%d].paths[%d]", i, j)
+			}
+		}
+
+		if len(pf.Fields) == 0 {
+			return fmt.Errorf("pathFields[%d].fields must not be empty", i)
+		}
+
+		for j, field := range pf.Fields {
+			if strings.TrimSpace(field) == "" || strings.Contains(field, ",") {
+				return fmt.Errorf("pathFields[%d].fields[%d] must not be empty or contain a comma", i, j)
+			}
+		}
+	}
+
+	return nil
+}
+
+// HeaderPathFields is the configuration of the header fields required in the files
+// matching a set of path globs.
+type HeaderPathFields struct {
+	// Paths are the path globs, relative to the module root, of the files the fields are
+	// required in, see common.MatchGlob.
+	Paths []string `yaml:"paths"`
+
+	// Fields are the fields required to be filled out in the header of the files matching
+	// any of Paths.
+	Fields []string `yaml:"fields"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (h *HeaderPathFields) MarshalLog(addField func(key string, value interface{})) {
+	addField("paths", h.Paths)
+	addField("fields", h.Fields)
+}
+
+// Copyright is the configuration type that matches the flags exposed by the copyright
+// linter.
+type Copyright struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to true.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// Text is the copyright literal string required at the top of each .go file. If this
+	// and pattern are empty this linter is a no-op. Pattern will always take precedence
+	// over text if both are provided. Defaults to an empty string.
+	Text string `yaml:"text"`
+
+	// Pattern is the copyright pattern as a regular expression required at the top of each
+	// .go file. If this and pattern are empty this linter is a no-op. Pattern will always
+	// take precedence over text if both are provided. Defaults to an empty string.
+	Pattern string `yaml:"pattern"`
+
+	// Block contains a regular expression for each line of the header comment required at
+	// the top of each .go file, for copyrights spanning several lines. It is checked in
+	// addition to text or pattern, which only check line 1. Defaults to an empty list.
+	Block []string `yaml:"block"`
+
+	// Entities contains the names of the legal entities the copyright on line 1 of each .go
+	// file may name, e.g. "Outreach Corporation". When given, a copyright that names none of
+	// them, e.g. "Outreach Inc.", is reported. Defaults to an empty list.
+	Entities []string `yaml:"entities"`
+
+	// Embedded denotes whether or not the files embedded by //go:embed directives that have
+	// Go-like comment syntax, e.g. .js, .css, or .proto files, must have the copyright as
+	// well. Defaults to false.
+	Embedded bool `yaml:"embedded"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (c *Copyright) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", c.Enabled)
+	addField("includeTests", c.IncludeTests)
+	addField("includeGenerated", c.IncludeGenerated)
+	addField("text", c.Text)
+	addField("pattern", c.Pattern)
+	addField("block", c.Block)
+	addField("entities", c.Entities)
+	addField("embedded", c.Embedded)
+}
+
+// Doculint is the configuration type that matches the flags exposed by the doculint
+// linter.
+type Doculint struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to true.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// MinFunLen is the minimum function length that doculint will report on if said
+	// function has no related documentation. Defaults to 10.
+	MinFunLen int `yaml:"minFunLen"`
+
+	// ValidatePackages denotes whether or not package comments should be validated.
+	// Defaults to true.
+	ValidatePackages bool `yaml:"validatePackages"`
+
+	// ValidateFunctions denotes whether or not function comments should be validated.
+	// Defaults to true.
+	ValidateFunctions bool `yaml:"validateFunctions"`
+
+	// ValidateVariables denotes whether or not variable comments should be validated.
+	// Defaults to true.
+	ValidateVariables bool `yaml:"validateVariables"`
+
+	// ValidateConstants denotes whether or not constant comments should be validated.
+	// Defaults to true.
+	ValidateConstants bool `yaml:"validateConstants"`
+
+	// ValidateTypes denotes whether or not type comments should be validated. Defaults
+	// to true.
+	ValidateTypes bool `yaml:"validateTypes"`
+
+	// ValidateDocNames denotes whether or not doc comments that begin with an identifier
+	// other than the one they document, e.g. after a rename, should be reported. Defaults
+	// to false.
+	ValidateDocNames bool `yaml:"validateDocNames"`
+
+	// MinPackageSentences is the minimum number of sentences a package comment must
+	// contain. Defaults to 0, which allows a package comment of any length.
+	MinPackageSentences int `yaml:"minPackageSentences"`
+
+	// RequirePackageUsage denotes whether or not the package comments of library packages,
+	// those outside of internal directories, must contain a usage or example section.
+	// Defaults to false.
+	RequirePackageUsage bool `yaml:"requirePackageUsage"`
+
+	// ValidateHeaderDescriptions denotes whether or not Description header fields that
+	// refer to the package comment of a package that has none, or that are copies of the
+	// description of another file of the package, should be reported. Defaults to false.
+	ValidateHeaderDescriptions bool `yaml:"validateHeaderDescriptions"`
+
+	// UnreferencedExports denotes whether or not exported identifiers of command packages,
+	// main packages and the packages beneath cmd directories or the internal directories of
+	// main packages, that nothing outside of their package references should be reported,
+	// suggesting to unexport them rather than document them. Defaults to false.
+	UnreferencedExports bool `yaml:"unreferencedExports"`
+
+	// EnumException is how the exception to the commenting rule for enum-like constant
+	// blocks applies, one of doculint.EnumExceptions: "lenient" exempts every such block,
+	// "exported" only blocks whose constants are all exported, "firstComment" only blocks
+	// whose first constant has a comment, and "disabled" none. Defaults to "lenient".
+	EnumException string `yaml:"enumException"`
+
+	// SkipAliases denotes whether or not type aliases, e.g. type Foo = bar.Baz, are exempt
+	// from having a comment. Aliases that have one are still checked. Defaults to false.
+	SkipAliases bool `yaml:"skipAliases"`
+
+	// ValidateEmbedded denotes whether or not fields of exported structs that embed exported
+	// types, promoting their fields and methods into the API of the struct, must have a
+	// comment. Defaults to false.
+	ValidateEmbedded bool `yaml:"validateEmbedded"`
+
+	// AcceptTrailingComments denotes whether or not the line comment trailing a constant
+	// or variable within a block, e.g. Foo = 1 // Foo is ..., is accepted as its comment
+	// when it has none above it. Defaults to false.
+	AcceptTrailingComments bool `yaml:"acceptTrailingComments"`
+
+	// ValidateDirectives denotes whether or not directives meant for tools, e.g.
+	// //go:generate, //go:build, and nolint directives, should be reported within doc
+	// comments. Defaults to false.
+	ValidateDirectives bool `yaml:"validateDirectives"`
+
+	// DocFile configures the opt-in check that library packages have a doc.go file.
+	DocFile DocFile `yaml:"docFile"`
+
+	// Spelling configures the opt-in spell check of doc comments.
+	Spelling Spelling `yaml:"spelling"`
+
+	// LineWidth configures the opt-in maximum width of doc comment lines.
+	LineWidth LineWidth `yaml:"lineWidth"`
+
+	// DisabledRules contains the IDs of individual rules that are never reported, e.g.
+	// "comment-prefix" to only require that comments exist. See doculint.Rules for the IDs
+	// of every rule.
+	DisabledRules []string `yaml:"disabledRules"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (d *Doculint) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", d.Enabled)
+	addField("includeTests", d.IncludeTests)
+	addField("includeGenerated", d.IncludeGenerated)
+	addField("minFunLen", d.MinFunLen)
+	addField("validatePackages", d.ValidatePackages)
+	addField("validateFunctions", d.ValidateFunctions)
+	addField("validateVariables", d.ValidateVariables)
+	addField("validateConstants", d.ValidateConstants)
+	addField("validateTypes", d.ValidateTypes)
+	addField("validateDocNames", d.ValidateDocNames)
+	addField("minPackageSentences", d.MinPackageSentences)
+	addField("requirePackageUsage", d.RequirePackageUsage)
+	addField("validateHeaderDescriptions", d.ValidateHeaderDescriptions)
+	addField("unreferencedExports", d.UnreferencedExports)
+	addField("enumException", d.EnumException)
+	addField("skipAliases", d.SkipAliases)
+	addField("validateEmbedded", d.ValidateEmbedded)
+	addField("acceptTrailingComments", d.AcceptTrailingComments)
+	addField("validateDirectives", d.ValidateDirectives)
+	addField("docFile", d.DocFile)
+	addField("spelling", d.Spelling)
+	addField("lineWidth", d.LineWidth)
+	addField("disabledRules", d.DisabledRules)
+}
+
+// DocFile is the configuration for the check that library packages, those outside of
+// internal directories, have a doc.go file with an extended package comment, more than a
+// single sentence.
+type DocFile struct {
+	// Paths contains globs of the import paths of the library packages that must have a
+	// doc.go file, e.g. "github.com/getoutreach/sdk/**". Defaults to none, which disables
+	// the check.
+	Paths []string `yaml:"paths"`
+
+	// ExceptionsFile is the path, relative to the config file, of a file listing globs of the
+	// import paths of the packages exempt from having a doc.go file, one per line, see
+	// LoadExceptions. Defaults to no exceptions.
+	ExceptionsFile string `yaml:"exceptionsFile"`
+
+	// Exceptions contains the globs loaded from ExceptionsFile.
+	Exceptions []string `yaml:"-"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (df *DocFile) MarshalLog(addField func(key string, value interface{})) {
+	addField("paths", df.Paths)
+	addField("exceptionsFile", df.ExceptionsFile)
+}
+
+// Spelling is the configuration for the spell check that doculint runs over doc comments.
+type Spelling struct {
+	// Enabled denotes whether or not misspellings in doc comments are reported. Defaults to
+	// false.
+	Enabled bool `yaml:"enabled"`
+
+	// Locale is the locale whose spellings are preferred, either "en-US" or "en-GB". When
+	// set, the spellings of the other locale are reported as well. Defaults to an empty
+	// string, which only reports misspellings.
+	Locale string `yaml:"locale"`
+
+	// IgnoreWords contains words that are never reported, e.g. product names.
+	IgnoreWords []string `yaml:"ignoreWords"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (s *Spelling) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", s.Enabled)
+	addField("locale", s.Locale)
+	addField("ignoreWords", s.IgnoreWords)
+}
+
+// LineWidth is the configuration for the maximum width of doc comment lines.
+type LineWidth struct {
+	// Enabled denotes whether or not doc comments with lines wider than Max are reported.
+	// Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// Max is the maximum width of a doc comment line, including its indentation. Defaults
+	// to 100.
+	Max int `yaml:"max"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (lw *LineWidth) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", lw.Enabled)
+	addField("max", lw.Max)
+}
+
+// Todo is the configuration type that matches the flags exposed by the todo linter.
+type Todo struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to true.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (t *Todo) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", t.Enabled)
+	addField("includeTests", t.IncludeTests)
+	addField("includeGenerated", t.IncludeGenerated)
+}
+
+// Why is the configuration type that matches the flags exposed by the why linter.
+type Why struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to true.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// MaxCopies is the number of times a nolint directive for the same linters with the
+	// same reason may be copied within a package before it is reported, suggesting to fix
+	// the underlying issues or cover them with a single range directive. Copies within
+	// declarations of the same name in files with build constraints count once. Defaults
+	// to 0, which allows any number of copies.
+	MaxCopies int `yaml:"maxCopies"`
 }
 
 // MarshalLog implements the log.Marshaler interface.
 func (w *Why) MarshalLog(addField func(key string, value interface{})) {
 	addField("enabled", w.Enabled)
+	addField("includeTests", w.IncludeTests)
+	addField("includeGenerated", w.IncludeGenerated)
+	addField("maxCopies", w.MaxCopies)
+}
+
+// CommentedCode is the configuration for the commentedcode linter.
+type CommentedCode struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// MinLines is the minimum number of lines of commented-out code that are reported.
+	// Defaults to 3.
+	MinLines int `yaml:"minLines"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (cc *CommentedCode) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", cc.Enabled)
+	addField("includeTests", cc.IncludeTests)
+	addField("includeGenerated", cc.IncludeGenerated)
+	addField("minLines", cc.MinLines)
+}
+
+// GoGenerate is the configuration for the gogenerate linter.
+type GoGenerate struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// AllowedCommands contains commands that go:generate directives may invoke without them
+	// being version-pinned, e.g. "sh".
+	AllowedCommands []string `yaml:"allowedCommands"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (gg *GoGenerate) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", gg.Enabled)
+	addField("includeGenerated", gg.IncludeGenerated)
+	addField("allowedCommands", gg.AllowedCommands)
+}
+
+// GoMod is the configuration for the gomod linter, the policy go.mod files are linted
+// against.
+type GoMod struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// ForbidReplace denotes whether or not replace directives are forbidden, except for
+	// those replacing a module matching AllowedReplace.
+	ForbidReplace bool `yaml:"forbidReplace"`
+
+	// AllowedReplace contains globs of the module paths that may be replaced when
+	// ForbidReplace is set.
+	AllowedReplace []string `yaml:"allowedReplace"`
+
+	// MinGoVersion is the minimum version, e.g. "1.22", that the go directive must be set to.
+	MinGoVersion string `yaml:"minGoVersion"`
+
+	// BannedModules contains globs of module paths that must not be required.
+	BannedModules []string `yaml:"bannedModules"`
+
+	// ForbidPseudoVersions denotes whether or not requiring a module at a pseudo-version is
+	// forbidden, except for modules matching PseudoVersionAllowlist.
+	ForbidPseudoVersions bool `yaml:"forbidPseudoVersions"`
+
+	// PseudoVersionAllowlist contains globs of module paths that may be required at a
+	// pseudo-version when ForbidPseudoVersions is set.
+	PseudoVersionAllowlist []string `yaml:"pseudoVersionAllowlist"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (gm *GoMod) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", gm.Enabled)
+	addField("forbidReplace", gm.ForbidReplace)
+	addField("allowedReplace", gm.AllowedReplace)
+	addField("minGoVersion", gm.MinGoVersion)
+	addField("bannedModules", gm.BannedModules)
+	addField("forbidPseudoVersions", gm.ForbidPseudoVersions)
+	addField("pseudoVersionAllowlist", gm.PseudoVersionAllowlist)
+}
+
+// Validate returns an error if the receiver contains a malformed glob or Go version.
+func (gm *GoMod) Validate() error {
+	if gm.MinGoVersion != "" && !version.IsValid("go"+gm.MinGoVersion) {
+		return fmt.Errorf("minGoVersion %q is not a valid Go version", gm.MinGoVersion)
+	}
+
+	globs := map[string][]string{
+		"allowedReplace":         gm.AllowedReplace,
+		"bannedModules":          gm.BannedModules,
+		"pseudoVersionAllowlist": gm.PseudoVersionAllowlist,
+	}
+	for _, field := range []string{"allowedReplace", "bannedModules", "pseudoVersionAllowlist"} {
+		for i, glob := range globs[field] {
+			if err := common.ValidateGlob(glob); err != nil {
+				return errors.Wrapf(err, "validate %s[%d]", field, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ErrorLint is the configuration for the errorlint linter.
+type ErrorLint struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// ValidateSpanNames denotes whether or not the names given to trace.StartSpan and
+	// trace.StartCall must be lowercase and dot-separated. Defaults to false.
+	ValidateSpanNames bool `yaml:"validateSpanNames"`
+
+	// SpanPrefix is the prefix, e.g. the name of the service, that every span name must
+	// start with, followed by a dot, when ValidateSpanNames is set.
+	SpanPrefix string `yaml:"spanPrefix"`
+
+	// RequireExternalWraps denotes whether or not errors returned from calls into other
+	// modules, including the standard library, must be wrapped before being returned.
+	// Defaults to false.
+	RequireExternalWraps bool `yaml:"requireExternalWraps"`
+
+	// PassThroughPackages contains the import paths of packages, in addition to errors, fmt,
+	// and github.com/pkg/errors, whose errors may be returned without being wrapped when
+	// RequireExternalWraps is set. Packages nested within them are included as well.
+	PassThroughPackages []string `yaml:"passThroughPackages"`
+
+	// RequireStaticMessages denotes whether or not the messages given to gobox's log
+	// functions and the names given to trace spans and calls must be constant strings.
+	// Defaults to false.
+	RequireStaticMessages bool `yaml:"requireStaticMessages"`
+
+	// RequireErrNames denotes whether or not local variables of type error must be named
+	// err, or start with err when several errors are live at once, e.g. errClose. Defaults
+	// to false.
+	RequireErrNames bool `yaml:"requireErrNames"`
+
+	// CheckDeferredClose denotes whether or not deferred calls to Close on writable
+	// resources, e.g. defer f.Close() on an *os.File, must not discard the error. Defaults
+	// to false.
+	CheckDeferredClose bool `yaml:"checkDeferredClose"`
+
+	// WritableTypes contains the types, in addition to *os.File, io.WriteCloser, and the
+	// writers of archive/tar, archive/zip, compress/gzip, and compress/zlib, whose Close
+	// error must be checked when CheckDeferredClose is set. Types are written as the import
+	// path of their package followed by a dot and their name, e.g. example.com/mod/store.Writer.
+	WritableTypes []string `yaml:"writableTypes"`
+
+	// ValidateMessageStyle denotes whether or not the messages given to functions that
+	// create errors, e.g. errors.New or fmt.Errorf, must not be capitalized or end with
+	// punctuation. Defaults to false.
+	ValidateMessageStyle bool `yaml:"validateMessageStyle"`
+
+	// CapitalizedWords contains the words, e.g. proper nouns like Outreach, that error
+	// messages may start with capitalized when ValidateMessageStyle is set. Acronyms like
+	// HTTP are always accepted. Defaults to none.
+	CapitalizedWords []string `yaml:"capitalizedWords"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (el *ErrorLint) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", el.Enabled)
+	addField("includeTests", el.IncludeTests)
+	addField("includeGenerated", el.IncludeGenerated)
+	addField("validateSpanNames", el.ValidateSpanNames)
+	addField("spanPrefix", el.SpanPrefix)
+	addField("requireExternalWraps", el.RequireExternalWraps)
+	addField("passThroughPackages", el.PassThroughPackages)
+	addField("requireStaticMessages", el.RequireStaticMessages)
+	addField("requireErrNames", el.RequireErrNames)
+	addField("checkDeferredClose", el.CheckDeferredClose)
+	addField("writableTypes", el.WritableTypes)
+	addField("validateMessageStyle", el.ValidateMessageStyle)
+	addField("capitalizedWords", el.CapitalizedWords)
+}
+
+// License is the configuration for the license linter, which compares the LICENSE file of
+// the module with the copyright required by the copyright linter and the headers present.
+type License struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (l *License) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", l.Enabled)
+	addField("includeTests", l.IncludeTests)
+	addField("includeGenerated", l.IncludeGenerated)
+}
+
+// CtxStruct is the configuration for the ctxstruct linter.
+type CtxStruct struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (cs *CtxStruct) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", cs.Enabled)
+	addField("includeTests", cs.IncludeTests)
+	addField("includeGenerated", cs.IncludeGenerated)
+}
+
+// Logging is the configuration for the logging linter.
+type Logging struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// AllowedPackages contains the names, e.g. "main", and import path globs, e.g.
+	// "**/tools/**", of the packages that may use logging stacks other than gobox's.
+	AllowedPackages []string `yaml:"allowedPackages"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (l *Logging) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", l.Enabled)
+	addField("includeTests", l.IncludeTests)
+	addField("includeGenerated", l.IncludeGenerated)
+	addField("allowedPackages", l.AllowedPackages)
+}
+
+// NoPrint is the configuration for the noprint linter.
+type NoPrint struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (np *NoPrint) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", np.Enabled)
+	addField("includeTests", np.IncludeTests)
+	addField("includeGenerated", np.IncludeGenerated)
+}
+
+// ThinMain is the configuration for the thinmain linter.
+type ThinMain struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// MaxLines is the maximum number of lines of a file in a main package. Defaults to 150.
+	MaxLines int `yaml:"maxLines"`
+
+	// DeniedImports contains the import path globs, e.g.
+	// "github.com/getoutreach/*/internal/store", of the packages holding business logic
+	// that main packages can not import.
+	DeniedImports []string `yaml:"deniedImports"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (tm *ThinMain) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", tm.Enabled)
+	addField("includeTests", tm.IncludeTests)
+	addField("includeGenerated", tm.IncludeGenerated)
+	addField("maxLines", tm.MaxLines)
+	addField("deniedImports", tm.DeniedImports)
+}
+
+// MetricName is the configuration for the metricname linter.
+type MetricName struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// Functions contains the full names of the functions, e.g.
+	// "github.com/getoutreach/gobox/pkg/metrics.NewCounter", and methods, e.g.
+	// "(github.com/getoutreach/gobox/pkg/metrics.Client).Count", whose first argument of
+	// type string is a metric name. Required when enabled.
+	Functions []string `yaml:"functions"`
+
+	// Pattern is the regular expression metric names must match. Defaults to lowercase
+	// words separated by underscores.
+	Pattern string `yaml:"pattern"`
+
+	// Prefixes contains the registered prefixes, e.g. "accounts_", one of which metric names
+	// must start with. Defaults to allowing any prefix.
+	Prefixes []string `yaml:"prefixes"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (mn *MetricName) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", mn.Enabled)
+	addField("includeTests", mn.IncludeTests)
+	addField("includeGenerated", mn.IncludeGenerated)
+	addField("functions", mn.Functions)
+	addField("pattern", mn.Pattern)
+	addField("prefixes", mn.Prefixes)
+}
+
+// ReflectUnsafe is the configuration for the reflectunsafe linter.
+type ReflectUnsafe struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// AllowedPackages contains the names and import path globs, e.g.
+	// "**/internal/codec/**", of the packages that may use reflect and unsafe.
+	AllowedPackages []string `yaml:"allowedPackages"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (ru *ReflectUnsafe) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", ru.Enabled)
+	addField("includeTests", ru.IncludeTests)
+	addField("includeGenerated", ru.IncludeGenerated)
+	addField("allowedPackages", ru.AllowedPackages)
+}
+
+// Receiver is the configuration for the receiver linter.
+type Receiver struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// MaxLength is the maximum number of characters of a receiver name. Defaults to 3.
+	MaxLength int `yaml:"maxLength"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (r *Receiver) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", r.Enabled)
+	addField("includeTests", r.IncludeTests)
+	addField("includeGenerated", r.IncludeGenerated)
+	addField("maxLength", r.MaxLength)
+}
+
+// Signature is the configuration for the signature linter.
+type Signature struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// MaxParams is the maximum number of parameters of a function. Defaults to 5.
+	MaxParams int `yaml:"maxParams"`
+
+	// MaxResults is the maximum number of results of a function. Defaults to 3.
+	MaxResults int `yaml:"maxResults"`
+
+	// ExportedOnly denotes whether or not only exported functions and methods are checked.
+	// Defaults to false.
+	ExportedOnly bool `yaml:"exportedOnly"`
+
+	// ExemptConstructors denotes whether or not constructors, functions whose name starts
+	// with New or new, e.g. NewClient, are exempt. Defaults to false.
+	ExemptConstructors bool `yaml:"exemptConstructors"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (s *Signature) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", s.Enabled)
+	addField("includeTests", s.IncludeTests)
+	addField("includeGenerated", s.IncludeGenerated)
+	addField("maxParams", s.MaxParams)
+	addField("maxResults", s.MaxResults)
+	addField("exportedOnly", s.ExportedOnly)
+	addField("exemptConstructors", s.ExemptConstructors)
+}
+
+// DupString is the configuration for the dupstring linter.
+type DupString struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// MaxOccurrences is the number of times a string literal may occur within a package.
+	// Defaults to 2.
+	MaxOccurrences int `yaml:"maxOccurrences"`
+
+	// MinLength is the number of characters a string literal needs to be counted, shorter
+	// literals are never reported. Defaults to 5.
+	MinLength int `yaml:"minLength"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (ds *DupString) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", ds.Enabled)
+	addField("includeTests", ds.IncludeTests)
+	addField("includeGenerated", ds.IncludeGenerated)
+	addField("maxOccurrences", ds.MaxOccurrences)
+	addField("minLength", ds.MinLength)
+}
+
+// MagicNumber is the configuration for the magicnumber linter.
+type MagicNumber struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// AllowedValues contains the numbers, in addition to 0, 1, and -1, that are allowed,
+	// written as they would be in Go source, e.g. "60" or "0.5".
+	AllowedValues []string `yaml:"allowedValues"`
+
+	// AllowPowersOfTwo denotes whether or not powers of two, e.g. 1024, are allowed.
+	// Defaults to false.
+	AllowPowersOfTwo bool `yaml:"allowPowersOfTwo"`
+
+	// AllowHTTPStatusCodes denotes whether or not HTTP status codes, e.g. 404, are allowed.
+	// Defaults to false.
+	AllowHTTPStatusCodes bool `yaml:"allowHTTPStatusCodes"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (mn *MagicNumber) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", mn.Enabled)
+	addField("includeTests", mn.IncludeTests)
+	addField("includeGenerated", mn.IncludeGenerated)
+	addField("allowedValues", mn.AllowedValues)
+	addField("allowPowersOfTwo", mn.AllowPowersOfTwo)
+	addField("allowHTTPStatusCodes", mn.AllowHTTPStatusCodes)
+}
+
+// CommentRules is the configuration for the commentrules linter, which runs rules defined
+// here over every line of every comment.
+type CommentRules struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// Rules are the rules ran over comments. Defaults to an empty list.
+	Rules []CommentRule `yaml:"rules"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (cr *CommentRules) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", cr.Enabled)
+	addField("includeTests", cr.IncludeTests)
+	addField("includeGenerated", cr.IncludeGenerated)
+	addField("rules", cr.Rules)
+}
+
+// Validate ensures that every rule has a unique name, well-formed patterns, and a known
+// severity.
+func (cr *CommentRules) Validate() error {
+	names := make(map[string]bool, len(cr.Rules))
+	for i := range cr.Rules {
+		rule := &cr.Rules[i]
+
+		if rule.Name == "" || strings.ContainsAny(rule.Name, "/ \t") {
+			return fmt.Errorf("rules[%d].name must not be empty or contain slashes or whitespace", i)
+		}
+
+		if names[rule.Name] {
+			return fmt.Errorf("rules[%d].name \"%s\" is used by more than one rule", i, rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.Match == "" {
+			return fmt.Errorf("rules[%d].match must not be empty", i)
+		}
+
+		if _, err := regexp.Compile(rule.Match); err != nil {
+			return errors.Wrapf(err, "rules[%d].match", i)
+		}
+
+		if _, err := regexp.Compile(rule.Require); err != nil {
+			return errors.Wrapf(err, "rules[%d].require", i)
+		}
+
+		switch rule.Severity {
+		case "", SeverityError, SeverityWarning:
+		default:
+			return fmt.Errorf("rules[%d].severity \"%s\" is not one of \"%s\" or \"%s\"", i, rule.Severity, SeverityError, SeverityWarning)
+		}
+	}
+
+	return nil
+}
+
+// LinkLint is the configuration for the linklint linter.
+type LinkLint struct {
+	// Enabled denotes whether or not this linter is enabled. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// IncludeTests denotes whether or not test files and test packages are linted rather
+	// than skipped. Defaults to false.
+	IncludeTests bool `yaml:"includeTests"`
+
+	// IncludeGenerated denotes whether or not generated files are linted rather than
+	// skipped. Defaults to false.
+	IncludeGenerated bool `yaml:"includeGenerated"`
+
+	// DeprecatedDomains are the domains, e.g. "wiki.example.com", that links in comments
+	// can't point to, including their subdomains.
+	DeprecatedDomains []string `yaml:"deprecatedDomains"`
+
+	// Online denotes whether or not each link in comments is requested to report dead
+	// links. Defaults to false, since it needs network access and slows runs down.
+	Online bool `yaml:"online"`
+
+	// CacheFile is the path of the file, relative to the working directory, the results of
+	// requesting links are cached in across runs when Online is set, e.g.
+	// ".lintroller-links.json". Defaults to caching them for the current run only.
+	CacheFile string `yaml:"cacheFile"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (ll *LinkLint) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", ll.Enabled)
+	addField("includeTests", ll.IncludeTests)
+	addField("includeGenerated", ll.IncludeGenerated)
+	addField("deprecatedDomains", ll.DeprecatedDomains)
+	addField("online", ll.Online)
+	addField("cacheFile", ll.CacheFile)
+}
+
+// Validate ensures that every deprecated domain is a bare domain, without a scheme or
+// path.
+func (ll *LinkLint) Validate() error {
+	for i, domain := range ll.DeprecatedDomains {
+		if domain == "" || strings.ContainsAny(domain, ":/ \t,") {
+			return fmt.Errorf("deprecatedDomains[%d] \"%s\" must be a domain without a scheme or path, e.g. wiki.example.com",
+				i, domain)
+		}
+	}
+
+	return nil
+}
+
+// CommentRule is a rule ran over every line of every comment.
+type CommentRule struct {
+	// Name identifies the rule, e.g. "commentrules/safety", in reports, in suppressions, and
+	// in nolint directives.
+	Name string `yaml:"name"`
+
+	// Match is the regular expression of the comment lines, without their comment markers,
+	// the rule applies to.
+	Match string `yaml:"match"`
+
+	// Require is the regular expression the lines matching Match must also match. When
+	// empty, every line matching Match is reported.
+	Require string `yaml:"require"`
+
+	// Message is the message lines breaking the rule are reported with. Defaults to a
+	// message naming the patterns.
+	Message string `yaml:"message"`
+
+	// Severity is either SeverityError or SeverityWarning. Defaults to SeverityError.
+	Severity string `yaml:"severity"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (cr *CommentRule) MarshalLog(addField func(key string, value interface{})) {
+	addField("name", cr.Name)
+	addField("match", cr.Match)
+	addField("require", cr.Require)
+	addField("message", cr.Message)
+	addField("severity", cr.Severity)
+}
+
+// Scoring is the configuration of the quality score, from 0 to 100, of every package and
+// of the repository as a whole, written after the summary and included in the json format.
+// Every issue costs the weight of the rule that reported it, and a package whose issues
+// cost one per 100 lines scores 50.
+type Scoring struct {
+	// Enabled denotes whether or not packages are scored. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// DefaultWeight is the weight of the rules without a weight in Weights. Defaults to 1.
+	DefaultWeight float64 `yaml:"defaultWeight"`
+
+	// Weights maps linter names, or rule IDs such as "doculint/spelling", to the weight of
+	// their issues. Rule IDs take precedence over the linter they belong to.
+	Weights map[string]float64 `yaml:"weights"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (s *Scoring) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", s.Enabled)
+	addField("defaultWeight", s.DefaultWeight)
+	addField("weights", s.Weights)
+}
+
+// Validate ensures that every weight is non-negative and refers to a known linter.
+func (s *Scoring) Validate() error {
+	if s.DefaultWeight < 0 {
+		return errors.New("defaultWeight must not be negative")
+	}
+
+	for key, weight := range s.Weights {
+		linter, _, _ := strings.Cut(key, "/")
+		if err := validateLinter(linter); err != nil {
+			return fmt.Errorf("weights.%s %v", key, err)
+		}
+
+		if weight < 0 {
+			return fmt.Errorf("weights.%s must not be negative", key)
+		}
+	}
+
+	return nil
+}
+
+// Build is the configuration of the build tags and target platforms the packages are
+// loaded with. Files excluded by the build constraints of every configuration aren't
+// linted, so e.g. integration tests guarded by a tag or files specific to another
+// operating system need a configuration selecting them.
+type Build struct {
+	// Tags are the build tags every configuration is loaded with, in addition to its own,
+	// e.g. "integration".
+	Tags []string `yaml:"tags"`
+
+	// Configurations are the combinations of GOOS, GOARCH, and build tags the packages are
+	// loaded and analyzed under within a single run. Each package is analyzed under every
+	// configuration that selects files not selected by an earlier one, and issues found
+	// under several configurations are only reported once. Defaults to the platform
+	// lintroller is ran on.
+	Configurations []BuildConfiguration `yaml:"configurations"`
+
+	// LintExcludedFiles denotes whether or not the Go files excluded by build constraints,
+	// e.g. by a //go:build ignore line, are linted by the linters that don't need type
+	// information: header, todo, and why. Copyright checks them regardless.
+	LintExcludedFiles bool `yaml:"lintExcludedFiles"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (b *Build) MarshalLog(addField func(key string, value interface{})) {
+	addField("tags", b.Tags)
+	addField("configurations", b.Configurations)
+	addField("lintExcludedFiles", b.LintExcludedFiles)
+}
+
+// Validate ensures that every build tag is a valid tag and that every configuration sets
+// at least one of its fields.
+func (b *Build) Validate() error {
+	if err := validateBuildTags(b.Tags); err != nil {
+		return errors.Wrap(err, "tags")
+	}
+
+	for i := range b.Configurations {
+		if err := b.Configurations[i].Validate(); err != nil {
+			return errors.Wrapf(err, "configurations[%d]", i)
+		}
+	}
+
+	return nil
+}
+
+// BuildConfiguration is a combination of target platform and build tags the packages are
+// loaded with, see Build.Configurations.
+type BuildConfiguration struct {
+	// GOOS is the operating system to load the packages for, e.g. "windows". Defaults to
+	// the operating system lintroller is ran on.
+	GOOS string `yaml:"goos"`
+
+	// GOARCH is the architecture to load the packages for, e.g. "arm64". Defaults to the
+	// architecture lintroller is ran on.
+	GOARCH string `yaml:"goarch"`
+
+	// Tags are the build tags to load the packages with, in addition to Build.Tags.
+	Tags []string `yaml:"tags"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (bc *BuildConfiguration) MarshalLog(addField func(key string, value interface{})) {
+	addField("goos", bc.GOOS)
+	addField("goarch", bc.GOARCH)
+	addField("tags", bc.Tags)
+}
+
+// Validate ensures that the configuration sets at least one of its fields and that its
+// fields are valid.
+func (bc *BuildConfiguration) Validate() error {
+	if bc.GOOS == "" && bc.GOARCH == "" && len(bc.Tags) == 0 {
+		return errors.New("must set at least one of goos, goarch, and tags")
+	}
+
+	if bc.GOOS != "" && !buildTagPattern.MatchString(bc.GOOS) {
+		return fmt.Errorf("goos %q is not a valid operating system", bc.GOOS)
+	}
+
+	if bc.GOARCH != "" && !buildTagPattern.MatchString(bc.GOARCH) {
+		return fmt.Errorf("goarch %q is not a valid architecture", bc.GOARCH)
+	}
+
+	return errors.Wrap(validateBuildTags(bc.Tags), "tags")
+}
+
+// buildTagPattern matches the build tags accepted by the go command.
+var buildTagPattern = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+// validateBuildTags ensures that every given build tag is accepted by the go command.
+func validateBuildTags(tags []string) error {
+	for _, tag := range tags {
+		if !buildTagPattern.MatchString(tag) {
+			return fmt.Errorf("%q is not a valid build tag", tag)
+		}
+	}
+
+	return nil
+}
+
+// CompanionFiles is the configuration for checking the non-Go files that live alongside Go
+// code in the module against the requirements of the header and copyright linters. The
+// checks only run when lintroller is given a config file, not as a vet tool.
+type CompanionFiles struct {
+	// Enabled denotes whether or not companion files are checked. Defaults to false.
+	Enabled bool `yaml:"enabled"`
+
+	// Extensions are the extensions of the companion files to check, e.g. ".proto".
+	// Defaults to ".proto", ".sh", and ".sql" when unset.
+	Extensions []string `yaml:"extensions"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (cf *CompanionFiles) MarshalLog(addField func(key string, value interface{})) {
+	addField("enabled", cf.Enabled)
+	addField("extensions", cf.Extensions)
+}
+
+// Validate ensures that each of the extensions is one that companion files can be checked
+// for.
+func (cf *CompanionFiles) Validate() error {
+	for i, ext := range cf.Extensions {
+		if _, ok := companion.CommentPrefixes[ext]; !ok {
+			return fmt.Errorf("lintroller.companionFiles.extensions[%d] \"%s\" is not one of: %s",
+				i, ext, strings.Join(companion.DefaultExtensions, ", "))
+		}
+	}
+
+	return nil
+}
+
+// ExtensionsOrDefault returns the extensions of the companion files to check.
+func (cf *CompanionFiles) ExtensionsOrDefault() []string {
+	if len(cf.Extensions) == 0 {
+		return companion.DefaultExtensions
+	}
+	return cf.Extensions
+}
+
+// TestDetection is the configuration for detecting the test files and test packages that
+// linters skip.
+type TestDetection struct {
+	// FilePatterns are additional patterns, matched against the base name of a file, that
+	// denote test files, e.g. "*_it.go". Files matching test_*.go or *_test.go are always
+	// test files.
+	FilePatterns []string `yaml:"filePatterns"`
+
+	// PackageSuffixes are the package name suffixes that denote test packages. Defaults to
+	// "test" when unset.
+	PackageSuffixes []string `yaml:"packageSuffixes"`
+
+	// LintTests contains the names of the linters that lint test files and test packages
+	// rather than skipping them.
+	LintTests []string `yaml:"lintTests"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (td *TestDetection) MarshalLog(addField func(key string, value interface{})) {
+	addField("filePatterns", td.FilePatterns)
+	addField("packageSuffixes", td.PackageSuffixes)
+	addField("lintTests", td.LintTests)
+}
+
+// Validate ensures that the file patterns are well formed and that each linter named by
+// LintTests exists.
+func (td *TestDetection) Validate() error {
+	for i, pattern := range td.FilePatterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "lintroller.testDetection.filePatterns[%d] is invalid", i)
+		}
+	}
+
+	for i, suffix := range td.PackageSuffixes {
+		if strings.TrimSpace(suffix) == "" {
+			return fmt.Errorf("lintroller.testDetection.packageSuffixes[%d] must not be empty", i)
+		}
+	}
+
+	for i, linter := range td.LintTests {
+		var found bool
+		for _, name := range Linters {
+			if linter == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("lintroller.testDetection.lintTests[%d] \"%s\" is not one of: %s",
+				i, linter, strings.Join(Linters, ", "))
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,379 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package config
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTestDetectionValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		detection     TestDetection
+		expectedError string
+	}{
+		{
+			name: "Accepts a valid configuration",
+			detection: TestDetection{
+				FilePatterns:    []string{"*_it.go"},
+				PackageSuffixes: []string{"test", "testutils"},
+				LintTests:       []string{"why"},
+			},
+		},
+		{
+			name:          "Rejects malformed file patterns",
+			detection:     TestDetection{FilePatterns: []string{"["}},
+			expectedError: "filePatterns[0] is invalid",
+		},
+		{
+			name:          "Rejects empty package suffixes",
+			detection:     TestDetection{PackageSuffixes: []string{""}},
+			expectedError: "packageSuffixes[0] must not be empty",
+		},
+		{
+			name:          "Rejects unknown linters",
+			detection:     TestDetection{LintTests: []string{"gofmt"}},
+			expectedError: "\"gofmt\" is not one of",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.detection.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestHeaderValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		header        Header
+		expectedError string
+	}{
+		{
+			name: "Accepts a valid configuration",
+			header: Header{PathFields: []HeaderPathFields{
+				{Paths: []string{"cmd/**"}, Fields: []string{"Command"}},
+			}},
+		},
+		{
+			name:          "Rejects path fields without paths",
+			header:        Header{PathFields: []HeaderPathFields{{Fields: []string{"Command"}}}},
+			expectedError: "pathFields[0].paths must not be empty",
+		},
+		{
+			name:          "Rejects malformed paths",
+			header:        Header{PathFields: []HeaderPathFields{{Paths: []string{"["}, Fields: []string{"Command"}}}},
+			expectedError: "pathFields[0].paths[0]",
+		},
+		{
+			name:          "Rejects empty fields",
+			header:        Header{PathFields: []HeaderPathFields{{Paths: []string{"cmd/**"}, Fields: []string{" "}}}},
+			expectedError: "pathFields[0].fields[0] must not be empty",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.header.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestLintTests(t *testing.T) {
+	tt := []struct {
+		name     string
+		config   Lintroller
+		expected []string
+	}{
+		{
+			name:     "Skips tests by default",
+			config:   Lintroller{},
+			expected: nil,
+		},
+		{
+			name: "Combines linters including tests with test detection",
+			config: Lintroller{
+				TestDetection: TestDetection{LintTests: []string{"why"}},
+				Todo:          Todo{IncludeTests: true},
+				ErrorLint:     ErrorLint{IncludeTests: true},
+			},
+			expected: []string{"why", "todo", "errorlint"},
+		},
+		{
+			name:     "Includes tests for every linter",
+			config:   Lintroller{IncludeTests: true},
+			expected: Linters,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, test.config.LintTests(), test.expected)
+		})
+	}
+}
+
+func TestLintGenerated(t *testing.T) {
+	lr := Lintroller{
+		Header:     Header{IncludeGenerated: true},
+		Copyright:  Copyright{IncludeGenerated: true},
+		GoGenerate: GoGenerate{IncludeGenerated: false},
+	}
+	assert.DeepEqual(t, lr.LintGenerated(), []string{"header", "copyright"})
+}
+
+func TestCommentRulesValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		rules         []CommentRule
+		expectedError string
+	}{
+		{
+			name:  "Accepts valid rules",
+			rules: []CommentRule{{Name: "safety", Match: "^SAFETY:", Require: `^SAFETY: \S`, Severity: SeverityWarning}},
+		},
+		{
+			name:          "Rejects rules without a name",
+			rules:         []CommentRule{{Match: "^SAFETY:"}},
+			expectedError: "rules[0].name must not be empty",
+		},
+		{
+			name:          "Rejects duplicate names",
+			rules:         []CommentRule{{Name: "safety", Match: "a"}, {Name: "safety", Match: "b"}},
+			expectedError: "rules[1].name \"safety\" is used by more than one rule",
+		},
+		{
+			name:          "Rejects malformed patterns",
+			rules:         []CommentRule{{Name: "safety", Match: "a", Require: "("}},
+			expectedError: "rules[0].require",
+		},
+		{
+			name:          "Rejects unknown severities",
+			rules:         []CommentRule{{Name: "safety", Match: "a", Severity: "info"}},
+			expectedError: "rules[0].severity \"info\" is not one of",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			cr := CommentRules{Rules: test.rules}
+
+			err := cr.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestScoringValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		scoring       Scoring
+		expectedError string
+	}{
+		{
+			name:    "Accepts weights of linters and rules",
+			scoring: Scoring{DefaultWeight: 2, Weights: map[string]float64{"todo": 0.5, "doculint/spelling": 0}},
+		},
+		{
+			name:          "Rejects unknown linters",
+			scoring:       Scoring{Weights: map[string]float64{"nope/rule": 1}},
+			expectedError: "weights.nope/rule \"nope\" is not one of",
+		},
+		{
+			name:          "Rejects negative weights",
+			scoring:       Scoring{Weights: map[string]float64{"todo": -1}},
+			expectedError: "weights.todo must not be negative",
+		},
+		{
+			name:          "Rejects a negative default weight",
+			scoring:       Scoring{DefaultWeight: -1},
+			expectedError: "defaultWeight must not be negative",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.scoring.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestLinkLintValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		linkLint      LinkLint
+		expectedError string
+	}{
+		{
+			name:     "Accepts domains",
+			linkLint: LinkLint{DeprecatedDomains: []string{"wiki.example.com", "example.org"}},
+		},
+		{
+			name:          "Rejects links",
+			linkLint:      LinkLint{DeprecatedDomains: []string{"https://wiki.example.com"}},
+			expectedError: "deprecatedDomains[0] \"https://wiki.example.com\" must be a domain without a scheme or path",
+		},
+		{
+			name:          "Rejects empty domains",
+			linkLint:      LinkLint{DeprecatedDomains: []string{"example.org", ""}},
+			expectedError: "deprecatedDomains[1] \"\" must be a domain",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.linkLint.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestBuildValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		build         Build
+		expectedError string
+	}{
+		{
+			name: "Accepts tags and configurations",
+			build: Build{
+				Tags: []string{"integration"},
+				Configurations: []BuildConfiguration{
+					{GOOS: "windows"},
+					{GOOS: "linux", GOARCH: "arm64", Tags: []string{"e2e", "go1.22"}},
+				},
+			},
+		},
+		{
+			name:          "Rejects invalid tags",
+			build:         Build{Tags: []string{"integration,e2e"}},
+			expectedError: "tags: \"integration,e2e\" is not a valid build tag",
+		},
+		{
+			name:          "Rejects empty configurations",
+			build:         Build{Configurations: []BuildConfiguration{{GOOS: "linux"}, {}}},
+			expectedError: "configurations[1]: must set at least one of goos, goarch, and tags",
+		},
+		{
+			name:          "Rejects invalid platforms",
+			build:         Build{Configurations: []BuildConfiguration{{GOOS: "linux/amd64"}}},
+			expectedError: "goos \"linux/amd64\" is not a valid operating system",
+		},
+		{
+			name:          "Rejects invalid configuration tags",
+			build:         Build{Configurations: []BuildConfiguration{{Tags: []string{"-e2e"}}}},
+			expectedError: "configurations[0]: tags: \"-e2e\" is not a valid build tag",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.build.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestReportPathsValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		reportPaths   ReportPaths
+		expectedError string
+	}{
+		{
+			name:        "Accepts rewrites",
+			reportPaths: ReportPaths{RelativeToModule: true, Rewrites: []PathRewrite{{From: "/sandbox/"}}},
+		},
+		{
+			name:          "Rejects rewrites without a prefix",
+			reportPaths:   ReportPaths{Rewrites: []PathRewrite{{To: "svc/"}}},
+			expectedError: "rewrites[0].from must not be empty",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.reportPaths.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestUploadValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		upload        Upload
+		expectedError string
+	}{
+		{
+			name: "Accepts no URL",
+		},
+		{
+			name:   "Accepts HTTPS URLs",
+			upload: Upload{URL: "https://lint-inventory.example.com/v1/results"},
+		},
+		{
+			name:          "Rejects URLs without a scheme",
+			upload:        Upload{URL: "lint-inventory.example.com/v1/results"},
+			expectedError: "url \"lint-inventory.example.com/v1/results\" must be an http or https URL",
+		},
+		{
+			name:          "Rejects other schemes",
+			upload:        Upload{URL: "ftp://lint-inventory.example.com"},
+			expectedError: "url \"ftp://lint-inventory.example.com\" must be an http or https URL",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.upload.Validate()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
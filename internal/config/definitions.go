@@ -0,0 +1,200 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements loading the tier definitions, the minimum configuration
+// required by each tier, from YAML files. The built-in definitions are embedded from the
+// tiers directory and can be overridden, or added to, by a directory of definitions named
+// by the tierDefinitions option.
+
+package config
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// builtinTierDefinitions contains the tier definitions that ship with lintroller.
+//
+//go:embed tiers/*.yaml
+var builtinTierDefinitions embed.FS
+
+// tierDefinitions contains the tier definitions currently in effect, keyed by the lowercase
+// name of the tier.
+var tierDefinitions = struct {
+	mu          sync.RWMutex
+	definitions map[string]*Lintroller
+}{
+	definitions: mustLoadBuiltinTierDefinitions(),
+}
+
+// mustLoadBuiltinTierDefinitions loads the built-in tier definitions, panicking if they're
+// invalid since that can only be the result of a programming error.
+func mustLoadBuiltinTierDefinitions() map[string]*Lintroller {
+	tiers, err := fs.Sub(builtinTierDefinitions, "tiers")
+	if err != nil {
+		panic(errors.Wrap(err, "open built-in tier definitions"))
+	}
+
+	definitions, err := LoadTierDefinitions(tiers)
+	if err != nil {
+		panic(errors.Wrap(err, "load built-in tier definitions"))
+	}
+
+	return definitions
+}
+
+// LoadTierDefinitions loads and validates every tier definition (*.yaml or *.yml) file in
+// the root of the given filesystem. Each file contains the minimum configuration required
+// by a single tier, in the same format as the lintroller section of a config file, with
+// the tier key naming the tier being defined.
+func LoadTierDefinitions(fsys fs.FS) (map[string]*Lintroller, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "read tier definitions directory")
+	}
+
+	definitions := make(map[string]*Lintroller)
+	for _, entry := range entries {
+		if entry.IsDir() || (path.Ext(entry.Name()) != ".yaml" && path.Ext(entry.Name()) != ".yml") {
+			continue
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, errors.Wrapf(err, "read tier definition \"%s\"", entry.Name())
+		}
+
+		if err := validateTierDefinitionKeys(content); err != nil {
+			return nil, errors.Wrapf(err, "validate tier definition \"%s\"", entry.Name())
+		}
+
+		var definition Lintroller
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&definition); err != nil {
+			return nil, errors.Wrapf(err, "decode tier definition \"%s\"", entry.Name())
+		}
+
+		if err := validateTierDefinition(&definition); err != nil {
+			return nil, errors.Wrapf(err, "validate tier definition \"%s\"", entry.Name())
+		}
+
+		name := strings.ToLower(*definition.Tier)
+		if _, exists := definitions[name]; exists {
+			return nil, fmt.Errorf("tier \"%s\" is defined more than once, last in \"%s\"", name, entry.Name())
+		}
+		definitions[name] = &definition
+	}
+
+	return definitions, nil
+}
+
+// validateTierDefinitionKeys ensures that the given tier definition file only sets the tier
+// key and the options of linters, the keys named after an entry of Linters, e.g.
+// commentedCode for commentedcode. Every other option configures a run rather than the
+// minimum of a tier, so options added later are rejected until they're allowed here.
+func validateTierDefinitionKeys(content []byte) error {
+	linters := make(map[string]bool, len(Linters))
+	for _, linter := range Linters {
+		linters[linter] = true
+	}
+
+	var keys map[string]yaml.Node
+	if err := yaml.Unmarshal(content, &keys); err != nil {
+		return errors.Wrap(err, "decode keys")
+	}
+
+	var disallowed []string
+	for key := range keys {
+		if key != "tier" && !linters[strings.ToLower(key)] {
+			disallowed = append(disallowed, key)
+		}
+	}
+
+	if len(disallowed) > 0 {
+		sort.Strings(disallowed)
+		return fmt.Errorf("%s can not be set in a tier definition, only tier and the options of linters can",
+			strings.Join(disallowed, ", "))
+	}
+
+	return nil
+}
+
+// validateTierDefinition ensures that a tier definition is usable as the minimum
+// configuration of a tier.
+func validateTierDefinition(definition *Lintroller) error {
+	if definition.Tier == nil || strings.TrimSpace(*definition.Tier) == "" {
+		return errors.New("tier must be set to the name of the tier being defined")
+	}
+
+	for i := range definition.Header.Fields {
+		if strings.TrimSpace(definition.Header.Fields[i]) == "" {
+			return fmt.Errorf("header.fields[%d] must not be empty", i)
+		}
+	}
+
+	if definition.Copyright.Pattern != "" {
+		if _, err := regexp.Compile(definition.Copyright.Pattern); err != nil {
+			return errors.Wrap(err, "compile copyright.pattern")
+		}
+	}
+
+	if definition.Doculint.MinFunLen < 0 {
+		return errors.New("doculint.minFunLen must not be negative")
+	}
+
+	return nil
+}
+
+// OverrideTierDefinitions loads the tier definitions in the given directory over the
+// definitions currently in effect. Definitions for tiers that already exist replace them
+// entirely, the rest are added as new tiers.
+func OverrideTierDefinitions(dir string) error {
+	definitions, err := LoadTierDefinitions(os.DirFS(dir))
+	if err != nil {
+		return errors.Wrapf(err, "load tier definitions from \"%s\"", dir)
+	}
+
+	tierDefinitions.mu.Lock()
+	defer tierDefinitions.mu.Unlock()
+
+	for name, definition := range definitions {
+		tierDefinitions.definitions[name] = definition
+	}
+
+	return nil
+}
+
+// TierDefinition returns the minimum configuration required by the given tier and whether
+// or not the tier exists. The tier name is case-insensitive.
+func TierDefinition(tier string) (*Lintroller, bool) {
+	tierDefinitions.mu.RLock()
+	defer tierDefinitions.mu.RUnlock()
+
+	definition, ok := tierDefinitions.definitions[strings.ToLower(tier)]
+	return definition, ok
+}
+
+// TierNames returns the sorted names of every tier currently defined.
+func TierNames() []string {
+	tierDefinitions.mu.RLock()
+	defer tierDefinitions.mu.RUnlock()
+
+	names := make([]string, 0, len(tierDefinitions.definitions))
+	for name := range tierDefinitions.definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
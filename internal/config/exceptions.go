@@ -0,0 +1,43 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the exceptions file of the check that library packages
+// have a doc.go file, see DocFile.
+
+package config
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/pkg/errors"
+)
+
+// LoadExceptions reads the globs listed in the exceptions file at the given path, one per
+// line. Blank lines are ignored, as is everything following a #, so that each exception can
+// say why it is one.
+func LoadExceptions(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open exceptions file")
+	}
+	defer f.Close()
+
+	var globs []string
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		glob, _, _ := strings.Cut(scanner.Text(), "#")
+		if glob = strings.TrimSpace(glob); glob == "" {
+			continue
+		}
+
+		if err := common.ValidateGlob(glob); err != nil {
+			return nil, errors.Wrapf(err, "validate line %d of \"%s\"", line, path)
+		}
+		globs = append(globs, glob)
+	}
+
+	return globs, errors.Wrap(scanner.Err(), "read exceptions file")
+}
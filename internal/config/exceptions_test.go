@@ -0,0 +1,56 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadExceptions(t *testing.T) {
+	tt := []struct {
+		name          string
+		content       string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name: "Loads exceptions",
+			content: `# Packages exempt from having a doc.go file.
+github.com/getoutreach/sdk/legacy/** # Deprecated, removed in v2.
+
+github.com/getoutreach/sdk/mocks
+`,
+			expected: []string{"github.com/getoutreach/sdk/legacy/**", "github.com/getoutreach/sdk/mocks"},
+		},
+		{
+			name:     "Loads empty files",
+			content:  "",
+			expected: nil,
+		},
+		{
+			name:          "Rejects malformed globs",
+			content:       "github.com/getoutreach/sdk/[\n",
+			expectedError: "validate line 1",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "docfile-exceptions")
+			assert.NilError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			exceptions, err := LoadExceptions(path)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.DeepEqual(t, exceptions, test.expected)
+		})
+	}
+}
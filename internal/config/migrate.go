@@ -0,0 +1,193 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the versioning of the config file schema, warning about
+// deprecated keys when a config file is decoded and rewriting them to the current schema.
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentVersion is the version of the config file schema this version of lintroller reads
+// and Migrate rewrites config files to. A config file declares the version of the schema
+// it was written for with lintroller.version, config files without one are read as if
+// they were written for the current version.
+const CurrentVersion = 2
+
+// renamedKey is a key of the config file that was renamed in a version of the schema.
+type renamedKey struct {
+	// parent is the path of the mapping the key is in, relative to the lintroller key.
+	parent []string
+
+	// from is the name the key had before version.
+	from string
+
+	// to is the name the key has from version onwards.
+	to string
+
+	// version is the version of the schema the key was renamed in.
+	version int
+}
+
+// renamedKeys contains every key that was renamed since the first version of the schema.
+// Renamed keys are still read, with a warning, until they're removed in a later version.
+var renamedKeys = []renamedKey{
+	{parent: []string{"copyright"}, from: "string", to: "text", version: 2},
+	{parent: []string{"copyright"}, from: "regex", to: "pattern", version: 2},
+}
+
+// Deprecation describes a deprecated key found in a config file.
+type Deprecation struct {
+	// Key is the path of the deprecated key, e.g. "lintroller.copyright.string".
+	Key string `json:"key"`
+
+	// Replacement is the path of the key that replaces it, e.g. "lintroller.copyright.text".
+	Replacement string `json:"replacement"`
+
+	// Version is the version of the schema the key was deprecated in.
+	Version int `json:"version"`
+}
+
+// String returns a human readable description of the deprecation.
+func (d *Deprecation) String() string {
+	return fmt.Sprintf("%s is deprecated since version %d of the config schema, use %s instead "+
+		"or run lintroller config migrate", d.Key, d.Version, d.Replacement)
+}
+
+// Deprecations returns every deprecated key found in the config file the receiver was
+// decoded from.
+func (l *Lintroller) Deprecations() []Deprecation {
+	return l.deprecations
+}
+
+// Migrate rewrites the given config file to the current version of the schema, renaming
+// every deprecated key and setting lintroller.version to CurrentVersion, and returns the
+// rewritten config file along with the deprecated keys it renamed. Comments are kept.
+func Migrate(content []byte) ([]byte, []Deprecation, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, nil, errors.Wrap(err, "decode config file")
+	}
+
+	deprecations, err := migrateNode(&doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lintroller := mappingValue(documentMapping(&doc), "lintroller")
+	if lintroller == nil {
+		return nil, nil, errors.New("config file has no lintroller key")
+	}
+
+	version := strconv.Itoa(CurrentVersion)
+	if node := mappingValue(lintroller, "version"); node != nil {
+		node.Value = version
+	} else {
+		lintroller.Content = append([]*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: "version"},
+			{Kind: yaml.ScalarNode, Tag: "!!int", Value: version},
+		}, lintroller.Content...)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, nil, errors.Wrap(err, "encode config file")
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, errors.Wrap(err, "encode config file")
+	}
+
+	return buf.Bytes(), deprecations, nil
+}
+
+// migrateNode renames every deprecated key in the given config file, decoded as a document
+// node, and returns the deprecated keys it renamed. Config files written for a newer
+// version of the schema than CurrentVersion, or setting both a deprecated key and its
+// replacement, are rejected.
+func migrateNode(doc *yaml.Node) ([]Deprecation, error) {
+	lintroller := mappingValue(documentMapping(doc), "lintroller")
+	if lintroller == nil || lintroller.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	if node := mappingValue(lintroller, "version"); node != nil {
+		version, err := strconv.Atoi(node.Value)
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("lintroller.version \"%s\" is not a positive integer", node.Value)
+		}
+
+		if version > CurrentVersion {
+			return nil, fmt.Errorf("lintroller.version %d is newer than version %d of the config schema this "+
+				"lintroller reads, upgrade lintroller", version, CurrentVersion)
+		}
+	}
+
+	var deprecations []Deprecation
+	for _, rk := range renamedKeys {
+		parent := lintroller
+		for _, key := range rk.parent {
+			if parent = mappingValue(parent, key); parent == nil {
+				break
+			}
+		}
+		if parent == nil || parent.Kind != yaml.MappingNode {
+			continue
+		}
+
+		path := strings.Join(append([]string{"lintroller"}, rk.parent...), ".")
+		for i := 0; i+1 < len(parent.Content); i += 2 {
+			if parent.Content[i].Value != rk.from {
+				continue
+			}
+
+			if mappingValue(parent, rk.to) != nil {
+				return nil, fmt.Errorf("%s.%s is deprecated and can't be set along with its replacement %s.%s",
+					path, rk.from, path, rk.to)
+			}
+
+			parent.Content[i].Value = rk.to
+			deprecations = append(deprecations, Deprecation{
+				Key:         path + "." + rk.from,
+				Replacement: path + "." + rk.to,
+				Version:     rk.version,
+			})
+		}
+	}
+
+	return deprecations, nil
+}
+
+// documentMapping returns the mapping at the root of the given document node, or nil if
+// the document is empty or its root isn't a mapping.
+func documentMapping(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+
+	return doc.Content[0]
+}
+
+// mappingValue returns the value of the given key in the given mapping node, or nil if the
+// node isn't a mapping or the key isn't in it.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMigrate(t *testing.T) {
+	tt := []struct {
+		name                 string
+		content              string
+		expected             string
+		expectedDeprecations []Deprecation
+		expectedError        string
+	}{
+		{
+			name: "Renames deprecated keys and keeps comments",
+			content: `lintroller:
+  copyright:
+    enabled: true
+    # The copyright of every file.
+    string: Copyright 2026 Outreach Corporation. All Rights Reserved.
+    regex: ^Copyright 20.*$
+`,
+			expected: `lintroller:
+  version: 2
+  copyright:
+    enabled: true
+    # The copyright of every file.
+    text: Copyright 2026 Outreach Corporation. All Rights Reserved.
+    pattern: ^Copyright 20.*$
+`,
+			expectedDeprecations: []Deprecation{
+				{Key: "lintroller.copyright.string", Replacement: "lintroller.copyright.text", Version: 2},
+				{Key: "lintroller.copyright.regex", Replacement: "lintroller.copyright.pattern", Version: 2},
+			},
+		},
+		{
+			name: "Updates the version of current config files",
+			content: `lintroller:
+  version: 1
+  todo:
+    enabled: true
+`,
+			expected: `lintroller:
+  version: 2
+  todo:
+    enabled: true
+`,
+		},
+		{
+			name: "Rejects deprecated keys set along with their replacements",
+			content: `lintroller:
+  copyright:
+    string: Copyright 2026 Outreach Corporation.
+    text: Copyright 2026 Outreach Corporation.
+`,
+			expectedError: "lintroller.copyright.string is deprecated and can't be set along with its replacement " +
+				"lintroller.copyright.text",
+		},
+		{
+			name: "Rejects newer versions",
+			content: `lintroller:
+  version: 3
+`,
+			expectedError: "lintroller.version 3 is newer than version 2 of the config schema this lintroller reads",
+		},
+		{
+			name:          "Rejects config files without a lintroller key",
+			content:       "linters: {}\n",
+			expectedError: "config file has no lintroller key",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			migrated, deprecations, err := Migrate([]byte(test.content))
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, string(migrated), test.expected)
+			assert.DeepEqual(t, deprecations, test.expectedDeprecations)
+		})
+	}
+}
+
+func TestFromFileReadsDeprecatedKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lintroller.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(`lintroller:
+  copyright:
+    enabled: true
+    regex: ^Copyright 20.*$
+`), 0o600))
+
+	cfg, err := FromFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, cfg.Copyright.Pattern, `^Copyright 20.*$`)
+	assert.DeepEqual(t, cfg.Deprecations(), []Deprecation{
+		{Key: "lintroller.copyright.regex", Replacement: "lintroller.copyright.pattern", Version: 2},
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the default organization config, a config file embedded
+// into the binary at build time that applies to repositories without a config file of their
+// own, and that the config file of a repository overrides otherwise.
+
+package config
+
+import (
+	"bytes"
+	"embed"
+	"io/fs"
+)
+
+// OrgConfigFile is the name of the default organization config in the org directory of
+// this package. Building lintroller with a file of this name there embeds it.
+const OrgConfigFile = "lintroller.yaml"
+
+// orgDirectory contains the org directory, which only contains the default organization
+// config if one was placed there before building lintroller.
+//
+//go:embed org
+var orgDirectory embed.FS
+
+// orgConfig is the default organization config embedded into the binary, nil if there is
+// none.
+var orgConfig = loadOrgConfig(orgDirectory)
+
+// loadOrgConfig returns the default organization config in the given file system, nil if
+// there is none or it is empty.
+func loadOrgConfig(fsys fs.FS) []byte {
+	content, err := fs.ReadFile(fsys, "org/"+OrgConfigFile)
+	if err != nil || len(bytes.TrimSpace(content)) == 0 {
+		return nil
+	}
+
+	return content
+}
+
+// OrgConfig returns the default organization config embedded into the binary, and whether
+// or not there is one.
+func OrgConfig() ([]byte, bool) {
+	return orgConfig, orgConfig != nil
+}
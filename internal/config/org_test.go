@@ -0,0 +1,69 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadOrgConfig(t *testing.T) {
+	assert.Assert(t, loadOrgConfig(fstest.MapFS{"org/README.md": {Data: []byte("# Org")}}) == nil)
+	assert.Assert(t, loadOrgConfig(fstest.MapFS{"org/lintroller.yaml": {Data: []byte("\n")}}) == nil)
+	assert.Equal(t, string(loadOrgConfig(fstest.MapFS{"org/lintroller.yaml": {Data: []byte("lintroller: {}\n")}})),
+		"lintroller: {}\n")
+}
+
+func TestDecodeFileOverOrgConfig(t *testing.T) {
+	original := orgConfig
+	t.Cleanup(func() { orgConfig = original })
+
+	orgConfig = nil
+	_, err := decodeFile("")
+	assert.ErrorContains(t, err, "no config file given and no default organization config embedded")
+
+	orgConfig = []byte(`lintroller:
+  ignorePaths: ["vendor/**"]
+  severities:
+    todo: warning
+    why: warning
+  doculint:
+    enabled: true
+    minFunLen: 12
+  errorLint:
+    enabled: true
+`)
+
+	// Without a config file, the organization config applies as is.
+	cfg, err := decodeFile("")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cfg.Lintroller.IgnorePaths, []string{"vendor/**"})
+	assert.Equal(t, cfg.Lintroller.Doculint.MinFunLen, 12)
+	assert.Equal(t, cfg.Lintroller.ErrorLint.Enabled, true)
+
+	path := filepath.Join(t.TempDir(), "lintroller.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(`lintroller:
+  ignorePaths: ["third_party/**"]
+  severities:
+    todo: error
+  doculint:
+    minFunLen: 20
+`), 0o600))
+
+	// Otherwise the keys of the config file override it.
+	cfg, err = decodeFile(path)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, cfg.Lintroller.IgnorePaths, []string{"third_party/**"})
+	assert.DeepEqual(t, cfg.Lintroller.Severities, map[string]string{"todo": "error", "why": "warning"})
+	assert.Equal(t, cfg.Lintroller.Doculint.Enabled, true)
+	assert.Equal(t, cfg.Lintroller.Doculint.MinFunLen, 20)
+	assert.Equal(t, cfg.Lintroller.ErrorLint.Enabled, true)
+
+	orgConfig = []byte("lintroller: [")
+	_, err = decodeFile(path)
+	assert.ErrorContains(t, err, "default organization config")
+}
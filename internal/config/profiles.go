@@ -0,0 +1,352 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements profiles, which adjust the configuration for the
+// context lintroller is ran in, e.g. CI or a pre-commit hook.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getoutreach/lintroller/internal/doculint"
+	"github.com/getoutreach/lintroller/internal/errorlint"
+	"github.com/getoutreach/lintroller/internal/linklint"
+)
+
+// Scopes of the files whose issues are reported.
+const (
+	// ScopeFull reports the issues in every file that is linted.
+	ScopeFull = "full"
+
+	// ScopeChanged only reports the issues in the files that changed compared to Base.
+	ScopeChanged = "changed"
+)
+
+// Severities of the issues reported by a linter.
+const (
+	// SeverityError reports issues as errors, which fail the run.
+	SeverityError = "error"
+
+	// SeverityWarning reports issues as warnings, which don't fail the run.
+	SeverityWarning = "warning"
+)
+
+// DefaultBase is the git revision changed files are compared to when no base is given,
+// which only reports issues in files with uncommitted changes.
+const DefaultBase = "HEAD"
+
+// Profile adjusts the configuration for the context lintroller is ran in. Profiles are
+// defined under lintroller.profiles and selected with the -profile flag.
+type Profile struct {
+	// Enable contains the names of the linters the profile enables.
+	Enable []string `yaml:"enable"`
+
+	// Disable contains the names of the linters the profile disables. Linters required by
+	// the tier are still enabled, or fail the run in strict mode.
+	Disable []string `yaml:"disable"`
+
+	// Severities maps the names of linters, or rule IDs, to the severity of their issues,
+	// either SeverityError or SeverityWarning, overriding lintroller.severities.
+	Severities map[string]string `yaml:"severities"`
+
+	// Scope is the scope of the files whose issues are reported, either ScopeFull or
+	// ScopeChanged, overriding lintroller.scope.
+	Scope string `yaml:"scope"`
+
+	// Base is the git revision changed files are compared to, overriding lintroller.base.
+	Base string `yaml:"base"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (p *Profile) MarshalLog(addField func(key string, value interface{})) {
+	addField("enable", p.Enable)
+	addField("disable", p.Disable)
+	addField("severities", p.Severities)
+	addField("scope", p.Scope)
+	addField("base", p.Base)
+}
+
+// Validate ensures that the receiver, found at the given path of the config file, only
+// refers to known linters, severities, and scopes.
+func (p *Profile) Validate(path string) error {
+	for i, linter := range p.Enable {
+		if err := validateLinter(linter); err != nil {
+			return fmt.Errorf("%s.enable[%d] %v", path, i, err)
+		}
+	}
+
+	for i, linter := range p.Disable {
+		if err := validateLinter(linter); err != nil {
+			return fmt.Errorf("%s.disable[%d] %v", path, i, err)
+		}
+	}
+
+	if err := validateSeverities(p.Severities); err != nil {
+		return fmt.Errorf("%s.severities%v", path, err)
+	}
+
+	if err := validateScope(p.Scope); err != nil {
+		return fmt.Errorf("%s.scope %v", path, err)
+	}
+
+	return nil
+}
+
+// ApplyProfile adjusts the receiver with the profile of the given name. This needs to be
+// called before ValidatePackageTiers so that package tiers inherit the adjustments.
+func (l *Lintroller) ApplyProfile(name string) error {
+	profile, ok := l.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(l.Profiles))
+		for n := range l.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		if len(names) == 0 {
+			return fmt.Errorf("profile \"%s\" is not defined, lintroller.profiles is empty", name)
+		}
+		return fmt.Errorf("profile \"%s\" is not one of: %s", name, strings.Join(names, ", "))
+	}
+
+	for _, linter := range profile.Enable {
+		*l.enabled(linter) = true
+	}
+
+	for _, linter := range profile.Disable {
+		*l.enabled(linter) = false
+	}
+
+	if len(profile.Severities) > 0 {
+		severities := make(map[string]string, len(l.Severities)+len(profile.Severities))
+		for linter, severity := range l.Severities {
+			severities[linter] = severity
+		}
+		for linter, severity := range profile.Severities {
+			severities[linter] = severity
+		}
+		l.Severities = severities
+	}
+
+	if profile.Scope != "" {
+		l.Scope = profile.Scope
+	}
+
+	if profile.Base != "" {
+		l.Base = profile.Base
+	}
+
+	return nil
+}
+
+// Warnings returns the names of the linters whose issues are reported as warnings, either
+// because of their severity or because they are within their grace period, see GraceUntil.
+func (l *Lintroller) Warnings() []string {
+	var linters []string
+	for _, linter := range Linters {
+		if l.Severities[linter] == SeverityWarning || l.inGracePeriod(linter) {
+			linters = append(linters, linter)
+		}
+	}
+
+	return linters
+}
+
+// WarningRules returns the sorted IDs of the rules, e.g. "doculint/spelling", whose issues
+// are reported as warnings, either because of their severity or because they are within
+// their grace period, see GraceUntil.
+func (l *Lintroller) WarningRules() []string {
+	seen := make(map[string]bool)
+	for key, severity := range l.Severities {
+		if strings.Contains(key, "/") && severity == SeverityWarning {
+			seen[key] = true
+		}
+	}
+	for key := range l.GraceUntil {
+		if strings.Contains(key, "/") && l.inGracePeriod(key) {
+			seen[key] = true
+		}
+	}
+
+	rules := make([]string, 0, len(seen))
+	for rule := range seen {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+
+	return rules
+}
+
+// ErrorRules returns the sorted IDs of the rules, e.g. "errorlint/wrap-message", whose
+// issues are reported as errors even when the other issues of their linter are warnings.
+// Rules within their grace period, or whose linter is, aren't included.
+func (l *Lintroller) ErrorRules() []string {
+	var rules []string
+	for key, severity := range l.Severities {
+		linter, _, isRule := strings.Cut(key, "/")
+		if isRule && severity == SeverityError && !l.inGracePeriod(key) && !l.inGracePeriod(linter) {
+			rules = append(rules, key)
+		}
+	}
+	sort.Strings(rules)
+
+	return rules
+}
+
+// inGracePeriod reports whether or not the grace period of the given linter or rule ID, see
+// GraceUntil, hasn't ended yet.
+func (l *Lintroller) inGracePeriod(key string) bool {
+	until, ok := l.GraceUntil[key]
+	if !ok {
+		return false
+	}
+
+	date, err := time.Parse(time.DateOnly, until)
+	return err == nil && now().Before(date)
+}
+
+// BaseOrDefault returns Base, or DefaultBase if it is unset.
+func (l *Lintroller) BaseOrDefault() string {
+	if l.Base == "" {
+		return DefaultBase
+	}
+
+	return l.Base
+}
+
+// enabled returns the field denoting whether or not the linter of the given name, which
+// must be one of Linters, is enabled.
+func (l *Lintroller) enabled(linter string) *bool {
+	table := map[string]*bool{
+		"header":        &l.Header.Enabled,
+		"copyright":     &l.Copyright.Enabled,
+		"doculint":      &l.Doculint.Enabled,
+		"todo":          &l.Todo.Enabled,
+		"why":           &l.Why.Enabled,
+		"commentedcode": &l.CommentedCode.Enabled,
+		"gogenerate":    &l.GoGenerate.Enabled,
+		"gomod":         &l.GoMod.Enabled,
+		"errorlint":     &l.ErrorLint.Enabled,
+		"license":       &l.License.Enabled,
+		"ctxstruct":     &l.CtxStruct.Enabled,
+		"logging":       &l.Logging.Enabled,
+		"noprint":       &l.NoPrint.Enabled,
+		"thinmain":      &l.ThinMain.Enabled,
+		"metricname":    &l.MetricName.Enabled,
+		"reflectunsafe": &l.ReflectUnsafe.Enabled,
+		"receiver":      &l.Receiver.Enabled,
+		"signature":     &l.Signature.Enabled,
+		"dupstring":     &l.DupString.Enabled,
+		"magicnumber":   &l.MagicNumber.Enabled,
+		"commentrules":  &l.CommentRules.Enabled,
+		"linklint":      &l.LinkLint.Enabled,
+	}
+
+	return table[linter]
+}
+
+// validateLinter returns an error if the given name isn't one of Linters.
+func validateLinter(linter string) error {
+	for _, name := range Linters {
+		if linter == name {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("\"%s\" is not one of: %s", linter, strings.Join(Linters, ", "))
+}
+
+// linterRules maps the linters whose rules are known ahead of time to the IDs of their
+// rules. The rules of the other linters, e.g. commentrules, are configured by name.
+var linterRules = map[string][]string{
+	"doculint":  doculint.Rules,
+	"errorlint": errorlint.Rules,
+	"linklint":  linklint.Rules,
+}
+
+// validateRuleKey returns an error if the given linter name, or rule ID such as
+// "doculint/spelling", refers to an unknown linter or an unknown rule of a linter whose
+// rules are known ahead of time.
+func validateRuleKey(key string) error {
+	linter, rule, isRule := strings.Cut(key, "/")
+	if err := validateLinter(linter); err != nil {
+		return err
+	}
+
+	rules, ok := linterRules[linter]
+	if !isRule || !ok {
+		return nil
+	}
+
+	for i := range rules {
+		if rules[i] == rule {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("\"%s\" is not one of the rules of %s: %s", rule, linter, strings.Join(rules, ", "))
+}
+
+// validateSeverities returns an error, prefixed with the offending key, if the given
+// severities refer to an unknown linter, rule, or severity.
+func validateSeverities(severities map[string]string) error {
+	for key, severity := range severities {
+		if err := validateRuleKey(key); err != nil {
+			return fmt.Errorf(".%s %v", key, err)
+		}
+
+		switch severity {
+		case SeverityError, SeverityWarning:
+		default:
+			return fmt.Errorf(".%s \"%s\" is not one of \"%s\" or \"%s\"", key, severity, SeverityError, SeverityWarning)
+		}
+	}
+
+	return nil
+}
+
+// validateGraceUntil returns an error, prefixed with the offending key, if the given grace
+// periods refer to an unknown linter or rule, or aren't dates.
+func validateGraceUntil(grace map[string]string) error {
+	for key, until := range grace {
+		if err := validateRuleKey(key); err != nil {
+			return fmt.Errorf(".%s %v", key, err)
+		}
+
+		if _, err := time.Parse(time.DateOnly, until); err != nil {
+			return fmt.Errorf(".%s \"%s\" is not a date of the form YYYY-MM-DD", key, until)
+		}
+	}
+
+	return nil
+}
+
+// validateEscalations returns an error, prefixed with the offending key, if the given
+// escalation thresholds refer to an unknown linter or are negative.
+func validateEscalations(thresholds map[string]int) error {
+	for linter, threshold := range thresholds {
+		if err := validateLinter(linter); err != nil {
+			return fmt.Errorf(".%s %v", linter, err)
+		}
+
+		if threshold < 0 {
+			return fmt.Errorf(".%s %d is negative", linter, threshold)
+		}
+	}
+
+	return nil
+}
+
+// validateScope returns an error if the given scope is neither empty nor one of the known
+// scopes.
+func validateScope(scope string) error {
+	switch scope {
+	case "", ScopeFull, ScopeChanged:
+		return nil
+	}
+
+	return fmt.Errorf("\"%s\" is not one of \"%s\" or \"%s\"", scope, ScopeFull, ScopeChanged)
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestApplyProfile(t *testing.T) {
+	lr := Lintroller{
+		Todo:       Todo{Enabled: true},
+		Severities: map[string]string{"todo": SeverityWarning, "why": SeverityWarning},
+		Profiles: map[string]Profile{
+			"ci": {
+				Enable:     []string{"errorlint"},
+				Disable:    []string{"todo"},
+				Severities: map[string]string{"why": SeverityError, "commentedcode": SeverityWarning},
+				Scope:      ScopeChanged,
+				Base:       "origin/main",
+			},
+		},
+	}
+
+	assert.NilError(t, lr.ApplyProfile("ci"))
+	assert.Equal(t, lr.ErrorLint.Enabled, true)
+	assert.Equal(t, lr.Todo.Enabled, false)
+	assert.DeepEqual(t, lr.Warnings(), []string{"todo", "commentedcode"})
+	assert.Equal(t, lr.Scope, ScopeChanged)
+	assert.Equal(t, lr.BaseOrDefault(), "origin/main")
+
+	assert.ErrorContains(t, lr.ApplyProfile("local"), "profile \"local\" is not one of: ci")
+}
+
+func TestProfileValidate(t *testing.T) {
+	tt := []struct {
+		name          string
+		profile       Profile
+		expectedError string
+	}{
+		{
+			name: "Accepts a valid profile",
+			profile: Profile{
+				Enable:     []string{"why"},
+				Severities: map[string]string{"todo": SeverityWarning},
+				Scope:      ScopeFull,
+			},
+		},
+		{
+			name:          "Rejects unknown linters",
+			profile:       Profile{Disable: []string{"gofmt"}},
+			expectedError: "lintroller.profiles.ci.disable[0] \"gofmt\" is not one of",
+		},
+		{
+			name:          "Rejects unknown severities",
+			profile:       Profile{Severities: map[string]string{"todo": "info"}},
+			expectedError: "lintroller.profiles.ci.severities.todo \"info\" is not one of \"error\" or \"warning\"",
+		},
+		{
+			name:    "Accepts the severities of rules",
+			profile: Profile{Severities: map[string]string{"errorlint/span-name": SeverityWarning, "commentrules/safety": SeverityError}},
+		},
+		{
+			name:          "Rejects the severities of unknown rules",
+			profile:       Profile{Severities: map[string]string{"errorlint/capitalization": SeverityWarning}},
+			expectedError: "lintroller.profiles.ci.severities.errorlint/capitalization \"capitalization\" is not one of the rules of errorlint",
+		},
+		{
+			name:          "Rejects unknown scopes",
+			profile:       Profile{Scope: "staged"},
+			expectedError: "lintroller.profiles.ci.scope \"staged\" is not one of \"full\" or \"changed\"",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.profile.Validate("lintroller.profiles.ci")
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestGraceUntil(t *testing.T) {
+	now = func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	lr := Lintroller{
+		Severities: map[string]string{"todo": SeverityWarning},
+		GraceUntil: map[string]string{
+			"magicnumber":        "2025-04-01",
+			"receiver":           "2025-03-01",
+			"doculint/spelling":  "2025-03-02",
+			"errorlint/wrapping": "2025-01-01",
+		},
+	}
+
+	// Grace periods end at the start of their date.
+	assert.DeepEqual(t, lr.Warnings(), []string{"todo", "magicnumber"})
+	assert.DeepEqual(t, lr.WarningRules(), []string{"doculint/spelling"})
+}
+
+func TestRuleSeverities(t *testing.T) {
+	now = func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	lr := Lintroller{
+		Severities: map[string]string{
+			"errorlint":                SeverityWarning,
+			"errorlint/wrap-message":   SeverityError,
+			"errorlint/span-name":      SeverityError,
+			"doculint/spelling":        SeverityWarning,
+			"doculint/missing-comment": SeverityError,
+			"todo/ticket":              SeverityError,
+		},
+		GraceUntil: map[string]string{
+			"errorlint/span-name": "2025-04-01",
+			"todo":                "2025-04-01",
+		},
+	}
+
+	assert.DeepEqual(t, lr.Warnings(), []string{"todo", "errorlint"})
+	assert.DeepEqual(t, lr.WarningRules(), []string{"doculint/spelling", "errorlint/span-name"})
+
+	// Rules within their grace period, or whose linter is, aren't errors yet.
+	assert.DeepEqual(t, lr.ErrorRules(), []string{"doculint/missing-comment", "errorlint/wrap-message"})
+}
+
+func TestValidateGraceUntil(t *testing.T) {
+	tt := []struct {
+		name          string
+		grace         map[string]string
+		expectedError string
+	}{
+		{
+			name:  "Accepts linters and rules",
+			grace: map[string]string{"todo": "2025-03-01", "doculint/spelling": "2025-04-01"},
+		},
+		{
+			name:          "Rejects unknown linters",
+			grace:         map[string]string{"gofmt/simplify": "2025-03-01"},
+			expectedError: ".gofmt/simplify \"gofmt\" is not one of",
+		},
+		{
+			name:          "Rejects unknown rules of linters with known rules",
+			grace:         map[string]string{"doculint/grammar": "2025-03-01"},
+			expectedError: ".doculint/grammar \"grammar\" is not one of the rules of doculint: missing-comment",
+		},
+		{
+			name:          "Rejects malformed dates",
+			grace:         map[string]string{"todo": "next month"},
+			expectedError: ".todo \"next month\" is not a date of the form YYYY-MM-DD",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateGraceUntil(test.grace)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestValidateEscalations(t *testing.T) {
+	tt := []struct {
+		name          string
+		thresholds    map[string]int
+		expectedError string
+	}{
+		{
+			name:       "Accepts linters",
+			thresholds: map[string]int{"todo": 5, "why": 0},
+		},
+		{
+			name:          "Rejects rules",
+			thresholds:    map[string]int{"doculint/spelling": 5},
+			expectedError: ".doculint/spelling \"doculint/spelling\" is not one of",
+		},
+		{
+			name:          "Rejects negative thresholds",
+			thresholds:    map[string]int{"todo": -1},
+			expectedError: ".todo -1 is negative",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateEscalations(test.thresholds)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the tier compliance report, which describes how a
+// config file compares to the minimums of the tiers it selects without failing on the
+// first problem found.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// Formats supported by (*TierReport).Write.
+const (
+	// ReportFormatJSON writes the report as indented JSON.
+	ReportFormatJSON = "json"
+
+	// ReportFormatText writes the report as a human readable table.
+	ReportFormatText = "text"
+)
+
+// ScopeDefault is the scope of the tier that applies to every package not selected by a
+// package tier.
+const ScopeDefault = "default"
+
+// TierCompliance describes how the configuration of a single scope compares to the minimums
+// of the tier selected for it.
+type TierCompliance struct {
+	// Scope is either ScopeDefault or "packageTiers[i]" for the i'th package tier.
+	Scope string `json:"scope"`
+
+	// Paths contains the path globs of the package tier, if this is one.
+	Paths []string `json:"paths,omitempty"`
+
+	// Tier is the name of the tier selected for the scope, empty if none is.
+	Tier string `json:"tier"`
+
+	// Mode is the tierMode the minimums were enforced with.
+	Mode string `json:"mode"`
+
+	// Compliant denotes whether or not the configuration of the scope is usable with its
+	// tier, which is to say it validated without an error.
+	Compliant bool `json:"compliant"`
+
+	// Met contains the minimums the configuration already met.
+	Met []Minimum `json:"met"`
+
+	// Raised contains the minimums the configuration was raised to meet.
+	Raised []Minimum `json:"raised"`
+
+	// Deviated contains the minimums the configuration deviates from.
+	Deviated []Minimum `json:"deviated"`
+
+	// Excepted contains the minimums the configuration is below, but that an exception
+	// accepts, along with the exception.
+	Excepted []Minimum `json:"excepted"`
+
+	// Error is the error the configuration of the scope failed to validate with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// TierReport describes how a config file compares to the minimums of every tier it selects.
+type TierReport struct {
+	// Compliant denotes whether or not every scope in Tiers is compliant.
+	Compliant bool `json:"compliant"`
+
+	// Tiers contains the compliance of the default scope followed by each package tier.
+	Tiers []TierCompliance `json:"tiers"`
+}
+
+// reportScope is a configuration to report the tier compliance of.
+type reportScope struct {
+	scope      string
+	paths      []string
+	lintroller *Lintroller
+}
+
+// ReportFromFile decodes the config file at the given path and reports how each of the
+// tiers it selects compare to its configuration. Unlike FromFile, a configuration that fails
+// to validate is not an error, the failure is recorded in the report instead.
+func ReportFromFile(path string) (*TierReport, error) {
+	cfg, err := decodeFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lr := &cfg.Lintroller
+	report := TierReport{
+		Compliant: true,
+	}
+
+	scopes := []reportScope{
+		{scope: ScopeDefault, lintroller: lr.clone()},
+	}
+	for i := range lr.PackageTiers {
+		pt := lr.clone()
+		pt.Tier = &lr.PackageTiers[i].Tier
+		pt.TierSchedule = nil
+		pt.PackageTiers = nil
+
+		scopes = append(scopes, reportScope{
+			scope:      fmt.Sprintf("packageTiers[%d]", i),
+			paths:      lr.PackageTiers[i].Paths,
+			lintroller: pt,
+		})
+	}
+
+	for _, s := range scopes {
+		tc := TierCompliance{
+			Scope: s.scope,
+			Paths: s.paths,
+			Mode:  s.lintroller.TierMode,
+		}
+		if tc.Mode == "" {
+			tc.Mode = TierModeAutofix
+		}
+		if s.lintroller.Tier != nil {
+			tc.Tier = strings.ToLower(*s.lintroller.Tier)
+		}
+
+		err := s.lintroller.ValidateTier()
+		if err == nil && tc.Tier != "" {
+			if _, ok := TierDefinition(tc.Tier); !ok {
+				err = fmt.Errorf("tier \"%s\" is not one of: %s", tc.Tier, strings.Join(TierNames(), ", "))
+			}
+		}
+
+		tc.Compliant = err == nil
+		if err != nil {
+			tc.Error = err.Error()
+			report.Compliant = false
+		}
+
+		// Initialize each list so they are written as empty lists rather than null.
+		tc.Met, tc.Raised, tc.Deviated, tc.Excepted = []Minimum{}, []Minimum{}, []Minimum{}, []Minimum{}
+		for _, m := range s.lintroller.Minimums() {
+			switch m.Status {
+			case MinimumMet:
+				tc.Met = append(tc.Met, m)
+			case MinimumRaised:
+				tc.Raised = append(tc.Raised, m)
+			case MinimumDeviated:
+				tc.Deviated = append(tc.Deviated, m)
+			case MinimumExcepted:
+				tc.Excepted = append(tc.Excepted, m)
+			}
+		}
+
+		report.Tiers = append(report.Tiers, tc)
+	}
+
+	return &report, nil
+}
+
+// Write writes the report to w in the given format, one of ReportFormatJSON or
+// ReportFormatText.
+func (r *TierReport) Write(w io.Writer, format string) error {
+	switch format {
+	case ReportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return errors.Wrap(encoder.Encode(r), "encode tier report")
+	case ReportFormatText:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+		fmt.Fprintln(tw, "SCOPE\tTIER\tMODE\tSTATUS\tFIELD\tREQUIRED\tCONFIGURED")
+		for i := range r.Tiers {
+			tc := &r.Tiers[i]
+			for _, minimums := range [][]Minimum{tc.Met, tc.Raised, tc.Deviated, tc.Excepted} {
+				for _, m := range minimums {
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%v\t%v\n", tc.Scope, tc.Tier, tc.Mode, m.Status, m.Field, m.Required, m.Configured)
+				}
+			}
+			if tc.Error != "" {
+				fmt.Fprintf(tw, "%s\t%s\t%s\terror\t%s\t\t\n", tc.Scope, tc.Tier, tc.Mode, tc.Error)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		// Exceptions are listed again below the table, along with who approved them and why,
+		// so that they stand out rather than blend in with the rest of the minimums.
+		for i := range r.Tiers {
+			for _, m := range r.Tiers[i].Excepted {
+				fmt.Fprintf(w, "EXCEPTION (%s): %s\n", r.Tiers[i].Scope, m.String())
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("format %q is not one of %q or %q", format, ReportFormatJSON, ReportFormatText)
+	}
+}
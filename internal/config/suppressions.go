@@ -0,0 +1,204 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the suppressions file, which suppresses issues in
+// files where a nolint directive is awkward, e.g. generated files without a generated
+// header or vendored snippets, and records the approval of new nolint directives.
+
+package config
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultSuppressionsFile is the suppressions file, relative to the config file, that is
+// loaded if it exists when suppressionsFile isn't set.
+const DefaultSuppressionsFile = "lintroller-suppressions.yaml"
+
+// SuppressionsFile is the format of a suppressions file.
+type SuppressionsFile struct {
+	// Suppressions contains every suppression in the file.
+	Suppressions []Suppression `yaml:"suppressions"`
+}
+
+// Suppression suppresses the issues of a linter, or of a single rule of it, in a set of
+// files like a nolint directive would. Every suppression has an owner and a reason so that
+// they can be revisited.
+type Suppression struct {
+	// File is the path glob, relative to the root of the module, of the files whose issues
+	// are suppressed.
+	File string `yaml:"file"`
+
+	// Line is the line whose issues are suppressed. Defaults to every line.
+	Line int `yaml:"line"`
+
+	// Rule is either the name of the linter whose issues are suppressed, e.g. "doculint",
+	// or the name of a linter followed by a slash and one of its rules, e.g.
+	// "doculint/spelling".
+	Rule string `yaml:"rule"`
+
+	// Owner is who is responsible for the suppression, e.g. a team.
+	Owner string `yaml:"owner"`
+
+	// Reason explains why the issues are suppressed.
+	Reason string `yaml:"reason"`
+
+	// ApprovedBy is who approved the suppression, e.g. the lead of the owning team. Entries
+	// added along with new nolint directives need to be approved, see ForbidNewNoLint.
+	ApprovedBy string `yaml:"approvedBy"`
+
+	// ApprovedOn is the date, in the form of 2006-01-02, the suppression was approved on.
+	ApprovedOn string `yaml:"approvedOn"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (s *Suppression) MarshalLog(addField func(key string, value interface{})) {
+	addField("file", s.File)
+	addField("line", s.Line)
+	addField("rule", s.Rule)
+	addField("owner", s.Owner)
+	addField("reason", s.Reason)
+	addField("approvedBy", s.ApprovedBy)
+	addField("approvedOn", s.ApprovedOn)
+}
+
+// IsApproved reports whether or not the suppression records who approved it and when.
+func (s *Suppression) IsApproved() bool {
+	return strings.TrimSpace(s.ApprovedBy) != "" && s.ApprovedOn != ""
+}
+
+// Validate ensures that every field of the receiver is well-formed and that it has an owner
+// and a reason.
+func (s *Suppression) Validate() error {
+	if strings.TrimSpace(s.File) == "" {
+		return errors.New("file must not be empty")
+	}
+
+	if err := common.ValidateGlob(s.File); err != nil {
+		return errors.Wrap(err, "validate file")
+	}
+
+	if s.Line < 0 {
+		return errors.New("line must not be negative")
+	}
+
+	linter, _, _ := strings.Cut(s.Rule, "/")
+	if err := validateLinter(linter); err != nil {
+		return fmt.Errorf("rule %v", err)
+	}
+
+	if strings.TrimSpace(s.Owner) == "" {
+		return errors.New("owner must not be empty")
+	}
+
+	if strings.TrimSpace(s.Reason) == "" {
+		return errors.New("reason must not be empty")
+	}
+
+	if s.ApprovedOn != "" {
+		if _, err := time.Parse(time.DateOnly, s.ApprovedOn); err != nil {
+			return fmt.Errorf("approvedOn \"%s\" is not a date of the form YYYY-MM-DD", s.ApprovedOn)
+		}
+	}
+
+	return nil
+}
+
+// LoadSuppressions decodes and validates the suppressions file at the given path. A file
+// that doesn't exist has no suppressions unless it is required.
+func LoadSuppressions(path string, required bool) ([]Suppression, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if !required && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "open suppressions file")
+	}
+	defer f.Close()
+
+	suppressions, err := DecodeSuppressions(f)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range suppressions {
+		if err := suppressions[i].Validate(); err != nil {
+			return nil, errors.Wrapf(err, "validate suppressions[%d] of \"%s\"", i, path)
+		}
+	}
+
+	return suppressions, nil
+}
+
+// DecodeSuppressions decodes the suppressions of the suppressions file read from r without
+// validating them, e.g. to compare them to a version of the file from an earlier revision.
+func DecodeSuppressions(r io.Reader) ([]Suppression, error) {
+	// An empty file has no suppressions.
+	var file SuppressionsFile
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "decode suppressions file")
+	}
+
+	return file.Suppressions, nil
+}
+
+// DecodeSuppressionLines returns the line each entry of the suppressions file read from r
+// starts on, in the order DecodeSuppressions returns them, e.g. to look up who added them.
+func DecodeSuppressionLines(r io.Reader) ([]int, error) {
+	var file struct {
+		Suppressions []yaml.Node `yaml:"suppressions"`
+	}
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "decode suppressions file")
+	}
+
+	lines := make([]int, len(file.Suppressions))
+	for i := range file.Suppressions {
+		lines[i] = file.Suppressions[i].Line
+	}
+
+	return lines, nil
+}
+
+// ForbidNewNoLint configures rejecting the nolint directives added since Base when
+// lintroller is ran with -forbid-new-nolint, unless an approved entry, see
+// Suppression.IsApproved, is added to the suppressions file along with each of them, so
+// that the number of suppressions doesn't grow silently.
+type ForbidNewNoLint struct {
+	// Linters are the names of the linters new nolint directives are rejected for.
+	// Defaults to every linter.
+	Linters []string `yaml:"linters"`
+}
+
+// MarshalLog implements the log.Marshaler interface.
+func (f *ForbidNewNoLint) MarshalLog(addField func(key string, value interface{})) {
+	addField("linters", f.Linters)
+}
+
+// Validate ensures that every linter of the receiver exists.
+func (f *ForbidNewNoLint) Validate() error {
+	for i, linter := range f.Linters {
+		if err := validateLinter(linter); err != nil {
+			return fmt.Errorf("linters[%d] %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// LintersOrDefault returns Linters, or every linter if it is empty.
+func (f *ForbidNewNoLint) LintersOrDefault() []string {
+	if len(f.Linters) == 0 {
+		return Linters
+	}
+
+	return f.Linters
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadSuppressions(t *testing.T) {
+	tt := []struct {
+		name          string
+		content       string
+		expected      []Suppression
+		expectedError string
+	}{
+		{
+			name: "Loads suppressions",
+			content: `suppressions:
+  - file: internal/gen/**
+    rule: doculint/spelling
+    owner: "@platform"
+    reason: Generated from a vendor API spec.
+`,
+			expected: []Suppression{{
+				File:   "internal/gen/**",
+				Rule:   "doculint/spelling",
+				Owner:  "@platform",
+				Reason: "Generated from a vendor API spec.",
+			}},
+		},
+		{
+			name: "Loads approvals",
+			content: `suppressions:
+  - file: internal/api/client.go
+    line: 12
+    rule: magicnumber
+    owner: "@platform"
+    reason: The retry budget of the vendor API.
+    approvedBy: "@platform-lead"
+    approvedOn: 2026-03-02
+`,
+			expected: []Suppression{{
+				File:       "internal/api/client.go",
+				Line:       12,
+				Rule:       "magicnumber",
+				Owner:      "@platform",
+				Reason:     "The retry budget of the vendor API.",
+				ApprovedBy: "@platform-lead",
+				ApprovedOn: "2026-03-02",
+			}},
+		},
+		{
+			name: "Rejects malformed approval dates",
+			content: `suppressions:
+  - file: internal/gen/**
+    rule: doculint
+    owner: "@platform"
+    reason: Generated.
+    approvedBy: "@platform-lead"
+    approvedOn: March 2nd
+`,
+			expectedError: "approvedOn \"March 2nd\" is not a date",
+		},
+		{
+			name:     "Loads empty files",
+			content:  "",
+			expected: nil,
+		},
+		{
+			name: "Rejects suppressions without a reason",
+			content: `suppressions:
+  - file: internal/gen/**
+    rule: doculint
+    owner: "@platform"
+`,
+			expectedError: "validate suppressions[0]",
+		},
+		{
+			name: "Rejects unknown linters",
+			content: `suppressions:
+  - file: internal/gen/**
+    rule: gofmt
+    owner: "@platform"
+    reason: Generated.
+`,
+			expectedError: "rule \"gofmt\" is not one of",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), DefaultSuppressionsFile)
+			assert.NilError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			suppressions, err := LoadSuppressions(path, true)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.DeepEqual(t, suppressions, test.expected)
+		})
+	}
+}
+
+func TestLoadSuppressionsMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultSuppressionsFile)
+
+	suppressions, err := LoadSuppressions(path, false)
+	assert.NilError(t, err)
+	assert.Equal(t, len(suppressions), 0)
+
+	_, err = LoadSuppressions(path, true)
+	assert.ErrorContains(t, err, "open suppressions file")
+}
+
+func TestDecodeSuppressionLines(t *testing.T) {
+	lines, err := DecodeSuppressionLines(strings.NewReader(`# Approved suppressions.
+suppressions:
+  - file: internal/gen/**
+    rule: doculint/spelling
+
+  - file: cmd/main.go
+    line: 12
+    rule: magicnumber
+`))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines, []int{3, 6})
+
+	lines, err = DecodeSuppressionLines(strings.NewReader(""))
+	assert.NilError(t, err)
+	assert.Equal(t, len(lines), 0)
+}
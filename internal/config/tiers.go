@@ -10,90 +10,523 @@ package config
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"regexp/syntax"
 	"strings"
+	"time"
 
 	"github.com/getoutreach/gobox/pkg/log"
+	"github.com/getoutreach/lintroller/internal/common"
 	"github.com/pkg/errors"
 )
 
-// Configuration tier variables that correspond to ops-level tiers. The reason these
-// are variables as opposed to constants is because the address of them are needed
-// when defining their corresponding Tier*Configuration types.
+// Configuration tier variables that correspond to the built-in ops-level tiers, whose
+// minimum configurations are defined in the tiers directory. The reason these are
+// variables as opposed to constants is so their address can be used for Lintroller.Tier.
 var (
-	// TierBronze is the tier name that corresponds to the TierBronzeConfiguration
-	// configuration minimums.
+	// TierBronze is the tier name that corresponds to the configuration minimums
+	// defined in tiers/bronze.yaml.
 	TierBronze = "bronze"
 
-	// TierSilver is the tier name that corresponds to the TierSilverConfiguration
-	// configuration minimums.
+	// TierSilver is the tier name that corresponds to the configuration minimums
+	// defined in tiers/silver.yaml.
 	TierSilver = "silver"
 
-	// TierGold is the tier name that corresponds to the TierGoldConfiguration
-	// configuration minimums.
+	// TierGold is the tier name that corresponds to the configuration minimums
+	// defined in tiers/gold.yaml.
 	TierGold = "gold"
 
-	// TierPlatinum is the tier name that corresponds to the TierPlatinumConfiguration
-	// configuration minimums.
+	// TierPlatinum is the tier name that corresponds to the configuration minimums
+	// defined in tiers/platinum.yaml.
 	TierPlatinum = "platinum"
+
+	// TierGoldV2 is the tier name that corresponds to the configuration minimums defined
+	// in tiers/gold-v2.yaml, which raise those of TierGold. Existing tiers are never made
+	// stricter, stricter minimums are introduced as a new version of the tier instead that
+	// repositories opt into.
+	TierGoldV2 = "gold-v2"
+
+	// TierPlatinumV2 is the tier name that corresponds to the configuration minimums
+	// defined in tiers/platinum-v2.yaml, which raise those of TierPlatinum.
+	TierPlatinumV2 = "platinum-v2"
 )
 
+// Tier modes that denote how a configuration below the minimums of its tier is handled.
+const (
+	// TierModeAutofix raises the configuration to the minimums of its tier, recording each
+	// field that was raised. This is the default.
+	TierModeAutofix = "autofix"
+
+	// TierModeStrict fails validation of a configuration below the minimums of its tier.
+	TierModeStrict = "strict"
+)
+
+// Statuses of a Minimum, denoting how a configuration compared to a minimum of its tier.
+const (
+	// MinimumMet denotes that the configuration already met the minimum.
+	MinimumMet = "met"
+
+	// MinimumRaised denotes that the configuration was below the minimum and was raised to
+	// meet it.
+	MinimumRaised = "raised"
+
+	// MinimumDeviated denotes that the configuration deviates from the minimum in a way that
+	// can't be raised automatically.
+	MinimumDeviated = "deviated"
+
+	// MinimumExcepted denotes that the configuration is below the minimum, but an entry of
+	// Lintroller.TierExceptions accepts it as it is.
+	MinimumExcepted = "excepted"
+)
+
+// TierEscalationNotice is how long before an entry of Lintroller.TierSchedule starts that
+// it is considered upcoming, see Lintroller.UpcomingTierEscalation.
+const TierEscalationNotice = 30 * 24 * time.Hour
+
+// now returns the current time, which selects the entry of Lintroller.TierSchedule that
+// applies. It is a variable so that tests can set the date.
+var now = time.Now
+
+// Minimum describes how a single field of a configuration compared to the minimum required
+// for it by a tier.
+type Minimum struct {
+	// Tier is the name of the tier that requires the minimum.
+	Tier string `json:"tier"`
+
+	// Field is the path of the field, e.g. "lintroller.todo.enabled".
+	Field string `json:"field"`
+
+	// Required is the minimum value required for the field by the tier.
+	Required interface{} `json:"required"`
+
+	// Configured is the value of the field as it was configured.
+	Configured interface{} `json:"configured"`
+
+	// Status is one of MinimumMet, MinimumRaised, MinimumDeviated, or MinimumExcepted.
+	Status string `json:"status"`
+
+	// Exception is the exception that accepts the field as it was configured, if Status is
+	// MinimumExcepted.
+	Exception *TierException `json:"exception,omitempty"`
+}
+
+// String returns a human readable description of the minimum.
+func (m *Minimum) String() string {
+	switch m.Status {
+	case MinimumRaised:
+		return fmt.Sprintf("%s raised from %v to %v to meet the minimums of the %s tier", m.Field, m.Configured, m.Required, m.Tier)
+	case MinimumDeviated:
+		return fmt.Sprintf("%s is %v which deviates from the minimum of %v required by the %s tier",
+			m.Field, m.Configured, m.Required, m.Tier)
+	case MinimumExcepted:
+		return fmt.Sprintf("%s is %v which is below the minimum of %v required by the %s tier, excepted %s",
+			m.Field, m.Configured, m.Required, m.Tier, m.Exception.String())
+	default:
+		return fmt.Sprintf("%s meets the minimum of %v required by the %s tier", m.Field, m.Required, m.Tier)
+	}
+}
+
+// Minimums returns how each field of the receiver compared to the minimums of its tier, as
+// recorded by ValidateTier.
+func (l *Lintroller) Minimums() []Minimum {
+	return l.minimums
+}
+
+// Overrides returns every field of the receiver that was raised to meet the minimums of its
+// tier by ValidateTier.
+func (l *Lintroller) Overrides() []Minimum {
+	var overrides []Minimum
+	for i := range l.minimums {
+		if l.minimums[i].Status == MinimumRaised {
+			overrides = append(overrides, l.minimums[i])
+		}
+	}
+
+	return overrides
+}
+
+// Excepted returns every field of the receiver that is below the minimums of its tier but
+// was accepted as it is by an entry of TierExceptions, as recorded by ValidateTier.
+func (l *Lintroller) Excepted() []Minimum {
+	var excepted []Minimum
+	for i := range l.minimums {
+		if l.minimums[i].Status == MinimumExcepted {
+			excepted = append(excepted, l.minimums[i])
+		}
+	}
+
+	return excepted
+}
+
+// ExpiredTierExceptions returns the entries of TierExceptions that have expired, which no
+// longer accept the fields they name.
+func (l *Lintroller) ExpiredTierExceptions() []TierException {
+	var expired []TierException
+	for i := range l.TierExceptions {
+		if l.TierExceptions[i].expired(now()) {
+			expired = append(expired, l.TierExceptions[i])
+		}
+	}
+
+	return expired
+}
+
+// UpcomingTierEscalation returns the entry of TierSchedule that starts within
+// TierEscalationNotice, as recorded by ValidateTier, or nil if there isn't one.
+func (l *Lintroller) UpcomingTierEscalation() *TierEscalation {
+	return l.upcoming
+}
+
 // ValidateTier ensures that if a tier was provided, the rest of the configuration
 // meets minimum requirements. If a field was left unset, it will be automatically
-// set to the minimum requirement.
+// set to the minimum requirement. The tier is first replaced by the tier of the latest
+// entry of TierSchedule that has started, if any.
 func (l *Lintroller) ValidateTier() error {
+	switch l.TierMode {
+	case "", TierModeAutofix, TierModeStrict:
+	default:
+		return fmt.Errorf("tierMode %q is not one of %q or %q", l.TierMode, TierModeAutofix, TierModeStrict)
+	}
+
+	if err := l.applyTierSchedule(now()); err != nil {
+		return errors.Wrap(err, "apply tierSchedule")
+	}
+
+	if err := l.validateTierExceptions(); err != nil {
+		return errors.Wrap(err, "validate exceptions")
+	}
+
 	if l.Tier == nil {
 		// No tier selected, nothing to validate.
 		return nil
 	}
 
-	switch strings.ToLower(*l.Tier) {
-	case TierBronze:
-		if err := l.EnsureMinimums(&TierBronzeConfiguration); err != nil {
-			return errors.Wrap(err, "ensure given configuration meets minimum requirments for bronze tier")
+	desired, ok := TierDefinition(*l.Tier)
+	if !ok {
+		names := TierNames()
+		for i := range names {
+			names[i] = fmt.Sprintf("%q", names[i])
 		}
-	case TierSilver:
-		if err := l.EnsureMinimums(&TierSilverConfiguration); err != nil {
-			return errors.Wrap(err, "ensure given configuration meets minimum requirments for silver tier")
-		}
-	case TierGold:
-		if err := l.EnsureMinimums(&TierGoldConfiguration); err != nil {
-			return errors.Wrap(err, "ensure given configuration meets minimum requirments for gold tier")
-		}
-	case TierPlatinum:
-		if err := l.EnsureMinimums(&TierPlatinumConfiguration); err != nil {
-			return errors.Wrap(err, "ensure given configuration meets minimum requirments for platinum tier")
-		}
-	default:
+
 		log.Warn(context.Background(),
-			fmt.Sprintf("provided does not match any of the following: %q, %q, %q, %q (sans-quotes)",
-				TierBronze, TierSilver, TierGold, TierPlatinum),
+			fmt.Sprintf("provided does not match any of the following: %s (sans-quotes)", strings.Join(names, ", ")),
 			log.F{
 				"tier": *l.Tier,
 			})
+		return nil
+	}
+
+	if err := l.EnsureMinimums(desired); err != nil {
+		return errors.Wrapf(err, "ensure given configuration meets minimum requirments for %s tier", strings.ToLower(*l.Tier))
+	}
+
+	if overrides := l.Overrides(); l.TierMode == TierModeStrict && len(overrides) > 0 {
+		fields := make([]string, len(overrides))
+		for i := range overrides {
+			fields[i] = fmt.Sprintf("%s must be %v", overrides[i].Field, overrides[i].Required)
+		}
+
+		return fmt.Errorf("configuration is below the minimums of the %s tier and tierMode is %q: %s",
+			strings.ToLower(*l.Tier), TierModeStrict, strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+// ValidateMinTier ensures that the receiver declares a tier, both at the top-level and for
+// each of its package tiers, whose minimums are at least those of the given tier, see
+// TierAtLeast. This needs to be called after ValidateTier so that the tier of TierSchedule
+// that applies has replaced Tier.
+func (l *Lintroller) ValidateMinTier(minimum string) error {
+	if _, ok := TierDefinition(minimum); !ok {
+		return fmt.Errorf("tier \"%s\" is not one of: %s", minimum, strings.Join(TierNames(), ", "))
+	}
+	minimum = strings.ToLower(minimum)
+
+	if l.Tier == nil {
+		return fmt.Errorf("no tier is declared, but at least the %s tier is required", minimum)
+	}
+
+	if ok, err := TierAtLeast(*l.Tier, minimum); err != nil {
+		return errors.Wrap(err, "compare tier")
+	} else if !ok {
+		return fmt.Errorf("tier %s is declared, but at least the %s tier is required", strings.ToLower(*l.Tier), minimum)
+	}
+
+	for i := range l.PackageTiers {
+		if ok, err := TierAtLeast(l.PackageTiers[i].Tier, minimum); err != nil {
+			return errors.Wrapf(err, "compare packageTiers[%d].tier", i)
+		} else if !ok {
+			return fmt.Errorf("packageTiers[%d].tier %s is declared, but at least the %s tier is required",
+				i, strings.ToLower(l.PackageTiers[i].Tier), minimum)
+		}
+	}
+
+	return nil
+}
+
+// TierAtLeast reports whether or not the minimums of the given tier are at least those of
+// the given minimum tier, which is to say that every configuration meeting the former meets
+// the latter too. Tiers are compared by their definitions rather than by name, so that tiers
+// added by tierDefinitions compare to the built-in ones, e.g. platinum isn't at least
+// gold-v2, which requires a header field platinum doesn't.
+func TierAtLeast(tier, minimum string) (bool, error) {
+	definition, ok := TierDefinition(tier)
+	if !ok {
+		return false, fmt.Errorf("tier \"%s\" is not one of: %s", tier, strings.Join(TierNames(), ", "))
+	}
+
+	minimumDefinition, ok := TierDefinition(minimum)
+	if !ok {
+		return false, fmt.Errorf("tier \"%s\" is not one of: %s", minimum, strings.Join(TierNames(), ", "))
+	}
+
+	// A tier that deviates from the minimums of the minimum tier, or had to be raised to
+	// meet them, is lower than the minimum tier.
+	lr := definition.clone()
+	deviation := lr.EnsureMinimums(minimumDefinition)
+
+	return deviation == nil && len(lr.Overrides()) == 0, nil
+}
+
+// applyTierSchedule replaces Tier with the tier of the latest entry of TierSchedule that
+// has started by the given time, and records the entry after it if it starts within
+// TierEscalationNotice of the given time.
+func (l *Lintroller) applyTierSchedule(t time.Time) error {
+	var previous time.Time
+	for i := range l.TierSchedule {
+		te := &l.TierSchedule[i]
+
+		if _, ok := TierDefinition(te.Tier); !ok {
+			return fmt.Errorf("[%d].tier \"%s\" is not one of: %s", i, te.Tier, strings.Join(TierNames(), ", "))
+		}
+
+		from, err := time.Parse(time.DateOnly, te.From)
+		if err != nil {
+			return fmt.Errorf("[%d].from \"%s\" is not a date of the form YYYY-MM-DD", i, te.From)
+		}
+
+		if i > 0 && !from.After(previous) {
+			return fmt.Errorf("[%d].from %s must be after the date of the entry before it", i, te.From)
+		}
+		previous = from
+
+		switch {
+		case !t.Before(from):
+			tier := te.Tier
+			l.Tier = &tier
+		case l.upcoming == nil && from.Sub(t) <= TierEscalationNotice:
+			l.upcoming = te
+		}
+	}
+
+	return nil
+}
+
+// validateTierExceptions ensures that each of the entries of TierExceptions names a field
+// and records who approved it and why, along with a well-formed expiry date if it has one.
+func (l *Lintroller) validateTierExceptions() error {
+	for i := range l.TierExceptions {
+		te := &l.TierExceptions[i]
+
+		switch {
+		case strings.TrimSpace(te.Rule) == "":
+			return fmt.Errorf("[%d].rule must not be empty", i)
+		case strings.TrimSpace(te.Reason) == "":
+			return fmt.Errorf("[%d].reason must not be empty", i)
+		case strings.TrimSpace(te.ApprovedBy) == "":
+			return fmt.Errorf("[%d].approvedBy must not be empty", i)
+		}
+
+		if te.Expires != "" {
+			if _, err := time.Parse(time.DateOnly, te.Expires); err != nil {
+				return fmt.Errorf("[%d].expires \"%s\" is not a date of the form YYYY-MM-DD", i, te.Expires)
+			}
+		}
+	}
+
+	return nil
+}
+
+// tierException returns the entry of TierExceptions that accepts the field at the given
+// path, e.g. "lintroller.doculint.validateFunctions", or nil if none that hasn't expired
+// does.
+func (l *Lintroller) tierException(fieldPath string) *TierException {
+	rule := strings.TrimPrefix(fieldPath, "lintroller.")
+	for i := range l.TierExceptions {
+		te := &l.TierExceptions[i]
+		if strings.TrimPrefix(strings.TrimSpace(te.Rule), "lintroller.") == rule && !te.expired(now()) {
+			return te
+		}
 	}
 
 	return nil
 }
 
+// expired reports whether or not the exception has expired by the given time. Exceptions
+// without an expiry date, or with a malformed one, never expire.
+func (te *TierException) expired(t time.Time) bool {
+	if te.Expires == "" {
+		return false
+	}
+
+	expires, err := time.Parse(time.DateOnly, te.Expires)
+	return err == nil && !t.Before(expires)
+}
+
+// String returns a human readable description of the exception.
+func (te *TierException) String() string {
+	s := fmt.Sprintf("by %s", te.ApprovedBy)
+	if te.Expires != "" {
+		s += fmt.Sprintf(" until %s", te.Expires)
+	}
+
+	return fmt.Sprintf("%s: %s", s, te.Reason)
+}
+
+// ValidatePackageTiers ensures that each of the package tiers is well-formed and derives
+// the configuration that applies to the packages matching it, which is the receiver raised
+// to the minimums of the package tier's tier. This needs to be called before ValidateTier
+// so the receiver hasn't been raised to the minimums of its own tier yet.
+func (l *Lintroller) ValidatePackageTiers() error {
+	for i := range l.PackageTiers {
+		pt := &l.PackageTiers[i]
+
+		if len(pt.Paths) == 0 {
+			return fmt.Errorf("lintroller.packageTiers[%d].paths must contain at least one path", i)
+		}
+
+		for _, glob := range pt.Paths {
+			if err := common.ValidateGlob(glob); err != nil {
+				return errors.Wrapf(err, "validate lintroller.packageTiers[%d].paths", i)
+			}
+		}
+
+		tier := pt.Tier
+
+		lr := l.clone()
+		lr.Tier = &tier
+		lr.TierSchedule = nil
+		lr.PackageTiers = nil
+
+		if err := lr.ValidateTier(); err != nil {
+			return errors.Wrapf(err, "validate lintroller.packageTiers[%d].tier", i)
+		}
+
+		pt.lintroller = lr
+	}
+
+	return nil
+}
+
+// Matches reports whether the package in the given directory, relative to the root of the
+// module, matches any of the paths of the receiver.
+func (pt *PackageTier) Matches(dir string) bool {
+	for _, glob := range pt.Paths {
+		if common.MatchGlob(glob, dir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Config returns the configuration that applies to the packages matching the receiver. This
+// is nil until Lintroller.ValidatePackageTiers has been ran.
+func (pt *PackageTier) Config() *Lintroller {
+	return pt.lintroller
+}
+
+// clone returns a deep copy of the receiver.
+func (l *Lintroller) clone() *Lintroller {
+	lr := *l
+
+	if l.Header.Fields != nil {
+		lr.Header.Fields = append([]string(nil), l.Header.Fields...)
+	}
+
+	if l.Header.PathFields != nil {
+		lr.Header.PathFields = append([]HeaderPathFields(nil), l.Header.PathFields...)
+	}
+
+	if l.PackageTiers != nil {
+		lr.PackageTiers = append([]PackageTier(nil), l.PackageTiers...)
+	}
+
+	lr.minimums = nil
+	lr.upcoming = nil
+
+	return &lr
+}
+
 // EnsureMinimums takes a desired Lintroller variable and diffs it against the receiver. It
 // will automatically override booleans set to false, needing to be set to true, as well as
-// any zero-valued struct field.
+// any zero-valued struct field. Header fields required by the desired tier that are missing
+// from the receiver are merged into it.
+//
+// Fields below the minimums that an entry of TierExceptions accepts are left as they are
+// and recorded as MinimumExcepted instead.
 //
 // This function will allow the receiver to be more restrictive (enable linters when the
 // desired has them disabled, set the minimum function length to a lower value, add more
 // required header fields, etc.), but not allow it to be less restrictive.
+//
+// How each field compared to its minimum is recorded and can be retrieved using Minimums.
+// Every deviation is recorded before the first one is returned as an error.
 func (l *Lintroller) EnsureMinimums(desired *Lintroller) error { //nolint:funlen // Why: Splitting this function out would add no value.
+	var tier string
+	if desired.Tier != nil {
+		tier = strings.ToLower(*desired.Tier)
+	}
+
+	var deviation error
+	record := func(fieldPath string, required, configured interface{}, status string) {
+		l.minimums = append(l.minimums, Minimum{
+			Tier:       tier,
+			Field:      fieldPath,
+			Required:   required,
+			Configured: configured,
+			Status:     status,
+		})
+	}
+
+	// except records the field at the given path as excepted and reports true if an entry of
+	// TierExceptions accepts it below the minimum, rather than it being raised or deviating.
+	except := func(fieldPath string, required, configured interface{}) bool {
+		te := l.tierException(fieldPath)
+		if te == nil {
+			return false
+		}
+
+		l.minimums = append(l.minimums, Minimum{
+			Tier:       tier,
+			Field:      fieldPath,
+			Required:   required,
+			Configured: configured,
+			Status:     MinimumExcepted,
+			Exception:  te,
+		})
+		return true
+	}
+
 	overrideBool := func(necessary, current bool, fieldPath string) bool {
 		if necessary {
 			if !current {
+				if except(fieldPath, true, current) {
+					return current
+				}
+
 				// If the necessary is true, but the current is false, then override it and log this action.
 				log.Warn(context.Background(),
 					"boolean value required to be true to meet tier minimum stanards is set to false - overriding to true",
 					log.F{
 						"field": fieldPath,
 					})
+				record(fieldPath, true, current, MinimumRaised)
 				return true
 			}
+
+			record(fieldPath, true, current, MinimumMet)
 		}
 
 		return current
@@ -102,42 +535,53 @@ func (l *Lintroller) EnsureMinimums(desired *Lintroller) error { //nolint:funlen
 	// Ensure header linter minimum configuration against desired.
 	l.Header.Enabled = overrideBool(desired.Header.Enabled, l.Header.Enabled, "lintroller.header.enabled")
 	if l.Header.Enabled {
-		if l.Header.Fields == nil {
-			l.Header.Fields = desired.Header.Fields
-		} else {
-			for i := range desired.Header.Fields {
-				var found bool
-				for j := range l.Header.Fields {
-					if desired.Header.Fields[i] == l.Header.Fields[j] {
-						found = true
-						break
-					}
+		// Higher tiers require more header fields than lower ones, so rather than requiring
+		// the configuration to already contain every field of the tier, the fields missing
+		// from it are merged in.
+		configured := append([]string(nil), l.Header.Fields...)
+		for i := range desired.Header.Fields {
+			var found bool
+			for j := range configured {
+				if desired.Header.Fields[i] == configured[j] {
+					found = true
+					break
 				}
+			}
 
-				if !found {
-					return fmt.Errorf(
-						"deviation detected from tier minimum defaults in lintroller.header.fields, fields must contain \"%s\"",
-						desired.Header.Fields[i])
-				}
+			if found {
+				record("lintroller.header.fields", desired.Header.Fields[i], configured, MinimumMet)
+				continue
 			}
+
+			if except("lintroller.header.fields", desired.Header.Fields[i], configured) {
+				continue
+			}
+
+			log.Warn(context.Background(), "required header field missing from configuration, adding it to meet tier minimum standards", log.F{
+				"field": "lintroller.header.fields",
+				"value": desired.Header.Fields[i],
+			})
+			record("lintroller.header.fields", desired.Header.Fields[i], configured, MinimumRaised)
+			l.Header.Fields = append(l.Header.Fields, desired.Header.Fields[i])
 		}
 	}
 
 	// Ensure copyright linter minimum configuration against desired.
 	l.Copyright.Enabled = overrideBool(desired.Copyright.Enabled, l.Copyright.Enabled, "lintroller.copyright.enabled")
-	if l.Copyright.Pattern == "" {
-		log.Warn(context.Background(), "zero value detected for field, overriding to value found in desired tier minimum version", log.F{
-			"field": "lintroller.copyright.pattern",
-			"value": desired.Copyright.Pattern,
-		})
-
-		l.Copyright.Pattern = desired.Copyright.Pattern
-	} else if l.Copyright.Pattern != desired.Copyright.Pattern {
+	switch {
+	case desired.Copyright.Pattern == "":
+		// The tier doesn't require a pattern, whatever is configured is at least as strict.
+	case l.Copyright.Pattern == desired.Copyright.Pattern,
+		copyrightAtLeastAsStrict(l.Copyright.Pattern, l.Copyright.Text, desired.Copyright.Pattern):
+		record("lintroller.copyright.pattern", desired.Copyright.Pattern, l.Copyright.Pattern, MinimumMet)
+	case except("lintroller.copyright.pattern", desired.Copyright.Pattern, l.Copyright.Pattern):
+	default:
 		log.Warn(context.Background(), "deviation detected for field, overriding to value found in desired tier minimum version", log.F{
 			"field": "lintroller.copyright.pattern",
 			"value": desired.Copyright.Pattern,
 		})
 
+		record("lintroller.copyright.pattern", desired.Copyright.Pattern, l.Copyright.Pattern, MinimumRaised)
 		l.Copyright.Pattern = desired.Copyright.Pattern
 	}
 
@@ -155,17 +599,26 @@ func (l *Lintroller) EnsureMinimums(desired *Lintroller) error { //nolint:funlen
 		l.Doculint.ValidateTypes = overrideBool(
 			desired.Doculint.ValidateTypes, l.Doculint.ValidateTypes, "lintroller.doculint.validateTypes")
 
-		if l.Doculint.ValidateFunctions {
-			if l.Doculint.MinFunLen == 0 {
+		// A minimum function length is only required when the tier sets one.
+		if l.Doculint.ValidateFunctions && desired.Doculint.MinFunLen > 0 {
+			switch {
+			case l.Doculint.MinFunLen > 0 && l.Doculint.MinFunLen <= desired.Doculint.MinFunLen:
+				record("lintroller.doculint.minFunLen", desired.Doculint.MinFunLen, l.Doculint.MinFunLen, MinimumMet)
+			case except("lintroller.doculint.minFunLen", desired.Doculint.MinFunLen, l.Doculint.MinFunLen):
+			case l.Doculint.MinFunLen == 0:
 				log.Warn(context.Background(), "zero value detected for field, overriding to value found in desired tier minimum version", log.F{
 					"field": "lintroller.doculint.minFunLen",
 					"value": desired.Doculint.MinFunLen,
 				})
+				record("lintroller.doculint.minFunLen", desired.Doculint.MinFunLen, l.Doculint.MinFunLen, MinimumRaised)
 				l.Doculint.MinFunLen = desired.Doculint.MinFunLen
-			} else if l.Doculint.MinFunLen > desired.Doculint.MinFunLen || l.Doculint.MinFunLen < 0 {
-				return fmt.Errorf(
-					"deviation detected from tier minimum defaults in lintroller.doculint.minFunLen, minFunLen must be set within (0, %d]",
-					desired.Doculint.MinFunLen)
+			default:
+				record("lintroller.doculint.minFunLen", desired.Doculint.MinFunLen, l.Doculint.MinFunLen, MinimumDeviated)
+				if deviation == nil {
+					deviation = fmt.Errorf(
+						"deviation detected from tier minimum defaults in lintroller.doculint.minFunLen, minFunLen must be set within (0, %d]",
+						desired.Doculint.MinFunLen)
+				}
 			}
 		}
 	}
@@ -174,122 +627,56 @@ func (l *Lintroller) EnsureMinimums(desired *Lintroller) error { //nolint:funlen
 	l.Todo.Enabled = overrideBool(desired.Todo.Enabled, l.Todo.Enabled, "lintroller.todo.enabled")
 
 	// Ensure why linter minimum configuration against desired.
-	l.Why.Enabled = overrideBool(desired.Todo.Enabled, l.Todo.Enabled, "lintroller.why.enabled")
+	l.Why.Enabled = overrideBool(desired.Why.Enabled, l.Why.Enabled, "lintroller.why.enabled")
 
-	return nil
-}
+	// Ensure magicnumber linter minimum configuration against desired.
+	l.MagicNumber.Enabled = overrideBool(desired.MagicNumber.Enabled, l.MagicNumber.Enabled, "lintroller.magicNumber.enabled")
 
-// TierBronzeConfiguration is the Lintroller configuration minumums that correspond
-// to the Bronze OpsLevel tier.
-var TierBronzeConfiguration = Lintroller{
-	Tier: &TierBronze,
-	Header: Header{
-		Enabled: false,
-		Fields:  nil,
-	},
-	Copyright: Copyright{
-		Enabled: false,
-	},
-	Doculint: Doculint{
-		Enabled:           false,
-		MinFunLen:         0,
-		ValidatePackages:  false,
-		ValidateFunctions: false,
-		ValidateVariables: false,
-		ValidateConstants: false,
-		ValidateTypes:     false,
-	},
-	Todo: Todo{
-		Enabled: false,
-	},
-	Why: Why{
-		Enabled: false,
-	},
+	return deviation
 }
 
-// TierSilverConfiguration is the Lintroller configuration minumums that correspond
-// to the Silver OpsLevel tier.
-var TierSilverConfiguration = Lintroller{
-	Tier: &TierSilver,
-	Header: Header{
-		Enabled: true,
-		Fields:  []string{"Description"},
-	},
-	Copyright: Copyright{
-		Enabled: true,
-		Pattern: `^Copyright 20.*$`,
-	},
-	Doculint: Doculint{
-		Enabled:           true,
-		MinFunLen:         0,
-		ValidatePackages:  true,
-		ValidateFunctions: false,
-		ValidateVariables: false,
-		ValidateConstants: false,
-		ValidateTypes:     false,
-	},
-	Todo: Todo{
-		Enabled: true,
-	},
-	Why: Why{
-		Enabled: true,
-	},
-}
+// copyrightAtLeastAsStrict reports whether the configured copyright pattern, or the
+// configured copyright text when no pattern is configured, only accepts copyrights that the
+// required pattern accepts too. This can only be told for required patterns made up of a
+// literal prefix followed by anything, like the patterns of the built-in tiers, e.g.
+// "^Copyright 20.*$": a configured text must match the required pattern, and a configured
+// pattern must be anchored to the start of the copyright with a literal prefix that starts
+// with the required one.
+func copyrightAtLeastAsStrict(pattern, text, required string) bool {
+	if pattern == "" {
+		if text == "" {
+			return false
+		}
 
-// TierGoldConfiguration is the Lintroller configuration minumums that correspond
-// to the Gold OpsLevel tier.
-var TierGoldConfiguration = Lintroller{
-	Tier: &TierSilver,
-	Header: Header{
-		Enabled: true,
-		Fields:  []string{"Description"},
-	},
-	Copyright: Copyright{
-		Enabled: true,
-		Pattern: `^Copyright 20.*$`,
-	},
-	Doculint: Doculint{
-		Enabled:           true,
-		MinFunLen:         0,
-		ValidatePackages:  true,
-		ValidateFunctions: false,
-		ValidateVariables: true,
-		ValidateConstants: true,
-		ValidateTypes:     true,
-	},
-	Todo: Todo{
-		Enabled: true,
-	},
-	Why: Why{
-		Enabled: true,
-	},
-}
+		re, err := regexp.Compile(required)
+		return err == nil && re.MatchString(text)
+	}
+
+	requiredPrefix, ok := strings.CutPrefix(required, "^")
+	if !ok {
+		return false
+	}
+	requiredPrefix, ok = strings.CutSuffix(requiredPrefix, ".*$")
+	if !ok {
+		if requiredPrefix, ok = strings.CutSuffix(requiredPrefix, ".*"); !ok {
+			return false
+		}
+	}
+
+	literal, err := syntax.Parse(requiredPrefix, syntax.Perl)
+	if err != nil || literal.Op != syntax.OpLiteral || literal.Flags&syntax.FoldCase != 0 {
+		return false
+	}
+
+	if !strings.HasPrefix(pattern, "^") {
+		return false
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false
+	}
 
-// TierPlatinumConfiguration is the Lintroller configuration minumums that correspond
-// to the Platinum OpsLevel tier.
-var TierPlatinumConfiguration = Lintroller{
-	Tier: &TierSilver,
-	Header: Header{
-		Enabled: true,
-		Fields:  []string{"Description"},
-	},
-	Copyright: Copyright{
-		Enabled: true,
-		Pattern: `^Copyright 20.*$`,
-	},
-	Doculint: Doculint{
-		Enabled:           true,
-		MinFunLen:         10,
-		ValidatePackages:  true,
-		ValidateFunctions: true,
-		ValidateVariables: true,
-		ValidateConstants: true,
-		ValidateTypes:     true,
-	},
-	Todo: Todo{
-		Enabled: true,
-	},
-	Why: Why{
-		Enabled: true,
-	},
+	prefix, _ := re.LiteralPrefix()
+	return strings.HasPrefix(prefix, string(literal.Rune))
 }
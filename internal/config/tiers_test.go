@@ -0,0 +1,731 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestValidatePackageTiers(t *testing.T) {
+	lr := Lintroller{
+		Tier: &TierPlatinum,
+		PackageTiers: []PackageTier{
+			{
+				Paths: []string{"internal/legacy/**"},
+				Tier:  TierBronze,
+			},
+			{
+				Paths: []string{"pkg/public/**"},
+				Tier:  TierSilver,
+			},
+		},
+	}
+
+	assert.NilError(t, lr.ValidatePackageTiers())
+	assert.NilError(t, lr.ValidateTier())
+
+	// The package tiers are derived from the configuration as given, not the configuration
+	// raised to the minimums of the top-level tier.
+	legacy := lr.PackageTiers[0].Config()
+	assert.Equal(t, *legacy.Tier, TierBronze)
+	assert.Equal(t, legacy.Doculint.Enabled, false)
+
+	public := lr.PackageTiers[1].Config()
+	assert.Equal(t, *public.Tier, TierSilver)
+	assert.Equal(t, public.Doculint.Enabled, true)
+	assert.Equal(t, public.Doculint.ValidateFunctions, false)
+
+	assert.Equal(t, lr.Doculint.ValidateFunctions, true)
+
+	assert.Equal(t, lr.PackageTiers[0].Matches("internal/legacy/foo"), true)
+	assert.Equal(t, lr.PackageTiers[0].Matches("internal/foo"), false)
+}
+
+func TestValidatePackageTiersRequiresPaths(t *testing.T) {
+	lr := Lintroller{
+		PackageTiers: []PackageTier{
+			{
+				Tier: TierBronze,
+			},
+		},
+	}
+
+	assert.ErrorContains(t, lr.ValidatePackageTiers(), "must contain at least one path")
+}
+
+func TestBuiltinTierDefinitions(t *testing.T) {
+	assert.DeepEqual(t, TierNames(), []string{TierBronze, TierGold, TierGoldV2, TierPlatinum, TierPlatinumV2, TierSilver})
+
+	platinum, ok := TierDefinition("Platinum")
+	assert.Assert(t, ok)
+	assert.Equal(t, *platinum.Tier, TierPlatinum)
+	assert.Equal(t, platinum.Doculint.MinFunLen, 10)
+	assert.DeepEqual(t, platinum.Header.Fields, []string{"Description"})
+	assert.Equal(t, platinum.MagicNumber.Enabled, false)
+
+	gold, ok := TierDefinition(TierGold)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, gold.Header.Fields, []string{"Description"})
+	assert.Equal(t, gold.MagicNumber.Enabled, false)
+
+	// Versions of a tier only add to the minimums of the version before them.
+	goldV2, ok := TierDefinition(TierGoldV2)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, goldV2.Header.Fields, []string{"Description", "Owner"})
+
+	platinumV2, ok := TierDefinition(TierPlatinumV2)
+	assert.Assert(t, ok)
+	assert.Equal(t, platinumV2.Doculint.MinFunLen, 10)
+	assert.DeepEqual(t, platinumV2.Header.Fields, []string{"Description", "Owner", "Runbook"})
+	assert.Equal(t, platinumV2.MagicNumber.Enabled, true)
+}
+
+func TestLoadTierDefinitions(t *testing.T) {
+	tt := []struct {
+		name          string
+		files         fstest.MapFS
+		expectedError string
+	}{
+		{
+			name: "Loads a valid definition",
+			files: fstest.MapFS{
+				"diamond.yaml": {Data: []byte("tier: diamond\ndoculint:\n  enabled: true\n")},
+				"README.md":    {Data: []byte("not a tier definition")},
+			},
+		},
+		{
+			name: "Requires the tier name",
+			files: fstest.MapFS{
+				"diamond.yaml": {Data: []byte("doculint:\n  enabled: true\n")},
+			},
+			expectedError: "tier must be set",
+		},
+		{
+			name: "Rejects unknown fields",
+			files: fstest.MapFS{
+				"diamond.yaml": {Data: []byte("tier: diamond\ndoculint:\n  enabeld: true\n")},
+			},
+			expectedError: "field enabeld not found",
+		},
+		{
+			name: "Rejects options that don't belong to a linter",
+			files: fstest.MapFS{
+				"diamond.yaml": {Data: []byte("tier: diamond\nscoring:\n  enabled: true\nignorePaths: [vendor]\n")},
+			},
+			expectedError: "ignorePaths, scoring can not be set in a tier definition",
+		},
+		{
+			name: "Rejects invalid copyright patterns",
+			files: fstest.MapFS{
+				"diamond.yaml": {Data: []byte("tier: diamond\ncopyright:\n  pattern: \"(\"\n")},
+			},
+			expectedError: "compile copyright.pattern",
+		},
+		{
+			name: "Rejects duplicate tiers",
+			files: fstest.MapFS{
+				"a.yaml": {Data: []byte("tier: diamond\n")},
+				"b.yml":  {Data: []byte("tier: Diamond\n")},
+			},
+			expectedError: "defined more than once",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			definitions, err := LoadTierDefinitions(test.files)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, len(definitions), 1)
+			assert.Equal(t, definitions["diamond"].Doculint.Enabled, true)
+		})
+	}
+}
+
+func TestValidateTierModes(t *testing.T) {
+	tt := []struct {
+		name          string
+		mode          string
+		expectedError string
+	}{
+		{
+			name: "Autofix raises the configuration and records each override",
+			mode: TierModeAutofix,
+		},
+		{
+			name: "Autofix is the default",
+			mode: "",
+		},
+		{
+			name:          "Strict fails when the configuration is below the minimums",
+			mode:          TierModeStrict,
+			expectedError: "lintroller.todo.enabled must be true",
+		},
+		{
+			name:          "Unknown modes are rejected",
+			mode:          "lenient",
+			expectedError: "tierMode \"lenient\" is not one of",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			lr := Lintroller{
+				Tier:     &TierSilver,
+				TierMode: test.mode,
+				Header:   Header{Enabled: true, Fields: []string{"Description"}},
+				Copyright: Copyright{
+					Enabled: true,
+					Pattern: `^Copyright 20.*$`,
+				},
+				Doculint: Doculint{Enabled: true, ValidatePackages: true},
+				Why:      Why{Enabled: true},
+			}
+
+			err := lr.ValidateTier()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, lr.Todo.Enabled, true)
+			assert.DeepEqual(t, lr.Overrides(), []Minimum{
+				{Tier: TierSilver, Field: "lintroller.todo.enabled", Required: true, Configured: false, Status: MinimumRaised},
+			})
+		})
+	}
+}
+
+func TestValidateTierSchedule(t *testing.T) {
+	schedule := []TierEscalation{
+		{Tier: TierSilver, From: "2025-01-01"},
+		{Tier: TierGold, From: "2025-03-01"},
+	}
+
+	tt := []struct {
+		name             string
+		date             string
+		schedule         []TierEscalation
+		expectedTier     string
+		expectedUpcoming *TierEscalation
+		expectedError    string
+	}{
+		{
+			name:         "Keeps the tier before the schedule starts",
+			date:         "2024-06-01",
+			schedule:     schedule,
+			expectedTier: TierBronze,
+		},
+		{
+			name:             "Reports entries starting soon",
+			date:             "2024-12-15",
+			schedule:         schedule,
+			expectedTier:     TierBronze,
+			expectedUpcoming: &schedule[0],
+		},
+		{
+			name:         "Requires the tier of an entry from its date",
+			date:         "2025-01-01",
+			schedule:     schedule,
+			expectedTier: TierSilver,
+		},
+		{
+			name:             "Requires the tier of the latest entry that started",
+			date:             "2025-02-20",
+			schedule:         schedule,
+			expectedTier:     TierSilver,
+			expectedUpcoming: &schedule[1],
+		},
+		{
+			name:         "Keeps the tier of the last entry",
+			date:         "2026-01-01",
+			schedule:     schedule,
+			expectedTier: TierGold,
+		},
+		{
+			name:          "Rejects unknown tiers",
+			date:          "2025-01-01",
+			schedule:      []TierEscalation{{Tier: "tin", From: "2025-01-01"}},
+			expectedError: "[0].tier \"tin\" is not one of",
+		},
+		{
+			name:          "Rejects malformed dates",
+			date:          "2025-01-01",
+			schedule:      []TierEscalation{{Tier: TierGold, From: "March 2025"}},
+			expectedError: "[0].from \"March 2025\" is not a date of the form YYYY-MM-DD",
+		},
+		{
+			name:          "Rejects entries out of order",
+			date:          "2025-01-01",
+			schedule:      []TierEscalation{schedule[1], schedule[0]},
+			expectedError: "[1].from 2025-01-01 must be after the date of the entry before it",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			date, err := time.Parse(time.DateOnly, test.date)
+			assert.NilError(t, err)
+
+			now = func() time.Time { return date }
+			defer func() { now = time.Now }()
+
+			lr := Lintroller{Tier: &TierBronze, TierSchedule: test.schedule}
+
+			err = lr.ValidateTier()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, *lr.Tier, test.expectedTier)
+			assert.DeepEqual(t, lr.UpcomingTierEscalation(), test.expectedUpcoming)
+		})
+	}
+}
+
+func TestValidatePackageTiersIgnoresTierSchedule(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	lr := Lintroller{
+		Tier:         &TierBronze,
+		TierSchedule: []TierEscalation{{Tier: TierGold, From: "2025-01-01"}},
+		PackageTiers: []PackageTier{{Paths: []string{"internal/legacy/**"}, Tier: TierBronze}},
+	}
+
+	assert.NilError(t, lr.ValidatePackageTiers())
+	assert.NilError(t, lr.ValidateTier())
+
+	assert.Equal(t, *lr.Tier, TierGold)
+	assert.Equal(t, *lr.PackageTiers[0].Config().Tier, TierBronze)
+}
+
+func TestEnsureMinimumsWhyIsIndependentOfTodo(t *testing.T) {
+	tt := []struct {
+		name        string
+		tier        string
+		todo, why   bool
+		expectedWhy bool
+		raised      []string
+	}{
+		{
+			name:        "Why stays enabled when todo is disabled and not required",
+			tier:        TierBronze,
+			why:         true,
+			expectedWhy: true,
+		},
+		{
+			name:        "Why stays disabled when todo is enabled and neither is required",
+			tier:        TierBronze,
+			todo:        true,
+			expectedWhy: false,
+		},
+		{
+			name:        "Why is raised on its own when todo already meets the tier",
+			tier:        TierSilver,
+			todo:        true,
+			expectedWhy: true,
+			raised:      []string{"lintroller.why.enabled"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			tier := test.tier
+			lr := Lintroller{
+				Tier:      &tier,
+				Header:    Header{Enabled: true, Fields: []string{"Description"}},
+				Copyright: Copyright{Enabled: true, Pattern: `^Copyright 20.*$`},
+				Doculint:  Doculint{Enabled: true, ValidatePackages: true},
+				Todo:      Todo{Enabled: test.todo},
+				Why:       Why{Enabled: test.why},
+			}
+
+			assert.NilError(t, lr.ValidateTier())
+			assert.Equal(t, lr.Why.Enabled, test.expectedWhy)
+
+			var raised []string
+			for _, o := range lr.Overrides() {
+				raised = append(raised, o.Field)
+			}
+			assert.DeepEqual(t, raised, test.raised)
+		})
+	}
+}
+
+func TestEnsureMinimumsCopyrightPattern(t *testing.T) {
+	tt := []struct {
+		name            string
+		tier            string
+		copyright       Copyright
+		expectedPattern string
+		expectedStatus  string
+	}{
+		{
+			name:            "Tiers without a pattern keep the configured pattern",
+			tier:            TierBronze,
+			copyright:       Copyright{Pattern: `^Copyright 2022 Outreach`},
+			expectedPattern: `^Copyright 2022 Outreach`,
+		},
+		{
+			name:            "Missing patterns are raised to the pattern of the tier",
+			tier:            TierSilver,
+			copyright:       Copyright{Enabled: true},
+			expectedPattern: `^Copyright 20.*$`,
+			expectedStatus:  MinimumRaised,
+		},
+		{
+			name:            "Patterns requiring the prefix of the tier meet it",
+			tier:            TierSilver,
+			copyright:       Copyright{Enabled: true, Pattern: `^Copyright 20[0-9]{2} Outreach Corporation\. All Rights Reserved\.$`},
+			expectedPattern: `^Copyright 20[0-9]{2} Outreach Corporation\. All Rights Reserved\.$`,
+			expectedStatus:  MinimumMet,
+		},
+		{
+			name:           "Text matching the pattern of the tier meets it",
+			tier:           TierSilver,
+			copyright:      Copyright{Enabled: true, Text: "Copyright 2022 Outreach Corporation. All Rights Reserved."},
+			expectedStatus: MinimumMet,
+		},
+		{
+			name:            "Patterns that accept more than the tier are raised",
+			tier:            TierSilver,
+			copyright:       Copyright{Enabled: true, Pattern: `Copyright`},
+			expectedPattern: `^Copyright 20.*$`,
+			expectedStatus:  MinimumRaised,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			tier := test.tier
+			lr := Lintroller{
+				Tier:      &tier,
+				TierMode:  TierModeStrict,
+				Header:    Header{Enabled: true, Fields: []string{"Description"}},
+				Copyright: test.copyright,
+				Doculint:  Doculint{Enabled: true, ValidatePackages: true},
+				Todo:      Todo{Enabled: true},
+				Why:       Why{Enabled: true},
+			}
+
+			// Strict mode only fails when the pattern had to be raised.
+			err := lr.ValidateTier()
+			if test.expectedStatus == MinimumRaised {
+				assert.ErrorContains(t, err, "lintroller.copyright.pattern must be")
+			} else {
+				assert.NilError(t, err)
+			}
+			assert.Equal(t, lr.Copyright.Pattern, test.expectedPattern)
+
+			var status string
+			for _, m := range lr.Minimums() {
+				if m.Field == "lintroller.copyright.pattern" {
+					status = m.Status
+				}
+			}
+			assert.Equal(t, status, test.expectedStatus)
+		})
+	}
+}
+
+func TestEnsureMinimumsMergesHeaderFields(t *testing.T) {
+	tt := []struct {
+		name     string
+		tier     string
+		fields   []string
+		expected []string
+	}{
+		{
+			name:     "Unset fields take the fields of the tier",
+			tier:     TierSilver,
+			expected: []string{"Description"},
+		},
+		{
+			name:     "Higher tiers add their fields to the configured fields",
+			tier:     TierPlatinumV2,
+			fields:   []string{"Owner", "Team"},
+			expected: []string{"Owner", "Team", "Description", "Runbook"},
+		},
+		{
+			name:     "Configured fields that already meet the tier are untouched",
+			tier:     TierGoldV2,
+			fields:   []string{"Owner", "Description"},
+			expected: []string{"Owner", "Description"},
+		},
+		{
+			name:     "The first version of a tier doesn't require the fields of later versions",
+			tier:     TierPlatinum,
+			fields:   []string{"Description"},
+			expected: []string{"Description"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			tier := test.tier
+			lr := Lintroller{
+				Tier:   &tier,
+				Header: Header{Enabled: true, Fields: test.fields},
+			}
+
+			assert.NilError(t, lr.ValidateTier())
+			assert.DeepEqual(t, lr.Header.Fields, test.expected)
+		})
+	}
+}
+
+func TestReportFromFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "lintroller.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(`lintroller:
+  tier: platinum
+  header:
+    enabled: true
+    fields: ["Owner"]
+  copyright:
+    enabled: true
+    pattern: "^Copyright 20.*$"
+  doculint:
+    enabled: true
+    validateFunctions: true
+    minFunLen: 12
+  exceptions:
+    - rule: header.fields
+      reason: Owners are listed in CODEOWNERS
+      approvedBy: platform
+  packageTiers:
+    - paths: ["legacy/**"]
+      tier: bronze
+`), 0o600))
+
+	report, err := ReportFromFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, report.Compliant, false)
+	assert.Equal(t, len(report.Tiers), 2)
+
+	platinum := report.Tiers[0]
+	assert.Equal(t, platinum.Scope, ScopeDefault)
+	assert.Equal(t, platinum.Tier, TierPlatinum)
+	assert.Equal(t, platinum.Compliant, false)
+	assert.DeepEqual(t, platinum.Deviated, []Minimum{
+		{Tier: TierPlatinum, Field: "lintroller.doculint.minFunLen", Required: 10, Configured: 12, Status: MinimumDeviated},
+	})
+	assert.Assert(t, len(platinum.Raised) > 0)
+	assert.Assert(t, len(platinum.Met) > 0)
+	assert.Assert(t, len(platinum.Excepted) > 0)
+	for _, m := range platinum.Excepted {
+		assert.Equal(t, m.Field, "lintroller.header.fields")
+		assert.Equal(t, m.Exception.ApprovedBy, "platform")
+	}
+
+	var buf bytes.Buffer
+	assert.NilError(t, report.Write(&buf, ReportFormatText))
+	assert.Assert(t, strings.Contains(buf.String(), "EXCEPTION (default): lintroller.header.fields is"))
+
+	bronze := report.Tiers[1]
+	assert.Equal(t, bronze.Scope, "packageTiers[0]")
+	assert.Equal(t, bronze.Tier, TierBronze)
+	assert.Equal(t, bronze.Compliant, true)
+	assert.Equal(t, len(bronze.Deviated), 0)
+}
+
+func TestValidateTierExceptions(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	tt := []struct {
+		name             string
+		mode             string
+		exceptions       []TierException
+		expectedExcepted []Minimum
+		expectedTodo     bool
+		expectedError    string
+	}{
+		{
+			name: "Accepts fields below the minimums as they are",
+			mode: TierModeStrict,
+			exceptions: []TierException{
+				{Rule: "todo.enabled", Reason: "TODOs are tracked in the issue tracker", ApprovedBy: "platform", Expires: "2026-12-31"},
+			},
+			expectedExcepted: []Minimum{
+				{
+					Tier: TierSilver, Field: "lintroller.todo.enabled", Required: true, Configured: false, Status: MinimumExcepted,
+					Exception: &TierException{
+						Rule: "todo.enabled", Reason: "TODOs are tracked in the issue tracker", ApprovedBy: "platform", Expires: "2026-12-31",
+					},
+				},
+			},
+			expectedTodo: false,
+		},
+		{
+			name: "Accepts rules prefixed with lintroller",
+			exceptions: []TierException{
+				{Rule: "lintroller.todo.enabled", Reason: "TODOs are tracked in the issue tracker", ApprovedBy: "platform"},
+			},
+			expectedExcepted: []Minimum{
+				{
+					Tier: TierSilver, Field: "lintroller.todo.enabled", Required: true, Configured: false, Status: MinimumExcepted,
+					Exception: &TierException{
+						Rule: "lintroller.todo.enabled", Reason: "TODOs are tracked in the issue tracker", ApprovedBy: "platform",
+					},
+				},
+			},
+			expectedTodo: false,
+		},
+		{
+			name: "Ignores expired exceptions",
+			exceptions: []TierException{
+				{Rule: "todo.enabled", Reason: "TODOs are tracked in the issue tracker", ApprovedBy: "platform", Expires: "2026-06-01"},
+			},
+			expectedTodo: true,
+		},
+		{
+			name: "Requires a reason",
+			exceptions: []TierException{
+				{Rule: "todo.enabled", ApprovedBy: "platform"},
+			},
+			expectedError: "[0].reason must not be empty",
+		},
+		{
+			name: "Requires an approver",
+			exceptions: []TierException{
+				{Rule: "todo.enabled", Reason: "TODOs are tracked in the issue tracker"},
+			},
+			expectedError: "[0].approvedBy must not be empty",
+		},
+		{
+			name: "Rejects malformed expiry dates",
+			exceptions: []TierException{
+				{Rule: "todo.enabled", Reason: "TODOs are tracked in the issue tracker", ApprovedBy: "platform", Expires: "next year"},
+			},
+			expectedError: "[0].expires \"next year\" is not a date of the form YYYY-MM-DD",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			lr := Lintroller{
+				Tier:           &TierSilver,
+				TierMode:       test.mode,
+				TierExceptions: test.exceptions,
+				Header:         Header{Enabled: true, Fields: []string{"Description"}},
+				Copyright: Copyright{
+					Enabled: true,
+					Pattern: `^Copyright 20.*$`,
+				},
+				Doculint: Doculint{Enabled: true, ValidatePackages: true},
+				Why:      Why{Enabled: true},
+			}
+
+			err := lr.ValidateTier()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, lr.Todo.Enabled, test.expectedTodo)
+			assert.DeepEqual(t, lr.Excepted(), test.expectedExcepted)
+		})
+	}
+}
+
+func TestTierAtLeast(t *testing.T) {
+	tt := []struct {
+		tier     string
+		minimum  string
+		expected bool
+	}{
+		{tier: TierBronze, minimum: TierBronze, expected: true},
+		{tier: TierSilver, minimum: TierBronze, expected: true},
+		{tier: TierBronze, minimum: TierSilver, expected: false},
+		{tier: TierPlatinum, minimum: TierGold, expected: true},
+		{tier: TierGoldV2, minimum: TierGold, expected: true},
+		{tier: TierGold, minimum: TierGoldV2, expected: false},
+		{tier: TierPlatinum, minimum: TierGoldV2, expected: false},
+		{tier: TierPlatinumV2, minimum: TierGoldV2, expected: true},
+	}
+
+	for _, test := range tt {
+		t.Run(test.tier+" at least "+test.minimum, func(t *testing.T) {
+			ok, err := TierAtLeast(test.tier, test.minimum)
+			assert.NilError(t, err)
+			assert.Equal(t, ok, test.expected)
+		})
+	}
+}
+
+func TestValidateMinTier(t *testing.T) {
+	tt := []struct {
+		name          string
+		lintroller    Lintroller
+		minimum       string
+		expectedError string
+	}{
+		{
+			name:       "Allows the minimum tier",
+			lintroller: Lintroller{Tier: &TierSilver},
+			minimum:    TierSilver,
+		},
+		{
+			name:       "Allows higher tiers",
+			lintroller: Lintroller{Tier: &TierGold},
+			minimum:    "Silver",
+		},
+		{
+			name:          "Rejects lower tiers",
+			lintroller:    Lintroller{Tier: &TierBronze},
+			minimum:       TierSilver,
+			expectedError: "tier bronze is declared, but at least the silver tier is required",
+		},
+		{
+			name:          "Rejects configurations without a tier",
+			lintroller:    Lintroller{},
+			minimum:       TierSilver,
+			expectedError: "no tier is declared, but at least the silver tier is required",
+		},
+		{
+			name: "Rejects lower package tiers",
+			lintroller: Lintroller{
+				Tier:         &TierGold,
+				PackageTiers: []PackageTier{{Paths: []string{"legacy/**"}, Tier: TierBronze}},
+			},
+			minimum:       TierSilver,
+			expectedError: "packageTiers[0].tier bronze is declared, but at least the silver tier is required",
+		},
+		{
+			name:          "Rejects unknown minimum tiers",
+			lintroller:    Lintroller{Tier: &TierGold},
+			minimum:       "tin",
+			expectedError: "tier \"tin\" is not one of",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.lintroller.ValidateMinTier(test.minimum)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+		})
+	}
+}
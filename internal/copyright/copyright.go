@@ -7,11 +7,16 @@
 package copyright
 
 import (
+	"go/ast"
+	"go/token"
 	"regexp"
 	"strings"
 	"sync"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -23,25 +28,37 @@ const name = "copyright"
 
 // doc defines the help text for the copyright linter.
 const doc = `Ensures each .go file has a comment at the top of the file containing the 
-copyright string requested via flags.`
+copyright string requested via flags. When a block is given, every line of the header
+comment starting on line 1 must match the respective regular expression of the block. When
+entities are given, the copyright on line 1 must also name one of them.`
 
 // Analyzer exports the copyright analyzer (linter).
 var Analyzer = analysis.Analyzer{
-	Name: name,
-	Doc:  doc,
-	Run:  copyright,
+	Name:     name,
+	Doc:      doc,
+	Run:      copyright,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
 }
 
 // NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
 // that would have been defined via flags if this was ran as a vet tool. This is so the
 // analyzers can be ran outside of the context of a vet tool and config can be gathered
 // from elsewhere.
-func NewAnalyzerWithOptions(_text, _pattern string) *analysis.Analyzer {
+func NewAnalyzerWithOptions(_text, _pattern string, _block, _entities []string) *analysis.Analyzer {
 	text = strings.TrimSpace(_text)
 	pattern = strings.TrimSpace(_pattern)
+	block = append(listFlag(nil), _block...)
+	entities = append(listFlag(nil), _entities...)
 	return &Analyzer
 }
 
+// SetEmbeddedOptions sets whether or not the files embedded by //go:embed directives that
+// have Go-like comment syntax are checked as well, which would have been defined via flags
+// if this was ran as a vet tool, see NewAnalyzerWithOptions.
+func SetEmbeddedOptions(_embedded bool) {
+	embedded = _embedded
+}
+
 // Variable block to keep track of flags whose values are collected at runtime. See the
 // init function that immediately proceeds this block to see more.
 var (
@@ -52,15 +69,46 @@ var (
 	// pattern is a variable that gets collected via flags. This variable contains the copyright
 	// string as a regular expression pattern that is required to be at the top of each .go file.
 	pattern string
+
+	// block is a variable that gets collected via flags. This variable contains a regular
+	// expression for each line of the header comment required at the top of each .go file.
+	block listFlag
+
+	// entities is a variable that gets collected via flags. This variable contains the names
+	// of the legal entities the copyright on line 1 of each .go file may name.
+	entities listFlag
+
+	// embedded is a variable that gets collected via flags. This variable contains a flag
+	// that denotes whether or not the files embedded by //go:embed directives that have
+	// Go-like comment syntax, see commentedExtensions, are checked as well.
+	embedded bool
 )
 
+// listFlag is a flag.Value that collects every value of a flag that is given once per
+// value, e.g. once per line of the block, since the values could contain any separator.
+type listFlag []string
+
+// String implements the flag.Value interface.
+func (b *listFlag) String() string {
+	return strings.Join(*b, "\n")
+}
+
+// Set implements the flag.Value interface.
+func (b *listFlag) Set(value string) error {
+	*b = append(*b, strings.TrimSpace(value))
+	return nil
+}
+
 // comparer is a convience type used to conditionally compare using either a string or a
 // compiled regular expression based off of the existence of the regular expression.
 type comparer struct {
 	text    string
 	pattern *regexp.Regexp
+	block   []*regexp.Regexp
 
+	// uniqueCopyrightsInternal is guarded by mu, since files are checked concurrently.
 	uniqueCopyrightsInternal map[string]struct{}
+	mu                       sync.Mutex
 
 	once sync.Once
 }
@@ -74,6 +122,10 @@ func (c *comparer) init() {
 		c.text = text
 	}
 
+	for _, line := range block {
+		c.block = append(c.block, regexp.MustCompile(line))
+	}
+
 	// Initialize an empty uniqueCopyrightsInternal map.
 	c.uniqueCopyrightsInternal = make(map[string]struct{})
 }
@@ -119,6 +171,9 @@ func (c *comparer) stringMatchLiteral() string {
 // it. If it we have, this is a no-op, if we haven't, we mark it as seen for reporting
 // purposes at the end of the run.
 func (c *comparer) trackUniqueness(copyrightString string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if _, exists := c.uniqueCopyrightsInternal[copyrightString]; !exists {
 		c.uniqueCopyrightsInternal[copyrightString] = struct{}{}
 	}
@@ -130,6 +185,12 @@ func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
 	Analyzer.Flags.StringVar(&text, "text", "", "the copyright string required at the top of each .go file. if this and pattern are empty the linter is a no-op")
 	//nolint:lll // Why: usage long
 	Analyzer.Flags.StringVar(&pattern, "pattern", "", "the copyright pattern (as a regular expression) required at the top of each .go file. if this and pattern are empty the linter is a no-op. pattern takes precedence over text if both are supplied")
+	//nolint:lll // Why: usage long
+	Analyzer.Flags.Var(&block, "block", "a regular expression for a line of the header comment required at the top of each .go file, given once per line of the header in order. checked in addition to text or pattern")
+	//nolint:lll // Why: usage long
+	Analyzer.Flags.Var(&entities, "entity", "the name of a legal entity the copyright on line 1 of each .go file may name, given once per entity. when given, the copyright must name one of them")
+	//nolint:lll // Why: usage long
+	Analyzer.Flags.BoolVar(&embedded, "embedded", false, "a boolean flag that denotes whether or not to also check files embedded by //go:embed directives that have Go-like comment syntax")
 
 	// Trim space around the passed in variables just in case.
 	text = strings.TrimSpace(text)
@@ -138,63 +199,231 @@ func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
 
 // copyright is the function that gets passed to the Analyzer which runs the actual
 // analysis for the copyright linter on a set of files.
-func copyright(pass *analysis.Pass) (interface{}, error) { //nolint:funlen // Why: Doesn't make sense to break this function up anymore.
+func copyright(_pass *analysis.Pass) (interface{}, error) {
 	// Ignore test packages.
-	if common.IsTestPackage(pass) {
+	if common.SkipPackage(_pass) {
 		return nil, nil
 	}
 
-	if text == "" && pattern == "" {
+	if text == "" && pattern == "" && len(block) == 0 {
 		return nil, nil
 	}
 
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
 	// comparer to use on this pass.
 	var c comparer
 
-	for _, file := range pass.Files {
-		// Ignore generated files and test files.
-		if common.IsGenerated(file) || common.IsTestFile(pass, file) {
-			continue
+	// The copyright is a legal requirement of every file, so the Go files excluded by build
+	// constraints are checked whether or not lintroller lints them otherwise.
+	files := append(append([]*ast.File(nil), pass.Files...), common.ParseExcludedFiles(pass.Pass)...)
+
+	var linted []*ast.File
+	for _, file := range files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if !common.SkipFile(pass.Pass, file) {
+			linted = append(linted, file)
 		}
+	}
+
+	if embedded {
+		linted = append(linted, embeddedFiles(pass.Fset, linted)...)
+	}
+
+	pass.ForEachFile(linted, func(r *reporter.Buffer, file *ast.File) {
+		checkFile(r, pass.Fset, &c, file)
+	})
+
+	return nil, nil
+}
+
+// checkFile reports the given file if the comment on its line 1 isn't the required
+// copyright, using the given comparer, or doesn't match the block or name the entities.
+func checkFile(r reporter.Reporter, fset *token.FileSet, c *comparer, file *ast.File) {
+	fp := fset.PositionFor(file.Package, false).Filename
+
+	// Variable to keep track of whether or not the copyright string was found at the
+	// top of the current file.
+	var foundCopyright bool
+
+	// Variable to keep track of the text of the first line of the comment on line 1 of
+	// the current file and its position, if there is one.
+	var lineOneText string
+	var lineOnePos token.Pos
 
-		fp := pass.Fset.PositionFor(file.Package, false).Filename
+	for _, commentGroup := range file.Comments {
+		if fset.PositionFor(commentGroup.Pos(), false).Line != 1 {
+			// The copyright comment needs to be on line 1. Ignore all other comments.
+			continue
+		}
 
-		// Variable to keep track of whether or not the copyright string was found at the
-		// top of the current file.
-		var foundCopyright bool
+		// Get the text out of the first line, trimming the // prefix and space before and after
+		// that may or may not exist.
+		lineOneText = strings.TrimSpace(strings.TrimPrefix(commentGroup.List[0].Text, "//"))
+		lineOnePos = commentGroup.Pos()
 
-		for _, commentGroup := range file.Comments {
-			if pass.Fset.PositionFor(commentGroup.Pos(), false).Line != 1 {
-				// The copyright comment needs to be on line 1. Ignore all other comments.
-				continue
+		// Block comments, the only comments some embedded files have, e.g. .css files, hold
+		// the copyright on their first line of text, without the comment markers.
+		if strings.HasPrefix(commentGroup.List[0].Text, "/*") {
+			if lines, _ := headerLines(fset, file); len(lines) > 0 {
+				lineOneText = lines[0]
 			}
+		}
+
+		// Set the value of the foundCopyright to the comparison of this comment's text
+		// to the stored copyrightString value or the regular expression compiled from
+		// it.
+		foundCopyright = c.compare(lineOneText)
+
+		if foundCopyright {
+			c.trackUniqueness(lineOneText)
+		}
+
+		// We can safely break here because if we got here, regardless on the outcome of
+		// the previous statement, we know this is the only comment that matters because
+		// it is on line one. This can be verified by the conditional at the top of the
+		// current loop.
+		break
+	}
+
+	c.once.Do(c.init)
+	if len(c.block) > 0 {
+		checkBlock(r, fset, file, fp, c.block)
+	}
+
+	if text == "" && pattern == "" {
+		if lineOnePos.IsValid() {
+			checkEntities(r, lineOnePos, fp, lineOneText, entities)
+		}
+		return
+	}
+
+	if !foundCopyright {
+		r.Reportf(file.Package,
+			"file \"%s\" does not contain the required copyright %s [%s] (sans-brackets) as a comment on line 1",
+			fp, c.stringMatchType(), c.stringMatchLiteral())
+		return
+	}
+
+	checkEntities(r, lineOnePos, fp, lineOneText, entities)
+}
+
+// headerLines returns the lines of the comment that starts on line 1 of the given file,
+// with their comment markers and surrounding space trimmed, along with the position of
+// each line. Files without a comment on line 1 have no header lines.
+func headerLines(fset *token.FileSet, file *ast.File) ([]string, []token.Pos) {
+	if len(file.Comments) == 0 || fset.PositionFor(file.Comments[0].Pos(), false).Line != 1 {
+		return nil, nil
+	}
 
-			// Get the text out of the first line, trimming the // prefix and space before and after
-			// that may or may not exist.
-			lineOneText := strings.TrimSpace(strings.TrimPrefix(commentGroup.List[0].Text, "//"))
+	var lines []string
+	var positions []token.Pos
+	for _, comment := range file.Comments[0].List {
+		if !strings.HasPrefix(comment.Text, "/*") {
+			lines = append(lines, strings.TrimSpace(strings.TrimPrefix(comment.Text, "//")))
+			positions = append(positions, comment.Pos())
+			continue
+		}
 
-			// Set the value of the foundCopyright to the comparison of this comment's text
-			// to the stored copyrightString value or the regular expression compiled from
-			// it.
-			foundCopyright = c.compare(lineOneText)
+		// A block comment spans several lines, the position of each of them is the start of
+		// the comment offset by the length of the lines before it. The lines holding nothing
+		// but the opening or closing marker aren't part of the header.
+		raw := strings.Split(comment.Text, "\n")
+		offset := 0
+		for i, line := range raw {
+			text := strings.TrimSpace(line)
+			if i == 0 {
+				text = strings.TrimPrefix(text, "/*")
+			}
+			if i == len(raw)-1 {
+				text = strings.TrimSuffix(text, "*/")
+			}
+			text = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), "*"))
 
-			if foundCopyright {
-				c.trackUniqueness(lineOneText)
+			if text != "" || (i != 0 && i != len(raw)-1) {
+				lines = append(lines, text)
+				positions = append(positions, comment.Pos()+token.Pos(offset))
 			}
+			offset += len(line) + 1
+		}
+	}
+
+	return lines, positions
+}
 
-			// We can safely break here because if we got here, regardless on the outcome of
-			// the previous statement, we know this is the only comment that matters because
-			// it is on line one. This can be verified by the conditional at the top of the
-			// current loop.
-			break
+// checkBlock reports the first line of the header comment of the given file, which must
+// start on line 1, that doesn't match the respective regular expression of the given
+// block, or the first line of the block that the header comment is too short to contain.
+func checkBlock(r reporter.Reporter, fset *token.FileSet, file *ast.File, filename string, block []*regexp.Regexp) {
+	lines, positions := headerLines(fset, file)
+
+	for i, re := range block {
+		if i >= len(lines) {
+			r.Reportf(file.Package,
+				"file \"%s\" does not contain line %d of the required copyright block, which must match "+
+					"the regular expression [%s] (sans-brackets)", filename, i+1, re.String())
+			return
 		}
 
-		if !foundCopyright {
-			pass.Reportf(0,
-				"file \"%s\" does not contain the required copyright %s [%s] (sans-brackets) as a comment on line 1",
-				fp, c.stringMatchType(), c.stringMatchLiteral())
+		if !re.MatchString(lines[i]) {
+			r.Reportf(positions[i],
+				"line %d of the copyright block of file \"%s\" does not match the regular expression [%s] (sans-brackets)",
+				i+1, filename, re.String())
+			return
 		}
 	}
+}
 
-	return nil, nil
+// checkEntities reports the given copyright, found on line 1 of the given file, when it
+// doesn't name any of the given legal entities. Regular expressions can't tell apart names
+// like "Outreach Inc." and "Outreach Corporation" without getting unwieldy, so the entities
+// the copyright may name are listed separately. Nothing is reported without entities.
+func checkEntities(r reporter.Reporter, pos token.Pos, filename, copyright string, entities []string) {
+	if len(entities) == 0 {
+		return
+	}
+
+	for _, entity := range entities {
+		if namesEntity(copyright, entity) {
+			return
+		}
+	}
+
+	r.Reportf(pos, "copyright of file \"%s\" does not name any of the legal entities [%s] (sans-brackets)",
+		filename, strings.Join(entities, "; "))
+}
+
+// namesEntity reports whether or not the given copyright contains the given entity name as
+// a whole, i.e. not directly preceded or followed by a letter or digit, so that
+// "Outreach Corporation" isn't named by "Outreach Corporations". Names are case-sensitive.
+func namesEntity(copyright, entity string) bool {
+	entity = strings.TrimSpace(entity)
+	if entity == "" {
+		return false
+	}
+
+	for offset := 0; offset < len(copyright); {
+		i := strings.Index(copyright[offset:], entity)
+		if i == -1 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(entity)
+
+		before, _ := utf8.DecodeLastRuneInString(copyright[:start])
+		after, _ := utf8.DecodeRuneInString(copyright[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+
+		offset = start + 1
+	}
+
+	return false
+}
+
+// isWordRune reports whether or not the given rune is part of a word, i.e. a letter or a
+// digit. utf8.RuneError, returned at either end of a string, isn't.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
 }
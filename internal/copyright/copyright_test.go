@@ -0,0 +1,155 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package copyright
+
+import (
+	"go/parser"
+	"go/token"
+	"regexp"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	a := NewAnalyzerWithOptions("Copyright 2026 Example Corporation.", "", nil, nil)
+	t.Cleanup(func() { NewAnalyzerWithOptions("", "", nil, nil) })
+
+	linttest.Run(t, a, "copyright")
+}
+
+func TestCheckBlock(t *testing.T) {
+	block := []*regexp.Regexp{
+		regexp.MustCompile(`^Copyright 20[2-9][0-9] Outreach Corporation\. All Rights Reserved\.$`),
+		regexp.MustCompile(`^Licensed under the Outreach Source License\.$`),
+		regexp.MustCompile(`^See LICENSE for details\.$`),
+	}
+
+	tt := []struct {
+		name     string
+		src      string
+		expected []string
+	}{
+		{
+			name: "Allows matching blocks",
+			src: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+// Licensed under the Outreach Source License.
+// See LICENSE for details.
+
+package foo
+`,
+			expected: nil,
+		},
+		{
+			name: "Allows matching block comments",
+			src: `/*
+ * Copyright 2026 Outreach Corporation. All Rights Reserved.
+ * Licensed under the Outreach Source License.
+ * See LICENSE for details.
+ */
+
+package foo
+`,
+			expected: nil,
+		},
+		{
+			name: "Reports mismatched lines",
+			src: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+// Licensed under the MIT License.
+// See LICENSE for details.
+
+package foo
+`,
+			expected: []string{
+				"line 2 of the copyright block of file \"foo.go\" does not match the regular expression " +
+					"[^Licensed under the Outreach Source License\\.$] (sans-brackets)",
+			},
+		},
+		{
+			name: "Reports short blocks",
+			src: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Package foo does things.
+package foo
+`,
+			expected: []string{
+				"file \"foo.go\" does not contain line 2 of the required copyright block, which must match " +
+					"the regular expression [^Licensed under the Outreach Source License\\.$] (sans-brackets)",
+			},
+		},
+		{
+			name: "Reports files without a header",
+			src:  "package foo\n",
+			expected: []string{
+				"file \"foo.go\" does not contain line 1 of the required copyright block, which must match " +
+					"the regular expression [^Copyright 20[2-9][0-9] Outreach Corporation\\. All Rights Reserved\\.$] (sans-brackets)",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", test.src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			checkBlock(&r, fset, file, "foo.go", block)
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
+
+func TestCheckEntities(t *testing.T) {
+	entities := []string{"Outreach Corporation", "Smartly Inc."}
+
+	tt := []struct {
+		name      string
+		copyright string
+		expected  []string
+	}{
+		{
+			name:      "Allows copyrights naming an entity",
+			copyright: "Copyright 2026 Outreach Corporation. All Rights Reserved.",
+			expected:  nil,
+		},
+		{
+			name:      "Allows copyrights naming any of the entities",
+			copyright: "Copyright 2026 Smartly Inc. All Rights Reserved.",
+			expected:  nil,
+		},
+		{
+			name:      "Reports copyrights naming another entity",
+			copyright: "Copyright 2026 Outreach Inc. All Rights Reserved.",
+			expected: []string{
+				"copyright of file \"foo.go\" does not name any of the legal entities " +
+					"[Outreach Corporation; Smartly Inc.] (sans-brackets)",
+			},
+		},
+		{
+			name:      "Reports copyrights naming an entity as part of a longer name",
+			copyright: "Copyright 2026 Outreach Corporations. All Rights Reserved.",
+			expected: []string{
+				"copyright of file \"foo.go\" does not name any of the legal entities " +
+					"[Outreach Corporation; Smartly Inc.] (sans-brackets)",
+			},
+		},
+		{
+			name:      "Names are case-sensitive",
+			copyright: "Copyright 2026 outreach corporation. All Rights Reserved.",
+			expected: []string{
+				"copyright of file \"foo.go\" does not name any of the legal entities " +
+					"[Outreach Corporation; Smartly Inc.] (sans-brackets)",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			var r linttest.Recorder
+			checkEntities(&r, token.NoPos, "foo.go", test.copyright, entities)
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
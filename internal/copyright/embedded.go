@@ -0,0 +1,198 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements finding the files embedded by //go:embed directives
+// and reading their leading comments, so that their copyright can be checked like that
+// of a .go file.
+
+package copyright
+
+import (
+	"go/ast"
+	"go/scanner"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+)
+
+// commentedExtensions contains the extensions of the embedded files that have Go-like
+// comment syntax, i.e. // and /* */ comments, which are the only embedded files checked.
+var commentedExtensions = map[string]bool{
+	".c":     true,
+	".cc":    true,
+	".cpp":   true,
+	".cs":    true,
+	".css":   true,
+	".go":    true,
+	".h":     true,
+	".java":  true,
+	".js":    true,
+	".jsx":   true,
+	".kt":    true,
+	".less":  true,
+	".mjs":   true,
+	".proto": true,
+	".rs":    true,
+	".scss":  true,
+	".swift": true,
+	".ts":    true,
+	".tsx":   true,
+}
+
+// embedDirective is the prefix of a //go:embed directive.
+const embedDirective = "//go:embed "
+
+// embeddedFiles returns the files with Go-like comment syntax embedded by the //go:embed
+// directives of the given files, each loaded into the given FileSet as an *ast.File that
+// holds nothing but the comments leading the file, so that it can be checked like a .go
+// file. Files in ignored paths, and files that can't be read, are left out.
+func embeddedFiles(fset *token.FileSet, files []*ast.File) []*ast.File {
+	var paths []string
+	seen := make(map[string]bool)
+
+	// Embedded .go files that are part of the package are checked already.
+	for _, file := range files {
+		seen[fset.PositionFor(file.Package, false).Filename] = true
+	}
+
+	for _, file := range files {
+		dir := filepath.Dir(fset.PositionFor(file.Package, false).Filename)
+
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				if !strings.HasPrefix(comment.Text, embedDirective) {
+					continue
+				}
+
+				for _, path := range embeddedPaths(dir, embedPatterns(strings.TrimPrefix(comment.Text, embedDirective))) {
+					if !seen[path] && commentedExtensions[filepath.Ext(path)] && !common.IsIgnoredPath(path) {
+						seen[path] = true
+						paths = append(paths, path)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(paths)
+
+	var embedded []*ast.File
+	for _, path := range paths {
+		if file, ok := leadingComments(fset, path); ok {
+			embedded = append(embedded, file)
+		}
+	}
+
+	return embedded
+}
+
+// embedPatterns returns the patterns given to a //go:embed directive, which are separated by
+// spaces and may be quoted like Go strings to contain spaces.
+func embedPatterns(args string) []string {
+	var patterns []string
+
+	for args = strings.TrimSpace(args); args != ""; args = strings.TrimSpace(args) {
+		if args[0] != '"' && args[0] != '`' {
+			end := strings.IndexAny(args, " \t")
+			if end == -1 {
+				end = len(args)
+			}
+			patterns = append(patterns, args[:end])
+			args = args[end:]
+			continue
+		}
+
+		quoted, err := strconv.QuotedPrefix(args)
+		if err != nil {
+			// The go command rejects the directive, there is nothing left to check.
+			break
+		}
+		if pattern, err := strconv.Unquote(quoted); err == nil {
+			patterns = append(patterns, pattern)
+		}
+		args = args[len(quoted):]
+	}
+
+	return patterns
+}
+
+// embeddedPaths returns the paths of the files the given patterns of a //go:embed directive
+// embed, relative to the given directory of the package. Like the go command, directories
+// embed every file beneath them except for those whose names start with . or _, unless the
+// pattern starts with "all:".
+func embeddedPaths(dir string, patterns []string) []string {
+	var paths []string
+
+	for _, pattern := range patterns {
+		pattern, all := strings.CutPrefix(pattern, "all:")
+
+		matches, err := filepath.Glob(filepath.Join(dir, filepath.FromSlash(pattern)))
+		if err != nil {
+			continue
+		}
+
+		for _, match := range matches {
+			// The walk never fails, unreadable directories just embed nothing.
+			_ = filepath.WalkDir(match, func(path string, entry fs.DirEntry, err error) error {
+				if err != nil {
+					return nil
+				}
+
+				if path != match && !all && (strings.HasPrefix(entry.Name(), ".") || strings.HasPrefix(entry.Name(), "_")) {
+					if entry.IsDir() {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+
+				if !entry.IsDir() {
+					paths = append(paths, path)
+				}
+				return nil
+			})
+		}
+	}
+
+	return paths
+}
+
+// leadingComments loads the file at the given path into the given FileSet and returns it as
+// an *ast.File whose only comment group is made up of the comments leading the file, with
+// Package set to the start of the file, or false if it can't be read.
+func leadingComments(fset *token.FileSet, path string) (*ast.File, bool) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	tf := fset.AddFile(path, -1, len(content))
+
+	var s scanner.Scanner
+	s.Init(tf, content, nil, scanner.ScanComments)
+
+	// Comments on consecutive lines make up the leading comment group, like they do in a
+	// .go file. Scanning stops at anything else, which needn't be valid Go.
+	group := &ast.CommentGroup{}
+	for {
+		pos, tok, lit := s.Scan()
+		if tok != token.COMMENT {
+			break
+		}
+
+		if len(group.List) > 0 && tf.Line(pos) > tf.Line(group.End())+1 {
+			break
+		}
+		group.List = append(group.List, &ast.Comment{Slash: pos, Text: lit})
+	}
+
+	file := &ast.File{Package: token.Pos(tf.Base())}
+	if len(group.List) > 0 {
+		file.Comments = []*ast.CommentGroup{group}
+	}
+
+	return file, true
+}
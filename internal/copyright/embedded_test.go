@@ -0,0 +1,89 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package copyright
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestEmbedPatterns(t *testing.T) {
+	tt := []struct {
+		name     string
+		args     string
+		expected []string
+	}{
+		{
+			name:     "Splits patterns separated by spaces",
+			args:     "static/*.js  templates",
+			expected: []string{"static/*.js", "templates"},
+		},
+		{
+			name:     "Unquotes quoted patterns",
+			args:     "\"assets/my file.css\" `all:web`",
+			expected: []string{"assets/my file.css", "all:web"},
+		},
+		{
+			name:     "Stops at malformed quotes",
+			args:     "a.js \"b.js",
+			expected: []string{"a.js"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, embedPatterns(test.args), test.expected)
+		})
+	}
+}
+
+func TestEmbeddedFiles(t *testing.T) {
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"web/app.js":        "// Copyright 2026 Example Corporation.\n// The app.\n\n// Not the header.\nlet x = 1;\n",
+		"web/style.css":     "/* Copyright 2026 Example Corporation. */\nbody {}\n",
+		"web/_draft.js":     "let draft = 1;\n",
+		"web/index.html":    "<!-- Copyright 2026 Example Corporation. -->\n",
+		"web/vendor/lib.js": "let lib = 1;\n",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NilError(t, os.WriteFile(path, []byte(src), 0o600))
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(dir, "embed.go"), `package web
+
+import "embed"
+
+//go:embed web
+var assets embed.FS
+`, parser.ParseComments)
+	assert.NilError(t, err)
+
+	embedded := embeddedFiles(fset, []*ast.File{file})
+
+	var names []string
+	var headers []int
+	for _, f := range embedded {
+		rel, err := filepath.Rel(dir, fset.PositionFor(f.Package, false).Filename)
+		assert.NilError(t, err)
+		names = append(names, filepath.ToSlash(rel))
+
+		var lines int
+		if len(f.Comments) > 0 {
+			lines = len(f.Comments[0].List)
+		}
+		headers = append(headers, lines)
+	}
+
+	// Files in ignored paths, e.g. vendor directories, are left out.
+	assert.DeepEqual(t, names, []string{"web/app.js", "web/style.css"})
+	assert.DeepEqual(t, headers, []int{2, 1})
+}
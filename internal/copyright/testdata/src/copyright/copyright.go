@@ -0,0 +1,4 @@
+// Copyright 2026 Example Corporation.
+
+// Package copyright has files with and without the required copyright.
+package copyright
@@ -0,0 +1 @@
+package copyright // want `does not contain the required copyright string \[Copyright 2026 Example Corporation\.\]`
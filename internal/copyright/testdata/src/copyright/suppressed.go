@@ -0,0 +1 @@
+package copyright //nolint:copyright // Why: suppressed issues aren't reported.
@@ -0,0 +1,147 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package ctxstruct contains the necessary logic for the ctxstruct linter. The ctxstruct
+// linter ensures that contexts are passed to the functions that need them rather than
+// stored in structs, per the guidance of the context package.
+package ctxstruct
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the ctxstruct linter.
+const name = "ctxstruct"
+
+// doc defines the help text for the ctxstruct linter.
+const doc = `Ensures that contexts aren't stored in structs: struct fields can not be of type
+context.Context, and methods can not assign a context.Context to a field of their
+receiver. Contexts should be passed explicitly as the first parameter of each function
+that needs one, see https://pkg.go.dev/context.`
+
+// Analyzer exports the ctxstruct analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      ctxstruct,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// ctxstruct is the function that gets passed to the Analyzer which runs the actual
+// analysis for the ctxstruct linter on a set of files.
+func ctxstruct(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, pass.TypesInfo, file)
+	}
+
+	return nil, nil
+}
+
+// checkFile reports every struct field of type context.Context and every assignment of a
+// context.Context to a field of a method's receiver within the given file.
+func checkFile(r reporter.Reporter, info *types.Info, file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.StructType:
+			for _, field := range node.Fields.List {
+				if !isContext(info.TypeOf(field.Type)) {
+					continue
+				}
+
+				if len(field.Names) == 0 {
+					r.Reportf(field.Pos(), "struct embeds context.Context, pass the context as the first parameter of "+
+						"the functions that need it instead")
+					continue
+				}
+
+				for _, fieldName := range field.Names {
+					r.Reportf(fieldName.Pos(), "struct field \"%s\" is of type context.Context, pass the context as the "+
+						"first parameter of the functions that need it instead", fieldName.Name)
+				}
+			}
+		case *ast.FuncDecl:
+			checkReceiverAssignments(r, info, node)
+		}
+
+		return true
+	})
+}
+
+// checkReceiverAssignments reports every assignment of a context.Context to a field of the
+// receiver of the given function, if it is a method, including fields of its fields.
+func checkReceiverAssignments(r reporter.Reporter, info *types.Info, fn *ast.FuncDecl) {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 || len(fn.Recv.List[0].Names) == 0 || fn.Body == nil {
+		return
+	}
+
+	recv := info.ObjectOf(fn.Recv.List[0].Names[0])
+	if recv == nil {
+		return
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+
+		for _, lhs := range assign.Lhs {
+			sel, ok := ast.Unparen(lhs).(*ast.SelectorExpr)
+			if !ok || !isContext(info.TypeOf(sel)) {
+				continue
+			}
+
+			if x := rootIdent(sel.X); x != nil && info.ObjectOf(x) == recv {
+				r.Reportf(lhs.Pos(), "method \"%s\" stores a context.Context in field \"%s\" of its receiver, pass the "+
+					"context as the first parameter of the functions that need it instead", fn.Name.Name, sel.Sel.Name)
+			}
+		}
+
+		return true
+	})
+}
+
+// rootIdent returns the identifier the given chain of selectors starts from, e.g. "w" for
+// "w.h.c", or nil if it doesn't start from one.
+func rootIdent(expr ast.Expr) *ast.Ident {
+	for {
+		switch e := ast.Unparen(expr).(type) {
+		case *ast.Ident:
+			return e
+		case *ast.SelectorExpr:
+			expr = e.X
+		default:
+			return nil
+		}
+	}
+}
+
+// isContext reports whether or not the given type is context.Context.
+func isContext(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package ctxstruct
+
+import (
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "ctxstruct")
+}
@@ -0,0 +1,38 @@
+// Package ctxstruct stores contexts in structs.
+package ctxstruct
+
+import "context"
+
+type worker struct {
+	ctx context.Context // want `struct field "ctx" is of type context.Context`
+}
+
+type runner struct {
+	ctx context.Context //nolint:ctxstruct // Why: suppressed issues aren't reported.
+}
+
+type pair struct {
+	ctx, parent context.Context // want `struct field "ctx" is of type context.Context` `struct field "parent" is of type context.Context`
+	name        string
+}
+
+type embedded struct {
+	context.Context // want `struct embeds context.Context, pass the context as the first parameter`
+}
+
+type holder struct{ c context.Context } // want `struct field "c" is of type context.Context`
+
+type owner struct{ h holder }
+
+func (o *owner) start(ctx context.Context) {
+	o.h.c = ctx // want `method "start" stores a context.Context in field "c" of its receiver`
+	other := &owner{}
+	other.h = holder{}
+}
+
+type server struct{ name string }
+
+// Contexts passed as parameters are fine.
+func (s *server) run(ctx context.Context) error { return nil }
+
+var _, _, _, _ = worker{}, runner{}, pair{}, embedded{}
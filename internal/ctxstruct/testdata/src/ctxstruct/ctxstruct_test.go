@@ -0,0 +1,9 @@
+package ctxstruct_test
+
+import "context"
+
+type fake struct {
+	ctx context.Context
+}
+
+var _ = fake{}
@@ -0,0 +1,9 @@
+package ctxstruct
+
+import "context"
+
+type ignored struct {
+	ctx context.Context
+}
+
+var _ = ignored{}
@@ -0,0 +1,65 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that the Description header fields of the
+// files of a package are consistent with each other and with its package comment.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/header"
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// checkHeaderDescriptions reports the files among the given files of a package whose
+// Description header field, found in the given metadata, refers to the package comment of
+// a package that has none, or is a copy of the description of another of the files.
+func checkHeaderDescriptions(r reporter.Reporter, fset *token.FileSet, pkgName string, files []*ast.File,
+	metadata header.Metadata) {
+	var hasPackageComment bool
+	for _, file := range files {
+		if file.Doc != nil {
+			hasPackageComment = true
+			break
+		}
+	}
+
+	// described maps each description, normalized, to the first file it describes.
+	described := make(map[string]string)
+
+	for _, file := range files {
+		description := metadata[file][header.FieldDescription]
+		if description == "" {
+			continue
+		}
+
+		fp := fset.PositionFor(file.Package, false).Filename
+
+		if refersToPackageComment(description) {
+			if !hasPackageComment {
+				reportRule(r, RuleHeaderDescription, file.Package,
+					"header description of file \"%s\" refers to the package comment, but package \"%s\" has no package comment",
+					fp, pkgName)
+			}
+			continue
+		}
+
+		normalized := strings.ToLower(strings.TrimRight(strings.TrimSpace(description), "."))
+		if first, ok := described[normalized]; ok {
+			reportRule(r, RuleHeaderDescription, file.Package,
+				"header description of file \"%s\" is a copy of the one of file \"%s\", it should describe what this file contains",
+				fp, first)
+			continue
+		}
+		described[normalized] = fp
+	}
+}
+
+// refersToPackageComment reports whether or not the given description defers to the
+// package comment rather than describing the file itself, e.g. "See package comment".
+func refersToPackageComment(description string) bool {
+	return strings.Contains(strings.ToLower(description), "package comment")
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/header"
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckHeaderDescriptions(t *testing.T) {
+	type file struct {
+		name        string
+		src         string
+		description string
+	}
+
+	tt := []struct {
+		name     string
+		files    []file
+		expected []string
+	}{
+		{
+			name: "Allows references to an existing package comment",
+			files: []file{
+				{"foo.go", "// Package foo does things.\npackage foo\n", "See package comment."},
+				{"bar.go", "package foo\n", "Implements bar."},
+			},
+		},
+		{
+			name: "Reports references to a missing package comment",
+			files: []file{
+				{"foo.go", "package foo\n", "See package comment for this one file package."},
+			},
+			expected: []string{
+				`header description of file "foo.go" refers to the package comment, but package "foo" has no package comment`,
+			},
+		},
+		{
+			name: "Reports copied descriptions",
+			files: []file{
+				{"foo.go", "// Package foo does things.\npackage foo\n", "Implements foo."},
+				{"bar.go", "package foo\n", "implements foo"},
+				{"baz.go", "package foo\n", "Implements baz."},
+			},
+			expected: []string{
+				`header description of file "bar.go" is a copy of the one of file "foo.go", it should describe what this file contains`,
+			},
+		},
+		{
+			name: "Allows several references to the package comment",
+			files: []file{
+				{"foo.go", "// Package foo does things.\npackage foo\n", "See package comment."},
+				{"bar.go", "package foo\n", "See package comment."},
+			},
+		},
+		{
+			name: "Ignores files without a description",
+			files: []file{
+				{"foo.go", "package foo\n", ""},
+				{"bar.go", "package foo\n", ""},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			metadata := make(header.Metadata)
+
+			var files []*ast.File
+			for _, f := range test.files {
+				parsed, err := parser.ParseFile(fset, f.name, f.src, parser.ParseComments)
+				assert.NilError(t, err)
+
+				files = append(files, parsed)
+				metadata[parsed] = header.Fields{header.FieldDescription: f.description}
+			}
+
+			var r linttest.Recorder
+			checkHeaderDescriptions(&r, fset, "foo", files, metadata)
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that directives meant for tools, e.g.
+// //go:generate, aren't part of doc comments, along with the fixes that separate them.
+
+package doculint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// separatedDirectives are the prefixes of the directives that don't belong in a doc comment
+// at all, since they apply to the file rather than to what the comment documents. Build
+// constraints within a doc comment aren't even honored, since they must be followed by a
+// blank line.
+var separatedDirectives = []string{"//go:generate ", "//go:build ", "// +build "}
+
+// checkDirectivePlacement reports the directives within the given doc comment that don't
+// belong there, or don't apply where they are, unless the comment is made of nothing but
+// directives. Build constraints and //go:generate directives come with a fix that moves them
+// above the comment, separated from it by a blank line. Nolint directives followed by more
+// of the comment only apply to the comment itself, and come with a fix that moves them to
+// the end of the comment, right above what it documents, where they apply to it.
+func checkDirectivePlacement(r interface{ Report(analysis.Diagnostic) }, fset *token.FileSet, doc *ast.CommentGroup) {
+	if doc == nil || !hasText(doc) {
+		return
+	}
+
+	indent := strings.Repeat("\t", fset.PositionFor(doc.List[0].Slash, false).Column-1)
+	last := len(doc.List) - 1
+
+	for i, c := range doc.List {
+		// remove deletes the directive's line, along with the line break separating it from
+		// the rest of the comment.
+		remove := analysis.TextEdit{Pos: c.Pos(), End: c.End()}
+		if i < last {
+			remove.End = doc.List[i+1].Pos()
+		} else if i > 0 {
+			remove.Pos = doc.List[i-1].End()
+		}
+
+		if separated(c.Text) {
+			directive := strings.Fields(c.Text)[0]
+			r.Report(analysis.Diagnostic{
+				Pos:      c.Pos(),
+				Category: RuleDirectivePlacement,
+				Message:  fmt.Sprintf("%s directive is part of a doc comment, separate it from the comment with a blank line", directive),
+				SuggestedFixes: []analysis.SuggestedFix{{
+					Message: fmt.Sprintf("Move the %s directive above the doc comment", directive),
+					TextEdits: []analysis.TextEdit{
+						{Pos: doc.Pos(), End: doc.Pos(), NewText: []byte(c.Text + "\n\n" + indent)},
+						remove,
+					},
+				}},
+			})
+			continue
+		}
+
+		if _, ok := reporter.ParseDirective(c.Text); ok && i < last {
+			r.Report(analysis.Diagnostic{
+				Pos:      c.Pos(),
+				Category: RuleDirectivePlacement,
+				Message:  "nolint directive in the middle of a doc comment doesn't apply to what it documents, move it to the end of the comment",
+				SuggestedFixes: []analysis.SuggestedFix{{
+					Message: "Move the nolint directive to the end of the doc comment",
+					TextEdits: []analysis.TextEdit{
+						remove,
+						{Pos: doc.End(), End: doc.End(), NewText: []byte("\n" + indent + c.Text)},
+					},
+				}},
+			})
+		}
+	}
+}
+
+// separated reports whether or not the given comment is a directive that doesn't belong in
+// a doc comment at all, see separatedDirectives.
+func separated(text string) bool {
+	for _, prefix := range separatedDirectives {
+		if strings.HasPrefix(text, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasText reports whether or not the given comment has any line that isn't a directive.
+func hasText(doc *ast.CommentGroup) bool {
+	for _, c := range doc.List {
+		if _, ok := reporter.ParseDirective(c.Text); !ok && !separated(c.Text) {
+			return true
+		}
+	}
+
+	return false
+}
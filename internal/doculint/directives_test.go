@@ -0,0 +1,96 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckDirectivePlacement(t *testing.T) {
+	tt := []struct {
+		name     string
+		doc      string
+		expected []string
+		fixed    string
+	}{
+		{
+			name: "Ignores comments without directives",
+			doc:  "// Foo does a thing.",
+		},
+		{
+			name: "Ignores groups made of directives only",
+			doc:  "//go:generate stringer -type Foo\n//nolint:doculint // Why: It's generated.",
+		},
+		{
+			name: "Ignores nolint directives at the end of the comment",
+			doc:  "// Foo does a thing.\n//nolint:errorlint // Why: It's wrapped elsewhere.",
+		},
+		{
+			name:     "Moves generate directives above the comment",
+			doc:      "// Foo does a thing.\n//go:generate stringer -type Foo",
+			expected: []string{"//go:generate directive is part of a doc comment, separate it from the comment with a blank line"},
+			fixed:    "//go:generate stringer -type Foo\n\n// Foo does a thing.",
+		},
+		{
+			name:     "Moves leading generate directives above the comment",
+			doc:      "//go:generate stringer -type Foo\n// Foo does a thing.",
+			expected: []string{"//go:generate directive is part of a doc comment, separate it from the comment with a blank line"},
+			fixed:    "//go:generate stringer -type Foo\n\n// Foo does a thing.",
+		},
+		{
+			name: "Moves nolint directives to the end of the comment",
+			doc:  "// Foo does a thing.\n//nolint:errorlint // Why: It's wrapped elsewhere.\n// It does it well.",
+			expected: []string{
+				"nolint directive in the middle of a doc comment doesn't apply to what it documents, move it to the end of the comment",
+			},
+			fixed: "// Foo does a thing.\n// It does it well.\n//nolint:errorlint // Why: It's wrapped elsewhere.",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := "package foo\n\n" + test.doc + "\nfunc Foo() {}\n"
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r diagnosticRecorder
+			checkDirectivePlacement(&r, fset, file.Decls[0].(*ast.FuncDecl).Doc)
+
+			var messages []string
+			for _, d := range r.diagnostics {
+				messages = append(messages, d.Message)
+			}
+			assert.DeepEqual(t, messages, test.expected)
+
+			if test.fixed != "" {
+				fixed := applyEdits(fset.File(file.Pos()), src, r.diagnostics[0].SuggestedFixes[0].TextEdits)
+				assert.Equal(t, fixed, "package foo\n\n"+test.fixed+"\nfunc Foo() {}\n")
+			}
+		})
+	}
+}
+
+// applyEdits returns src, the contents of the given file, with the given edits applied.
+func applyEdits(file *token.File, src string, edits []analysis.TextEdit) string {
+	sorted := append([]analysis.TextEdit(nil), edits...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Pos < sorted[j].Pos })
+
+	var out string
+	last := 0
+	for _, edit := range sorted {
+		start, end := file.Offset(edit.Pos), file.Offset(edit.End)
+		out += src[last:start] + string(edit.NewText)
+		last = end
+	}
+
+	return out + src[last:]
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the opt-in check that library packages within a set of
+// paths, e.g. those of published SDK modules, have a doc.go file with an extended package
+// comment.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// requiresDocFile reports whether or not the library package with the given import path has
+// to have a doc.go file, which is the case if it matches any of the comma-separated globs in
+// paths and none of those in exceptions.
+func requiresDocFile(pkgPath, paths, exceptions string) bool {
+	return isLibraryPackage(pkgPath) && matchesAny(pkgPath, paths) && !matchesAny(pkgPath, exceptions)
+}
+
+// matchesAny reports whether or not the given import path matches any of the given
+// comma-separated globs, see common.MatchGlob.
+func matchesAny(pkgPath, globs string) bool {
+	for _, glob := range strings.Split(globs, ",") {
+		if glob = strings.TrimSpace(glob); glob != "" && common.MatchGlob(glob, pkgPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDocFile reports the package with the given name, made of the given files, if none of
+// them is a doc.go file, or if the package comment in its doc.go file is no more than a
+// single sentence. An extended package comment goes beyond the synopsis shown in package
+// listings, e.g. by explaining what the package is for and how to get started with it.
+func checkDocFile(r reporter.Reporter, fset *token.FileSet, pkg string, files []*ast.File) {
+	if len(files) == 0 {
+		return
+	}
+
+	for _, file := range files {
+		if filepath.Base(fset.PositionFor(file.Package, false).Filename) != common.DocFilenameWithoutPath+".go" {
+			continue
+		}
+
+		if file.Doc == nil || countSentences(file.Doc.Text()) < 2 {
+			reportRule(r, RulePackageDocFile, file.Package,
+				"doc.go of library package \"%s\" should contain an extended package comment, more than a single sentence", pkg)
+		}
+		return
+	}
+
+	reportRule(r, RulePackageDocFile, files[0].Package,
+		"library package \"%s\" has no doc.go file, add one with an extended package comment or list the package as an exception", pkg)
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestRequiresDocFile(t *testing.T) {
+	const paths, exceptions = "example.com/sdk/**", "example.com/sdk/legacy/**"
+
+	tt := []struct {
+		name     string
+		pkgPath  string
+		expected bool
+	}{
+		{
+			name:     "Requires library packages within the paths",
+			pkgPath:  "example.com/sdk/client",
+			expected: true,
+		},
+		{
+			name:    "Ignores packages outside of the paths",
+			pkgPath: "example.com/service/client",
+		},
+		{
+			name:    "Ignores internal packages",
+			pkgPath: "example.com/sdk/internal/client",
+		},
+		{
+			name:    "Ignores exceptions",
+			pkgPath: "example.com/sdk/legacy/client",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, requiresDocFile(test.pkgPath, paths, exceptions), test.expected)
+		})
+	}
+}
+
+func TestCheckDocFile(t *testing.T) {
+	tt := []struct {
+		name     string
+		files    map[string]string
+		expected []string
+	}{
+		{
+			name: "Allows extended package comments in doc.go",
+			files: map[string]string{
+				"doc.go":    "// Package client is a client of the API. Create one with New.\npackage client\n",
+				"client.go": "package client\n",
+			},
+		},
+		{
+			name: "Reports packages without a doc.go file",
+			files: map[string]string{
+				"client.go": "// Package client is a client of the API. Create one with New.\npackage client\n",
+			},
+			expected: []string{
+				`library package "client" has no doc.go file, add one with an extended package comment or list the package as an exception`,
+			},
+		},
+		{
+			name: "Reports single sentence package comments in doc.go",
+			files: map[string]string{
+				"doc.go": "// Package client is a client of the API.\npackage client\n",
+			},
+			expected: []string{
+				`doc.go of library package "client" should contain an extended package comment, more than a single sentence`,
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+
+			var files []*ast.File
+			for filename, src := range test.files {
+				file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+				assert.NilError(t, err)
+				files = append(files, file)
+			}
+
+			var r linttest.Recorder
+			checkDocFile(&r, fset, "client", files)
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 // Copyright 2022 Outreach Corporation. All Rights Reserved.
 
-// Description: See package comment for this one file package.
+// Description: This file implements the doculint analyzer and the checks it runs over each
+// declaration.
 
 // Package doculint contains the necessary logic for the doculint linter. The doculint
 // linter ensures proper documentation on various types, functions, variables, constants,
@@ -12,9 +13,12 @@ import (
 	"go/ast"
 	"go/token"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/header"
 	"github.com/getoutreach/lintroller/internal/reporter"
 	"golang.org/x/tools/go/analysis"
 )
@@ -28,9 +32,10 @@ const doc = `Checks for proper function, type, package, constant, and string and
 
 // Analyzer exports the doculint analyzer (linter).
 var Analyzer = analysis.Analyzer{
-	Name: name,
-	Doc:  doc,
-	Run:  doculint,
+	Name:     name,
+	Doc:      doc,
+	Run:      doculint,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer, &header.MetadataAnalyzer},
 }
 
 // NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
@@ -38,17 +43,98 @@ var Analyzer = analysis.Analyzer{
 // analyzers can be ran outside of the context of a vet tool and config can be gathered
 // from elsewhere.
 func NewAnalyzerWithOptions(
-	_minFunLen int, _validatePackages, _validateFunctions, _validateVariables, _validateConstants, _validateTypes bool) *analysis.Analyzer {
+	_minFunLen int, _validatePackages, _validateFunctions, _validateVariables, _validateConstants, _validateTypes,
+	_validateDocNames bool) *analysis.Analyzer {
 	minFunLen = _minFunLen
 	validatePackages = _validatePackages
 	validateFunctions = _validateFunctions
 	validateVariables = _validateVariables
 	validateConstants = _validateConstants
 	validateTypes = _validateTypes
+	validateDocNames = _validateDocNames
 
 	return &Analyzer
 }
 
+// SetSpellCheckOptions sets the options of the spell check that would have been defined via
+// flags if this was ran as a vet tool, see NewAnalyzerWithOptions.
+func SetSpellCheckOptions(_spellCheck bool, _spellLocale string, ignoreWords []string) {
+	spellCheck = _spellCheck
+	spellLocale = _spellLocale
+	spellIgnoreWords = strings.Join(ignoreWords, ",")
+}
+
+// SetPackageCommentOptions sets the options for the substance of package comments that
+// would have been defined via flags if this was ran as a vet tool, see
+// NewAnalyzerWithOptions.
+func SetPackageCommentOptions(_minPackageSentences int, _requirePackageUsage bool) {
+	minPackageSentences = _minPackageSentences
+	requirePackageUsage = _requirePackageUsage
+}
+
+// SetLineWidthOptions sets the options of the line width check that would have been defined
+// via flags if this was ran as a vet tool, see NewAnalyzerWithOptions. A maximum width of
+// zero uses DefaultMaxLineWidth.
+func SetLineWidthOptions(_lineWidth bool, _maxLineWidth int) {
+	lineWidth = _lineWidth
+	maxLineWidth = _maxLineWidth
+}
+
+// SetHeaderDescriptionOptions sets whether or not the Description header fields of files
+// are checked against each other and the package comment, which would have been defined via
+// flags if this was ran as a vet tool, see NewAnalyzerWithOptions.
+func SetHeaderDescriptionOptions(_validateHeaderDescriptions bool) {
+	validateHeaderDescriptions = _validateHeaderDescriptions
+}
+
+// SetUnreferencedExportOptions sets whether or not exported identifiers of command packages
+// that aren't referenced outside of their package are reported, which would have been
+// defined via flags if this was ran as a vet tool, see NewAnalyzerWithOptions.
+func SetUnreferencedExportOptions(_unreferencedExports bool) {
+	unreferencedExports = _unreferencedExports
+}
+
+// SetEnumExceptionOptions sets how the exception to the commenting rule for enum-like
+// constant blocks applies, one of EnumExceptions, which would have been defined via flags if
+// this was ran as a vet tool, see NewAnalyzerWithOptions. An empty mode uses
+// EnumExceptionLenient.
+func SetEnumExceptionOptions(mode string) {
+	enumException = mode
+}
+
+// SetTypeOptions sets whether or not type aliases are exempt from having a comment and
+// whether or not fields embedding exported types in exported structs are required to have
+// one, which would have been defined via flags if this was ran as a vet tool, see
+// NewAnalyzerWithOptions.
+func SetTypeOptions(_skipAliases, _validateEmbedded bool) {
+	skipAliases = _skipAliases
+	validateEmbedded = _validateEmbedded
+}
+
+// SetTrailingCommentOptions sets whether or not the line comment trailing a spec of a const
+// or var block, e.g. Foo = 1 // Foo is ..., is accepted as its comment when it has no doc
+// comment, which would have been defined via flags if this was ran as a vet tool, see
+// NewAnalyzerWithOptions.
+func SetTrailingCommentOptions(_acceptTrailingComments bool) {
+	acceptTrailingComments = _acceptTrailingComments
+}
+
+// SetDirectivePlacementOptions sets whether or not directives meant for tools, e.g.
+// //go:generate, are reported within doc comments, which would have been defined via flags
+// if this was ran as a vet tool, see NewAnalyzerWithOptions.
+func SetDirectivePlacementOptions(_validateDirectives bool) {
+	validateDirectives = _validateDirectives
+}
+
+// SetDocFileOptions sets the globs of the import paths of the library packages that must
+// have a doc.go file with an extended package comment, and the globs of those exempt from
+// it, which would have been defined via flags if this was ran as a vet tool, see
+// NewAnalyzerWithOptions. No paths disables the check.
+func SetDocFileOptions(paths, exceptions []string) {
+	docFilePaths = strings.Join(paths, ",")
+	docFileExceptions = strings.Join(exceptions, ",")
+}
+
 // Variable block to keep track of flags whose values are collected at runtime. See the
 // init function that immediately proceeds this block to see more.
 var (
@@ -81,6 +167,90 @@ var (
 	// flag that denotes whether or not the linter should validate that types have
 	// satisfactory comments.
 	validateTypes bool
+
+	// validateDocNames is a variable that gets collected via flags. This variable contains a
+	// flag that denotes whether or not the linter should validate that doc comments don't
+	// begin with an identifier other than the one they document.
+	validateDocNames bool
+
+	// spellCheck is a variable that gets collected via flags. This variable contains a flag
+	// that denotes whether or not the linter should report misspellings in doc comments.
+	spellCheck bool
+
+	// spellLocale is a variable that gets collected via flags. This variable contains the
+	// locale, LocaleUS or LocaleGB, whose spellings are preferred by the spell check. When
+	// empty, only misspellings are reported.
+	spellLocale string
+
+	// spellIgnoreWords is a variable that gets collected via flags. This variable contains a
+	// comma-separated list of words that the spell check never reports.
+	spellIgnoreWords string
+
+	// minPackageSentences is a variable that gets collected via flags. This variable contains
+	// the minimum number of sentences a package comment must contain, zero meaning any.
+	minPackageSentences int
+
+	// requirePackageUsage is a variable that gets collected via flags. This variable contains
+	// a flag that denotes whether or not the package comments of library packages must
+	// contain a usage or example section.
+	requirePackageUsage bool
+
+	// lineWidth is a variable that gets collected via flags. This variable contains a flag
+	// that denotes whether or not the linter should report doc comments with lines wider
+	// than maxLineWidth.
+	lineWidth bool
+
+	// maxLineWidth is a variable that gets collected via flags. This variable contains the
+	// maximum width of a doc comment line when lineWidth is set.
+	maxLineWidth int
+
+	// validateHeaderDescriptions is a variable that gets collected via flags. This variable
+	// contains a flag that denotes whether or not the linter should report Description
+	// header fields that refer to a missing package comment or are copies of each other.
+	validateHeaderDescriptions bool
+
+	// unreferencedExports is a variable that gets collected via flags. This variable contains
+	// a flag that denotes whether or not the linter should report exported identifiers of
+	// command packages that aren't referenced outside of their package.
+	unreferencedExports bool
+
+	// enumException is a variable that gets collected via flags. This variable contains how
+	// the exception to the commenting rule for enum-like constant blocks applies, one of
+	// EnumExceptions.
+	enumException string
+
+	// skipAliases is a variable that gets collected via flags. This variable contains a flag
+	// that denotes whether or not type aliases are exempt from having a comment.
+	skipAliases bool
+
+	// validateEmbedded is a variable that gets collected via flags. This variable contains a
+	// flag that denotes whether or not fields embedding exported types in exported structs
+	// must have a comment.
+	validateEmbedded bool
+
+	// acceptTrailingComments is a variable that gets collected via flags. This variable
+	// contains a flag that denotes whether or not the line comment trailing a spec of a const
+	// or var block is accepted as its comment when it has no doc comment.
+	acceptTrailingComments bool
+
+	// validateDirectives is a variable that gets collected via flags. This variable contains
+	// a flag that denotes whether or not the linter should report directives meant for tools,
+	// e.g. //go:generate, within doc comments.
+	validateDirectives bool
+
+	// docFilePaths is a variable that gets collected via flags. This variable contains a
+	// comma-separated list of globs of the import paths of the library packages that must
+	// have a doc.go file with an extended package comment.
+	docFilePaths string
+
+	// docFileExceptions is a variable that gets collected via flags. This variable contains
+	// a comma-separated list of globs of the import paths of the packages exempt from having
+	// a doc.go file.
+	docFileExceptions string
+
+	// disabledRules is a variable that gets collected via flags. This variable contains a
+	// comma-separated list of the IDs of rules, see Rules, that are never reported.
+	disabledRules string
 )
 
 func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
@@ -96,6 +266,52 @@ func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
 		&validateConstants, "validateConstants", true, "a boolean flag that denotes whether or not to validate constant comments")
 	Analyzer.Flags.BoolVar(
 		&validateTypes, "validateTypes", true, "a boolean flag that denotes whether or not to validate type comments")
+	Analyzer.Flags.BoolVar(
+		&validateDocNames, "validateDocNames", false,
+		"a boolean flag that denotes whether or not to validate that doc comments begin with an identifier they document")
+	Analyzer.Flags.BoolVar(
+		&spellCheck, "spellCheck", false, "a boolean flag that denotes whether or not to report misspellings in doc comments")
+	Analyzer.Flags.StringVar(
+		&spellLocale, "spellLocale", "", "the locale, en-US or en-GB, whose spellings are preferred by the spell check")
+	Analyzer.Flags.StringVar(
+		&spellIgnoreWords, "spellIgnoreWords", "", "comma-separated list of words that the spell check never reports")
+	Analyzer.Flags.IntVar(
+		&minPackageSentences, "minPackageSentences", 0, "the minimum number of sentences a package comment must contain")
+	Analyzer.Flags.BoolVar(
+		&requirePackageUsage, "requirePackageUsage", false,
+		"a boolean flag that denotes whether or not package comments of library packages must contain a usage or example section")
+	Analyzer.Flags.BoolVar(
+		&lineWidth, "lineWidth", false, "a boolean flag that denotes whether or not to report doc comments with lines wider than maxLineWidth")
+	Analyzer.Flags.IntVar(
+		&maxLineWidth, "maxLineWidth", DefaultMaxLineWidth, "the maximum width of a doc comment line when lineWidth is set")
+	Analyzer.Flags.BoolVar(
+		&validateHeaderDescriptions, "validateHeaderDescriptions", false,
+		"a boolean flag that denotes whether or not to report header descriptions that refer to a missing package comment or are copies")
+	Analyzer.Flags.BoolVar(
+		&unreferencedExports, "unreferencedExports", false,
+		"a boolean flag that denotes whether or not to report exported identifiers of command packages never referenced outside of them")
+	Analyzer.Flags.StringVar(
+		&enumException, "enumException", EnumExceptionLenient,
+		"how the exception to the commenting rule for enum-like constant blocks applies, one of: "+strings.Join(EnumExceptions, ", "))
+	Analyzer.Flags.BoolVar(
+		&skipAliases, "skipAliases", false, "a boolean flag that denotes whether or not type aliases are exempt from having a comment")
+	Analyzer.Flags.BoolVar(
+		&validateEmbedded, "validateEmbedded", false,
+		"a boolean flag that denotes whether or not fields embedding exported types in exported structs must have a comment")
+	Analyzer.Flags.BoolVar(
+		&acceptTrailingComments, "acceptTrailingComments", false,
+		"a boolean flag that denotes whether or not trailing line comments are accepted as the comments of specs in const and var blocks")
+	Analyzer.Flags.BoolVar(
+		&validateDirectives, "validateDirectives", false,
+		"a boolean flag that denotes whether or not to report directives meant for tools, e.g. //go:generate, within doc comments")
+	Analyzer.Flags.StringVar(
+		&docFilePaths, "docFilePaths", "",
+		"comma-separated list of globs of the import paths of the library packages that must have a doc.go file")
+	Analyzer.Flags.StringVar(
+		&docFileExceptions, "docFileExceptions", "",
+		"comma-separated list of globs of the import paths of the packages exempt from having a doc.go file")
+	Analyzer.Flags.StringVar(
+		&disabledRules, "disabledRules", "", "comma-separated list of the IDs of rules that are never reported")
 
 	if minFunLen == 0 {
 		minFunLen = 10
@@ -106,7 +322,7 @@ func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
 // analysis for the doculint linter on a set of files.
 func doculint(_pass *analysis.Pass) (interface{}, error) { //nolint:funlen // Why: Doesn't make sense to break this function up anymore.
 	// Ignore test packages.
-	if common.IsTestPackage(_pass) {
+	if common.SkipPackage(_pass) {
 		return nil, nil
 	}
 
@@ -121,14 +337,23 @@ func doculint(_pass *analysis.Pass) (interface{}, error) { //nolint:funlen // Wh
 	// This will bypass the package comment reporting.
 	allGenerated := true
 
+	var spell *speller
+	if spellCheck && !ruleDisabled(RuleSpelling) {
+		spell = newSpeller(spellLocale, strings.Split(spellIgnoreWords, ","))
+	}
+
+	// linted are the files of the package that aren't skipped.
+	var linted []*ast.File
+
 	for _, file := range pass.Files {
 		// Pull file into a local variable so it can be passed as a parameter safely.
 		file := file
 
-		// Ignore generated files and test files.
-		if common.IsGenerated(file) || common.IsTestFile(pass.Pass, file) {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
 			continue
 		}
+		linted = append(linted, file)
 
 		// We've made it past the generated check, make sure to denote that at least one file in the
 		// package was not generated.
@@ -155,16 +380,18 @@ func doculint(_pass *analysis.Pass) (interface{}, error) { //nolint:funlen // Wh
 				validatePackageName(pass, file.Package, pass.Pkg.Name())
 
 				if file.Doc == nil {
-					pass.Reportf(
+					reportRule(pass, RuleMissingComment,
 						file.Package,
 						"package \"%s\" has no comment associated with it in \"%s.go\"", pass.Pkg.Name(), pass.Pkg.Name())
 				} else {
 					expectedPrefix := fmt.Sprintf("Package %s", pass.Pkg.Name())
 					if !strings.HasPrefix(strings.TrimSpace(file.Doc.Text()), expectedPrefix) {
-						pass.Reportf(
+						reportRule(pass, RuleCommentPrefix,
 							file.Package,
 							"comment for package \"%s\" should begin with \"%s\"", pass.Pkg.Name(), expectedPrefix)
 					}
+
+					validatePackageComment(pass, file.Package, pass.Pkg.Name(), isLibraryPackage(pass.Pkg.Path()), file.Doc.Text())
 				}
 			}
 		}
@@ -230,14 +457,56 @@ func doculint(_pass *analysis.Pass) (interface{}, error) { //nolint:funlen // Wh
 
 			return true
 		})
+
+		if validateDocNames && !ruleDisabled(RuleDocName) {
+			checkDocNames(pass, pass.Pkg.Scope(), file)
+		}
+
+		if validateDirectives && !ruleDisabled(RuleDirectivePlacement) {
+			for _, doc := range docComments(file) {
+				checkDirectivePlacement(pass, pass.Fset, doc)
+			}
+		}
+
+		checkWidth := lineWidth && !ruleDisabled(RuleLineWidth)
+		if spell != nil || checkWidth {
+			width := maxLineWidth
+			if width <= 0 {
+				width = DefaultMaxLineWidth
+			}
+
+			for _, doc := range docComments(file) {
+				if spell != nil {
+					spell.check(pass, doc)
+				}
+				if checkWidth {
+					checkLineWidth(pass, pass.Fset, doc, width)
+				}
+			}
+		}
 	}
 
 	if !allGenerated {
 		if !packageHasFileWithSameName {
-			pass.Reportf(0, "package \"%s\" has no file with the same name containing package comment", pass.Pkg.Name())
+			reportRule(pass, RulePackageFile, 0, "package \"%s\" has no file with the same name containing package comment", pass.Pkg.Name())
 		}
 	}
 
+	if pass.Pkg.Name() != common.PackageMain && !ruleDisabled(RulePackageDocFile) &&
+		requiresDocFile(pass.Pkg.Path(), docFilePaths, docFileExceptions) {
+		checkDocFile(pass, pass.Fset, pass.Pkg.Name(), linted)
+	}
+
+	if validateHeaderDescriptions && !ruleDisabled(RuleHeaderDescription) {
+		metadata, _ := pass.ResultOf[&header.MetadataAnalyzer].(header.Metadata)
+		checkHeaderDescriptions(pass, pass.Fset, pass.Pkg.Name(), linted, metadata)
+	}
+
+	if unreferencedExports && !ruleDisabled(RuleUnreferencedExport) && len(linted) > 0 {
+		dir := filepath.Dir(pass.Fset.PositionFor(linted[0].Package, false).Filename)
+		checkUnreferencedExports(pass, pass.Pkg.Name(), pass.Pkg.Path(), dir, linted)
+	}
+
 	return nil, nil
 }
 
@@ -293,8 +562,13 @@ func validateGenDeclConstants(r reporter.Reporter, expr *ast.GenDecl, stack []as
 			//     ValC MyEnum = iota
 			// )
 
-			// See if it's enum-like -- are we at the top level of the doc?
-			if len(stack) == 2 {
+			// enumType is the type of the block when it is enum-like but the enumException flag
+			// doesn't exempt it, which the issue points at as well as the block.
+			var enumType *ast.TypeSpec
+
+			// See if it's enum-like -- are we at the top level of the doc? The exception can be
+			// disabled, or made stricter, with the enumException flag.
+			if len(stack) == 2 && enumException != EnumExceptionDisabled {
 				if parent, worked := stack[0].(*ast.File); worked {
 					// Okay, now find our previous sibling to see if it's a type declaration
 					for i, decl := range parent.Decls {
@@ -343,18 +617,30 @@ func validateGenDeclConstants(r reporter.Reporter, expr *ast.GenDecl, stack []as
 							}
 						}
 
-						if !foundInvalid {
+						if !foundInvalid && enumExceptionMet(expr) {
 							// All members of the const block were using the same type as the element above it.  Call it an enum,
 							// which doesn't need a comment, and move on!
 							return
 						}
 
+						if !foundInvalid {
+							enumType = typeSpec
+						}
+
 						break
 					}
 				}
 			}
 
-			r.Reportf(expr.Pos(), "constant block has no comment associated with it")
+			if enumType != nil {
+				reportRelated(r, RuleMissingComment, expr.Pos(), []analysis.RelatedInformation{{
+					Pos: enumType.Pos(),
+					Message: fmt.Sprintf("enum type \"%s\" of the constant block, which enumException \"%s\" doesn't exempt",
+						enumType.Name.Name, enumException),
+				}}, "constant block has no comment associated with it")
+			} else {
+				reportRule(r, RuleMissingComment, expr.Pos(), "constant block has no comment associated with it")
+			}
 		}
 	}
 
@@ -367,25 +653,26 @@ func validateGenDeclConstants(r reporter.Reporter, expr *ast.GenDecl, stack []as
 					names = append(names, fmt.Sprintf("%q", vs.Names[j].Name))
 				}
 
-				r.Reportf(vs.Pos(), "constants %s should be separated and each have a comment associated with them", strings.Join(names, ", "))
+				reportRule(r, RuleSeparateDeclarations, vs.Pos(), "constants %s should be separated and each have a comment associated with them",
+					strings.Join(names, ", "))
 				continue
 			}
 
 			name := vs.Names[0].Name
 
-			doc := vs.Doc
+			doc := valueSpecDoc(vs)
 			if !expr.Lparen.IsValid() {
 				// If this constant isn't apart of a constant block it's comment is stored in the *ast.GenDecl type.
 				doc = expr.Doc
 			}
 
 			if doc == nil {
-				r.Reportf(vs.Pos(), "constant \"%s\" has no comment associated with it", name)
+				reportRule(r, RuleMissingComment, vs.Pos(), "constant \"%s\" has no comment associated with it", name)
 				continue
 			}
 
 			if !strings.HasPrefix(strings.TrimSpace(doc.Text()), name) {
-				r.Reportf(vs.Pos(), "comment for constant \"%s\" should begin with \"%s\"", name, name)
+				reportRule(r, RuleCommentPrefix, vs.Pos(), "comment for constant \"%s\" should begin with \"%s\"", name, name)
 			}
 		}
 	}
@@ -399,7 +686,7 @@ func validateGenDeclTypes(r reporter.Reporter, expr *ast.GenDecl) {
 	if expr.Lparen.IsValid() {
 		// Type block
 		if expr.Doc == nil {
-			r.Reportf(expr.Pos(), "type block has no comment associated with it")
+			reportRule(r, RuleMissingComment, expr.Pos(), "type block has no comment associated with it")
 		}
 	}
 
@@ -412,13 +699,22 @@ func validateGenDeclTypes(r reporter.Reporter, expr *ast.GenDecl) {
 				doc = expr.Doc
 			}
 
+			if ts.Assign.IsValid() {
+				validateAlias(r, ts, doc)
+				continue
+			}
+
+			if validateEmbedded {
+				checkEmbeddedFields(r, ts)
+			}
+
 			if doc == nil {
-				r.Reportf(ts.Pos(), "type \"%s\" has no comment associated with it", ts.Name.Name)
+				reportRule(r, RuleMissingComment, ts.Pos(), "type \"%s\" has no comment associated with it", ts.Name.Name)
 				continue
 			}
 
 			if !strings.HasPrefix(strings.TrimSpace(doc.Text()), ts.Name.Name) {
-				r.Reportf(ts.Pos(), "comment for type \"%s\" should begin with \"%s\"", ts.Name.Name, ts.Name.Name)
+				reportRule(r, RuleCommentPrefix, ts.Pos(), "comment for type \"%s\" should begin with \"%s\"", ts.Name.Name, ts.Name.Name)
 			}
 		}
 	}
@@ -432,7 +728,7 @@ func validateGenDeclVariables(r reporter.Reporter, expr *ast.GenDecl) {
 	if expr.Lparen.IsValid() {
 		// Variable block
 		if expr.Doc == nil {
-			r.Reportf(expr.Pos(), "variable block has no comment associated with it")
+			reportRule(r, RuleMissingComment, expr.Pos(), "variable block has no comment associated with it")
 		}
 	}
 
@@ -445,7 +741,8 @@ func validateGenDeclVariables(r reporter.Reporter, expr *ast.GenDecl) {
 					names = append(names, fmt.Sprintf("%q", vs.Names[j].Name))
 				}
 
-				r.Reportf(vs.Pos(), "variables %s should be separated and each have a comment associated with them", strings.Join(names, ", "))
+				reportRule(r, RuleSeparateDeclarations, vs.Pos(), "variables %s should be separated and each have a comment associated with them",
+					strings.Join(names, ", "))
 				continue
 			}
 
@@ -454,24 +751,35 @@ func validateGenDeclVariables(r reporter.Reporter, expr *ast.GenDecl) {
 				continue // skip underscore variables.
 			}
 
-			doc := vs.Doc
+			doc := valueSpecDoc(vs)
 			if !expr.Lparen.IsValid() {
 				// If this variable isn't apart of a variable block it's comment is stored in the *ast.GenDecl type.
 				doc = expr.Doc
 			}
 
 			if doc == nil {
-				r.Reportf(vs.Pos(), "variable %q has no comment associated with it", name)
+				reportRule(r, RuleMissingComment, vs.Pos(), "variable %q has no comment associated with it", name)
 				continue
 			}
 
 			if !strings.HasPrefix(strings.TrimSpace(doc.Text()), name) {
-				r.Reportf(vs.Pos(), "comment for variable \"%s\" should begin with \"%s\"", name, name)
+				reportRule(r, RuleCommentPrefix, vs.Pos(), "comment for variable \"%s\" should begin with \"%s\"", name, name)
 			}
 		}
 	}
 }
 
+// valueSpecDoc returns the comment of the given spec of a const or var block, which is its
+// doc comment, or the line comment trailing it if it has none and acceptTrailingComments is
+// set, e.g. Foo = 1 // Foo is ...
+func valueSpecDoc(vs *ast.ValueSpec) *ast.CommentGroup {
+	if vs.Doc == nil && acceptTrailingComments {
+		return vs.Comment
+	}
+
+	return vs.Doc
+}
+
 // validateFuncDecl ensures that an *ast.FuncDecl upholds doculint standards by ensuring
 // it has a corresponding comment that starts with the name of the function.
 func validateFuncDecl(r reporter.Reporter, expr *ast.FuncDecl) {
@@ -481,13 +789,14 @@ func validateFuncDecl(r reporter.Reporter, expr *ast.FuncDecl) {
 	}
 
 	if expr.Doc == nil {
-		r.Reportf(expr.Pos(), "function \"%s\" has no comment associated with it", expr.Name.Name)
+		reportRule(r, RuleMissingComment, expr.Pos(), "function \"%s\" has no comment associated with it", expr.Name.Name)
 		return
 	}
 
 	// Enforce a space after the function name.
 	if !strings.HasPrefix(strings.TrimSpace(expr.Doc.Text()), expr.Name.Name+" ") {
-		r.Reportf(expr.Pos(), "comment for function \"%s\" should be a sentence that starts with \"%s \"", expr.Name.Name, expr.Name.Name)
+		reportRule(r, RuleCommentPrefix, expr.Pos(), "comment for function \"%s\" should be a sentence that starts with \"%s \"",
+			expr.Name.Name, expr.Name.Name)
 	}
 }
 
@@ -495,10 +804,84 @@ func validateFuncDecl(r reporter.Reporter, expr *ast.FuncDecl) {
 // be read about here: https://blog.golang.org/package-names
 func validatePackageName(r reporter.Reporter, pos token.Pos, pkg string) {
 	if strings.ContainsAny(pkg, "_-") {
-		r.Reportf(pos, "package \"%s\" should not contain - or _ in name", pkg)
+		reportRule(r, RulePackageName, pos, "package \"%s\" should not contain - or _ in name", pkg)
 	}
 
 	if pkg != strings.ToLower(pkg) {
-		r.Reportf(pos, "package \"%s\" should be all lowercase", pkg)
+		reportRule(r, RulePackageName, pos, "package \"%s\" should be all lowercase", pkg)
+	}
+}
+
+// reSentenceEnd matches the end of a sentence.
+var reSentenceEnd = regexp.MustCompile(`[.!?](\s|$)`)
+
+// reUsageHeading matches a heading, in either the "# Heading" or the older single line
+// form, that introduces a usage or example section.
+var reUsageHeading = regexp.MustCompile(`(?m)^(# )?(Usage|Examples?)( .*)?$`)
+
+// isLibraryPackage reports whether or not the package with the given import path is a
+// library package, one that is meant to be imported outside of the module it belongs to.
+func isLibraryPackage(pkgPath string) bool {
+	for _, segment := range strings.Split(pkgPath, "/") {
+		if segment == "internal" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validatePackageComment ensures that the given package comment text has substance, that
+// is it contains at least minPackageSentences sentences and, if it is for a library
+// package and requirePackageUsage is set, a usage or example section.
+func validatePackageComment(r reporter.Reporter, pos token.Pos, pkg string, library bool, text string) {
+	if minPackageSentences > 0 {
+		if n := countSentences(text); n < minPackageSentences {
+			reportRule(r, RulePackageSentences, pos, "comment for package \"%s\" should contain at least %d sentences, found %d",
+				pkg, minPackageSentences, n)
+		}
+	}
+
+	if requirePackageUsage && library && pkg != common.PackageMain && !hasUsageSection(text) {
+		reportRule(r, RulePackageUsage, pos, "comment for package \"%s\" should contain a usage or example section", pkg)
+	}
+}
+
+// countSentences returns the number of sentences in the prose of the given comment text,
+// ignoring code blocks and headings.
+func countSentences(text string) int {
+	var prose []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "# ") {
+			continue
+		}
+		prose = append(prose, line)
 	}
+
+	// Common abbreviations shouldn't end a sentence.
+	joined := strings.NewReplacer("e.g.", "eg", "i.e.", "ie", "etc.", "etc").Replace(strings.Join(prose, " "))
+
+	n := len(reSentenceEnd.FindAllString(joined, -1))
+	if trimmed := strings.TrimSpace(joined); trimmed != "" && !reSentenceEnd.MatchString(trimmed[len(trimmed)-1:]) {
+		// The last sentence is missing its punctuation.
+		n++
+	}
+
+	return n
+}
+
+// hasUsageSection reports whether or not the given comment text contains a usage or
+// example section, which is either a heading introducing one or an indented code block.
+func hasUsageSection(text string) bool {
+	if reUsageHeading.MatchString(text) {
+		return true
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "  ") {
+			return true
+		}
+	}
+
+	return false
 }
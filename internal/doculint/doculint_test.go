@@ -4,9 +4,11 @@ package doculint
 
 import (
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"testing"
 
+	"github.com/getoutreach/lintroller/internal/linttest"
 	"gotest.tools/v3/assert"
 )
 
@@ -79,3 +81,139 @@ func TestValidateFunDecl(t *testing.T) {
 		})
 	}
 }
+
+func TestCountSentences(t *testing.T) {
+	tt := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{
+			name:     "Counts a single sentence",
+			text:     "Package foo contains foo.\n",
+			expected: 1,
+		},
+		{
+			name:     "Counts sentences across lines",
+			text:     "Package foo contains foo. It does things,\ne.g. bar and baz! Why?\n",
+			expected: 3,
+		},
+		{
+			name:     "Counts a sentence missing its punctuation",
+			text:     "Package foo contains foo. It does things\n",
+			expected: 2,
+		},
+		{
+			name:     "Ignores code blocks and headings",
+			text:     "Package foo contains foo.\n\n# Usage\n\n\tfoo.Bar(). Baz()\n",
+			expected: 1,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, countSentences(test.text), test.expected)
+		})
+	}
+}
+
+func TestHasUsageSection(t *testing.T) {
+	tt := []struct {
+		name     string
+		text     string
+		expected bool
+	}{
+		{
+			name:     "Finds a usage heading",
+			text:     "Package foo contains foo.\n\n# Usage\n\nCall Foo.\n",
+			expected: true,
+		},
+		{
+			name:     "Finds an old-style examples heading",
+			text:     "Package foo contains foo.\n\nExamples\n\nCall Foo.\n",
+			expected: true,
+		},
+		{
+			name:     "Finds a code block",
+			text:     "Package foo contains foo.\n\n\tfoo.Foo()\n",
+			expected: true,
+		},
+		{
+			name:     "Requires a section",
+			text:     "Package foo contains foo. Usage is simple.\n",
+			expected: false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, hasUsageSection(test.text), test.expected)
+		})
+	}
+}
+
+func TestTrailingComments(t *testing.T) {
+	const src = `package foo
+
+// Block is a block.
+const (
+	// Foo is documented above.
+	Foo = 1
+	Bar = 2 // Bar is documented after.
+	Baz = 3 // the baz.
+	Qux = 4
+)
+
+// Vars is a block.
+var (
+	quux = 5 // quux is documented after.
+)
+
+var corge = 6 // corge is documented after, outside of a block.
+`
+
+	tt := []struct {
+		name                   string
+		acceptTrailingComments bool
+		expected               []string
+	}{
+		{
+			name: "Requires comments above specs by default",
+			expected: []string{
+				`constant "Bar" has no comment associated with it`,
+				`constant "Baz" has no comment associated with it`,
+				`constant "Qux" has no comment associated with it`,
+				`variable "quux" has no comment associated with it`,
+				`variable "corge" has no comment associated with it`,
+			},
+		},
+		{
+			name:                   "Accepts trailing comments of specs in blocks",
+			acceptTrailingComments: true,
+			expected: []string{
+				`comment for constant "Baz" should begin with "Baz"`,
+				`constant "Qux" has no comment associated with it`,
+				`variable "corge" has no comment associated with it`,
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			validateConstants, validateVariables = true, true
+			SetTrailingCommentOptions(test.acceptTrailingComments)
+			t.Cleanup(func() { SetTrailingCommentOptions(false) })
+
+			file, err := parser.ParseFile(token.NewFileSet(), "foo.go", src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			for _, decl := range file.Decls {
+				if genDecl, ok := decl.(*ast.GenDecl); ok {
+					validateGenDecl(&r, genDecl, nil)
+				}
+			}
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file defines how strictly the exception to the commenting rule for
+// enum-like constant blocks applies.
+
+package doculint
+
+import "go/ast"
+
+// Modes of the exception to the commenting rule for enum-like constant blocks, which exempts
+// a block of constants typed with the type declared immediately above it, and each of its
+// constants, from having a comment.
+const (
+	// EnumExceptionLenient exempts every enum-like constant block.
+	EnumExceptionLenient = "lenient"
+
+	// EnumExceptionExported only exempts enum-like constant blocks whose constants are all
+	// exported.
+	EnumExceptionExported = "exported"
+
+	// EnumExceptionFirstComment only exempts enum-like constant blocks whose first constant
+	// has a comment, which usually describes the values of the enum as a whole.
+	EnumExceptionFirstComment = "firstComment"
+
+	// EnumExceptionDisabled never exempts constant blocks, enum-like or not.
+	EnumExceptionDisabled = "disabled"
+)
+
+// EnumExceptions contains every mode of the exception to the commenting rule for enum-like
+// constant blocks.
+var EnumExceptions = []string{
+	EnumExceptionLenient,
+	EnumExceptionExported,
+	EnumExceptionFirstComment,
+	EnumExceptionDisabled,
+}
+
+// enumExceptionMet reports whether or not the given enum-like constant block meets the
+// requirements of the configured mode of the enum exception to be exempt from comments.
+func enumExceptionMet(expr *ast.GenDecl) bool {
+	switch enumException {
+	case EnumExceptionExported:
+		for _, spec := range expr.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				return false
+			}
+
+			for _, name := range vs.Names {
+				if !name.IsExported() {
+					return false
+				}
+			}
+		}
+
+		return true
+	case EnumExceptionFirstComment:
+		if len(expr.Specs) == 0 {
+			return false
+		}
+
+		vs, ok := expr.Specs[0].(*ast.ValueSpec)
+		return ok && (vs.Doc != nil || vs.Comment != nil)
+	case EnumExceptionDisabled:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestEnumException(t *testing.T) {
+	const exported = `package foo
+
+// Color is a color.
+type Color int
+
+const (
+	Red Color = iota
+	Green Color = 1
+)
+`
+
+	const unexported = `package foo
+
+// color is a color.
+type color int
+
+const (
+	// red is the first color.
+	red color = iota
+	green color = 1
+)
+`
+
+	tt := []struct {
+		name     string
+		mode     string
+		src      string
+		expected []string
+		related  []string
+	}{
+		{
+			name: "Exempts enum-like blocks by default",
+			mode: EnumExceptionLenient,
+			src:  exported,
+		},
+		{
+			name: "Exempts blocks of exported constants",
+			mode: EnumExceptionExported,
+			src:  exported,
+		},
+		{
+			name: "Reports blocks of unexported constants",
+			mode: EnumExceptionExported,
+			src:  unexported,
+			expected: []string{
+				"constant block has no comment associated with it",
+				`constant "green" has no comment associated with it`,
+			},
+			related: []string{`enum type "color" of the constant block, which enumException "exported" doesn't exempt`},
+		},
+		{
+			name: "Exempts blocks whose first constant has a comment",
+			mode: EnumExceptionFirstComment,
+			src:  unexported,
+		},
+		{
+			name: "Reports blocks whose first constant has no comment",
+			mode: EnumExceptionFirstComment,
+			src:  exported,
+			expected: []string{
+				"constant block has no comment associated with it",
+				`constant "Red" has no comment associated with it`,
+				`constant "Green" has no comment associated with it`,
+			},
+			related: []string{`enum type "Color" of the constant block, which enumException "firstComment" doesn't exempt`},
+		},
+		{
+			name: "Reports every block when disabled",
+			mode: EnumExceptionDisabled,
+			src:  unexported,
+			expected: []string{
+				"constant block has no comment associated with it",
+				`constant "green" has no comment associated with it`,
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetEnumExceptionOptions(test.mode)
+			t.Cleanup(func() { SetEnumExceptionOptions(EnumExceptionLenient) })
+
+			file, err := parser.ParseFile(token.NewFileSet(), "foo.go", test.src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			for _, decl := range file.Decls {
+				if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.CONST {
+					validateGenDeclConstants(&r, genDecl, []ast.Node{file, genDecl})
+				}
+			}
+			assert.DeepEqual(t, r.Messages, test.expected)
+			assert.DeepEqual(t, r.Related, test.related)
+		})
+	}
+}
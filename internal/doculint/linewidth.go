@@ -0,0 +1,156 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the opt-in doc comment line width check, which reports
+// doc comments with lines wider than the maximum along with a fix that rewraps them.
+
+package doculint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// DefaultMaxLineWidth is the maximum width of a doc comment line used when the line width
+// check is enabled without a maximum.
+const DefaultMaxLineWidth = 100
+
+// commentLine is a single "//" line of a doc comment.
+type commentLine struct {
+	// text is the text of the line without the leading "//".
+	text string
+
+	// width is the width of the line, including its indentation.
+	width int
+}
+
+// prose reports whether or not the line is part of a paragraph of prose that can be
+// rewrapped, as opposed to a blank line, a code block, a list item, or a directive.
+func (l *commentLine) prose() bool {
+	if !strings.HasPrefix(l.text, " ") || strings.HasPrefix(l.text, "  ") {
+		// Blank lines, directives (e.g. "//go:generate"), and indented code blocks.
+		return false
+	}
+
+	text := strings.TrimPrefix(l.text, " ")
+	if text == "" || strings.HasPrefix(text, "- ") || strings.HasPrefix(text, "* ") || strings.HasPrefix(text, "+ ") {
+		return false
+	}
+
+	// Numbered list items, e.g. "1. ".
+	if i := strings.Index(text, ". "); i > 0 && strings.Trim(text[:i], "0123456789") == "" {
+		return false
+	}
+
+	return true
+}
+
+// checkLineWidth reports the given doc comment if any line of prose within it is wider than
+// maxWidth, along with a fix that rewraps the paragraphs containing those lines.
+func checkLineWidth(r interface{ Report(analysis.Diagnostic) }, fset *token.FileSet, doc *ast.CommentGroup, maxWidth int) {
+	if doc == nil || len(doc.List) == 0 {
+		return
+	}
+
+	column := fset.PositionFor(doc.List[0].Slash, false).Column
+	indent := strings.Repeat("\t", column-1)
+
+	lines := make([]commentLine, 0, len(doc.List))
+	for _, c := range doc.List {
+		if !strings.HasPrefix(c.Text, "//") {
+			// Block comments are left alone, rewrapping them is rarely what's wanted.
+			return
+		}
+
+		lines = append(lines, commentLine{
+			text:  strings.TrimPrefix(c.Text, "//"),
+			width: column - 1 + utf8.RuneCountInString(c.Text),
+		})
+	}
+
+	var wrapped []string
+	var first token.Pos
+	for i := 0; i < len(lines); {
+		if !lines[i].prose() {
+			wrapped = append(wrapped, "//"+lines[i].text)
+			i++
+			continue
+		}
+
+		// Gather the paragraph this line starts.
+		j := i
+		tooWide := -1
+		for j < len(lines) && lines[j].prose() {
+			if lines[j].width > maxWidth && tooWide < 0 {
+				tooWide = j
+			}
+			j++
+		}
+
+		paragraph := make([]string, 0, j-i)
+		for k := i; k < j; k++ {
+			paragraph = append(paragraph, "//"+lines[k].text)
+		}
+
+		if tooWide >= 0 {
+			rewrapped := rewrap(lines[i:j], maxWidth-(column-1))
+			if strings.Join(rewrapped, "\n") != strings.Join(paragraph, "\n") && !first.IsValid() {
+				first = doc.List[tooWide].Slash
+			}
+			paragraph = rewrapped
+		}
+
+		wrapped = append(wrapped, paragraph...)
+		i = j
+	}
+
+	if !first.IsValid() {
+		// Either nothing is too wide or the lines that are can't be wrapped any further, e.g.
+		// a long URL.
+		return
+	}
+
+	r.Report(analysis.Diagnostic{
+		Pos:      first,
+		End:      doc.End(),
+		Category: RuleLineWidth,
+		Message:  fmt.Sprintf("doc comment has lines wider than %d characters", maxWidth),
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf("Rewrap doc comment to %d characters", maxWidth),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     doc.Pos(),
+						End:     doc.End(),
+						NewText: []byte(strings.Join(wrapped, "\n"+indent)),
+					},
+				},
+			},
+		},
+	})
+}
+
+// rewrap greedily wraps the words of the given paragraph into "// " lines no wider than
+// width. Words wider than width on their own are given a line of their own.
+func rewrap(paragraph []commentLine, width int) []string {
+	var words []string
+	for i := range paragraph {
+		words = append(words, strings.Fields(paragraph[i].text)...)
+	}
+
+	var lines []string
+	line := "//"
+	for _, w := range words {
+		if line != "//" && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(w) > width {
+			lines = append(lines, line)
+			line = "//"
+		}
+		line += " " + w
+	}
+
+	return append(lines, line)
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckLineWidth(t *testing.T) {
+	tt := []struct {
+		name     string
+		doc      string
+		expected string
+	}{
+		{
+			name:     "Ignores comments within the limit",
+			doc:      "// Foo does a thing.",
+			expected: "",
+		},
+		{
+			name:     "Rewraps paragraphs that are too wide",
+			doc:      "// Foo does a thing that takes quite a lot of words to describe.\n// It is short.",
+			expected: "// Foo does a thing that takes quite a\n// lot of words to describe. It is\n// short.",
+		},
+		{
+			name: "Leaves code blocks and other paragraphs alone",
+			doc: "// Foo does a thing that takes quite a lot of words to describe.\n//\n" +
+				"//\tfoo := Foo(with, a, very, long, list, of, arguments)\n//\n// Short.",
+			expected: "// Foo does a thing that takes quite a\n// lot of words to describe.\n//\n" +
+				"//\tfoo := Foo(with, a, very, long, list, of, arguments)\n//\n// Short.",
+		},
+		{
+			name:     "Ignores lines that can't be wrapped any further",
+			doc:      "// See:\n// https://example.com/a/very/long/url/that/cannot/be/wrapped",
+			expected: "",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := "package foo\n\n" + test.doc + "\nfunc Foo() {}\n"
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r diagnosticRecorder
+			checkLineWidth(&r, fset, file.Decls[0].(*ast.FuncDecl).Doc, 40)
+
+			if test.expected == "" {
+				assert.Equal(t, len(r.diagnostics), 0)
+				return
+			}
+
+			assert.Equal(t, len(r.diagnostics), 1)
+			assert.Equal(t, string(r.diagnostics[0].SuggestedFixes[0].TextEdits[0].NewText), test.expected)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the detection of doc comments whose leading identifier
+// doesn't match anything in the declaration they document, which is usually the result of
+// copying a declaration or renaming one without updating its comment.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// checkDocNames reports every doc comment in the top-level declarations of the given
+// file whose leading identifier doesn't match any identifier in the declaration it precedes.
+// Identifiers are looked up in the given package scope to tell them apart from the English
+// words doc comments often start with.
+func checkDocNames(r reporter.Reporter, scope *types.Scope, file *ast.File) {
+	for _, decl := range file.Decls {
+		switch expr := decl.(type) {
+		case *ast.FuncDecl:
+			checkDocName(r, scope, "function", expr.Doc, expr.Pos(), expr.Name)
+		case *ast.GenDecl:
+			var names []*ast.Ident
+			for _, spec := range expr.Specs {
+				specNames := specIdents(spec)
+				names = append(names, specNames...)
+
+				if expr.Lparen.IsValid() {
+					checkDocName(r, scope, kind(expr.Tok), specDoc(spec), spec.Pos(), specNames...)
+				}
+
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					checkFieldDocNames(r, scope, ts.Type)
+				}
+			}
+
+			if expr.Lparen.IsValid() {
+				checkDocName(r, scope, kind(expr.Tok)+" block", expr.Doc, expr.Pos(), names...)
+			} else {
+				checkDocName(r, scope, kind(expr.Tok), expr.Doc, expr.Pos(), names...)
+			}
+		}
+	}
+}
+
+// checkFieldDocNames checks the doc comments of the fields of a struct type, or the
+// methods of an interface type, against the names of the fields or methods they precede.
+func checkFieldDocNames(r reporter.Reporter, scope *types.Scope, typ ast.Expr) {
+	var fields *ast.FieldList
+	var what string
+
+	switch t := typ.(type) {
+	case *ast.StructType:
+		fields, what = t.Fields, "field"
+	case *ast.InterfaceType:
+		fields, what = t.Methods, "method"
+	default:
+		return
+	}
+
+	for _, field := range fields.List {
+		names := field.Names
+		if len(names) == 0 {
+			// Embedded fields are documented by the name of the type they embed.
+			if ident := embeddedIdent(field.Type); ident != nil {
+				names = []*ast.Ident{ident}
+			}
+		}
+
+		checkDocName(r, scope, what, field.Doc, field.Pos(), names...)
+	}
+}
+
+// checkDocName reports the given doc comment if its leading word is an identifier that
+// isn't one of the given names.
+func checkDocName(r reporter.Reporter, scope *types.Scope, what string, doc *ast.CommentGroup, pos token.Pos, names ...*ast.Ident) {
+	if doc == nil || len(names) == 0 {
+		return
+	}
+
+	fields := strings.Fields(doc.Text())
+	if len(fields) == 0 {
+		return
+	}
+
+	// Allow for possessives and punctuation, e.g. "Foo's" or "Foo,".
+	leading := strings.TrimSuffix(strings.TrimRightFunc(fields[0], unicode.IsPunct), "'s")
+	if !token.IsIdentifier(leading) || !looksLikeIdentifier(scope, leading) {
+		return
+	}
+
+	expected := make([]string, 0, len(names))
+	for _, name := range names {
+		// Unexported fields are often documented by the exported name of their type, e.g.
+		// "Config is ..." above "config Config", so the case of the name doesn't matter.
+		if strings.EqualFold(name.Name, leading) {
+			return
+		}
+		expected = append(expected, "\""+name.Name+"\"")
+	}
+
+	reportRule(r, RuleDocName, pos, "comment for %s %s begins with \"%s\", which doesn't match any identifier it documents",
+		what, strings.Join(expected, ", "), leading)
+}
+
+// looksLikeIdentifier reports whether or not the given word, which is a valid identifier,
+// refers to an identifier rather than being an English word. This is the case if it is
+// declared in the given package scope or is written in camel case, e.g. "newFoo".
+func looksLikeIdentifier(scope *types.Scope, w string) bool {
+	if scope != nil && scope.Lookup(w) != nil {
+		return true
+	}
+
+	if strings.ContainsAny(w, "_0123456789") {
+		return true
+	}
+
+	// Acronyms and their plurals, e.g. "URL" or "IDs", are words.
+	if strings.ToUpper(strings.TrimSuffix(w, "s")) == strings.TrimSuffix(w, "s") {
+		return false
+	}
+
+	for _, r := range w[1:] {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// specIdents returns the identifiers declared by the given spec.
+func specIdents(spec ast.Spec) []*ast.Ident {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return []*ast.Ident{s.Name}
+	case *ast.ValueSpec:
+		return s.Names
+	}
+
+	return nil
+}
+
+// specDoc returns the doc comment of the given spec, see valueSpecDoc.
+func specDoc(spec ast.Spec) *ast.CommentGroup {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return s.Doc
+	case *ast.ValueSpec:
+		return valueSpecDoc(s)
+	}
+
+	return nil
+}
+
+// embeddedIdent returns the identifier an embedded field is referred to by.
+func embeddedIdent(typ ast.Expr) *ast.Ident {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		return t
+	case *ast.StarExpr:
+		return embeddedIdent(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel
+	case *ast.IndexExpr:
+		return embeddedIdent(t.X)
+	case *ast.IndexListExpr:
+		return embeddedIdent(t.X)
+	}
+
+	return nil
+}
+
+// kind returns the kind of declaration the given token introduces, as it is referred to in
+// reported issues.
+func kind(tok token.Token) string {
+	switch tok { //nolint:exhaustive // Why: Only declarations are passed to this function.
+	case token.CONST:
+		return "constant"
+	case token.TYPE:
+		return "type"
+	case token.VAR:
+		return "variable"
+	case token.IMPORT:
+		return "import"
+	}
+
+	return tok.String()
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+type formatRecorder struct {
+	formats []string
+}
+
+func (r *formatRecorder) Reportf(_ token.Pos, format string, _ ...interface{}) {
+	r.formats = append(r.formats, format)
+}
+
+func TestCheckDocNames(t *testing.T) {
+	tt := []struct {
+		name     string
+		src      string
+		expected int
+	}{
+		{
+			name:     "Accepts comments that begin with the declared name",
+			src:      "// Baz does a thing.\nfunc Baz() {}",
+			expected: 0,
+		},
+		{
+			name:     "Reports comments that begin with another declared name",
+			src:      "// Bar does a thing.\nfunc Baz() {}\n\n// Bar does another thing.\nfunc Bar() {}",
+			expected: 1,
+		},
+		{
+			name:     "Reports comments that begin with a camel case identifier",
+			src:      "// newFoo returns a foo.\nfunc newBar() {}",
+			expected: 1,
+		},
+		{
+			name:     "Ignores comments that begin with a word",
+			src:      "// Returns a foo.\nfunc newBar() {}\n\n// URLs are a type.\ntype Baz int",
+			expected: 0,
+		},
+		{
+			name:     "Reports stale names within blocks",
+			src:      "const (\n\t// Foo is one.\n\tFoo = 1\n\n\t// Foo is two.\n\tBar = 2\n)",
+			expected: 1,
+		},
+		{
+			name:     "Accepts any name of a multi-name spec",
+			src:      "// Bar is a number.\nvar Foo, Bar int",
+			expected: 0,
+		},
+		{
+			name: "Reports stale field names",
+			src: "// Baz is a thing.\ntype Baz struct {\n\t// oldName is a name.\n\tnewName string\n\n" +
+				"\t// Baz is embedded.\n\tBaz2\n}\n\n// Baz2 is a thing.\ntype Baz2 struct{}",
+			expected: 2,
+		},
+		{
+			name:     "Accepts fields documented by their type",
+			src:      "// Baz is a thing.\ntype Baz struct {\n\t// Baz2 is a baz.\n\tbaz2 Baz2\n}\n\n// Baz2 is a thing.\ntype Baz2 struct{}",
+			expected: 0,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", "package foo\n\n"+test.src+"\n", parser.ParseComments)
+			assert.NilError(t, err)
+
+			pkg, err := (&types.Config{}).Check("foo", fset, []*ast.File{file}, nil)
+			assert.NilError(t, err)
+
+			var r formatRecorder
+			checkDocNames(&r, pkg.Scope(), file)
+			assert.Equal(t, len(r.formats), test.expected)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file defines the IDs of the rules doculint reports issues under, which
+// allow individual rules to be disabled.
+
+package doculint
+
+import (
+	"go/token"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// IDs of the rules doculint reports issues under. Each issue carries the ID of its rule as
+// the category of its diagnostic.
+const (
+	// RuleMissingComment is the rule that packages, functions, types, variables, and
+	// constants have a comment.
+	RuleMissingComment = "missing-comment"
+
+	// RuleCommentPrefix is the rule that comments begin with the name of what they document.
+	RuleCommentPrefix = "comment-prefix"
+
+	// RuleSeparateDeclarations is the rule that variables and constants declared together
+	// are separated so that each can have a comment.
+	RuleSeparateDeclarations = "separate-declarations"
+
+	// RulePackageName is the rule that package names are lowercase without - or _.
+	RulePackageName = "package-name"
+
+	// RulePackageFile is the rule that each package has a file with the same name that
+	// contains the package comment.
+	RulePackageFile = "package-file"
+
+	// RulePackageSentences is the rule that package comments contain a minimum number of
+	// sentences.
+	RulePackageSentences = "package-sentences"
+
+	// RulePackageUsage is the rule that package comments of library packages contain a
+	// usage or example section.
+	RulePackageUsage = "package-usage"
+
+	// RuleDocName is the rule that doc comments don't begin with an identifier other than
+	// the one they document.
+	RuleDocName = "doc-name"
+
+	// RuleSpelling is the rule that doc comments are spelled correctly.
+	RuleSpelling = "spelling"
+
+	// RuleLineWidth is the rule that doc comment lines are no wider than the maximum.
+	RuleLineWidth = "line-width"
+
+	// RuleHeaderDescription is the rule that the Description header fields of the files of
+	// a package don't refer to a package comment that doesn't exist, and aren't copies of
+	// each other.
+	RuleHeaderDescription = "header-description"
+
+	// RuleUnreferencedExport is the rule that exported identifiers of command packages are
+	// referenced outside of their package, rather than exported by accident.
+	RuleUnreferencedExport = "unreferenced-export"
+
+	// RuleEmbeddedField is the rule that fields embedding exported types in exported structs,
+	// which promote the fields and methods of the types they embed, have a comment.
+	RuleEmbeddedField = "embedded-field"
+
+	// RuleDirectivePlacement is the rule that directives meant for tools, e.g. //go:generate,
+	// aren't part of doc comments.
+	RuleDirectivePlacement = "directive-placement"
+
+	// RulePackageDocFile is the rule that library packages within the configured paths have
+	// a doc.go file with an extended package comment.
+	RulePackageDocFile = "package-doc-file"
+)
+
+// Rules contains the ID of every rule doculint reports issues under.
+var Rules = []string{
+	RuleMissingComment,
+	RuleCommentPrefix,
+	RuleSeparateDeclarations,
+	RulePackageName,
+	RulePackageFile,
+	RulePackageSentences,
+	RulePackageUsage,
+	RuleDocName,
+	RuleSpelling,
+	RuleLineWidth,
+	RuleHeaderDescription,
+	RuleUnreferencedExport,
+	RuleEmbeddedField,
+	RuleDirectivePlacement,
+	RulePackageDocFile,
+}
+
+// SetDisabledRules sets the rules that would have been disabled via flags if this was ran
+// as a vet tool, see NewAnalyzerWithOptions.
+func SetDisabledRules(rules []string) {
+	disabledRules = strings.Join(rules, ",")
+}
+
+// ruleDisabled reports whether or not the rule with the given ID is disabled.
+func ruleDisabled(rule string) bool {
+	for _, disabled := range strings.Split(disabledRules, ",") {
+		if strings.TrimSpace(disabled) == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reportRule reports an issue under the given rule through r, unless the rule is disabled,
+// see reporter.ReportRule.
+func reportRule(r reporter.Reporter, rule string, pos token.Pos, format string, args ...interface{}) {
+	if ruleDisabled(rule) {
+		return
+	}
+
+	reporter.ReportRule(r, rule, pos, format, args...)
+}
+
+// reportRelated reports an issue under the given rule through r along with the given
+// related information, unless the rule is disabled, see reporter.ReportRelated.
+func reportRelated(r reporter.Reporter, rule string, pos token.Pos, related []analysis.RelatedInformation, format string,
+	args ...interface{}) {
+	if ruleDisabled(rule) {
+		return
+	}
+
+	reporter.ReportRelated(r, rule, pos, related, format, args...)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/docs"
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+type ruleRecorder struct {
+	diagnosticRecorder
+}
+
+func (r *ruleRecorder) Reportf(pos token.Pos, format string, args ...interface{}) {
+	r.Report(analysis.Diagnostic{Pos: pos, Message: fmt.Sprintf(format, args...)})
+}
+
+func TestReportRule(t *testing.T) {
+	tt := []struct {
+		name     string
+		disabled []string
+		funcDoc  string
+		expected []string
+	}{
+		{
+			name:     "Reports missing comments under their rule",
+			expected: []string{RuleMissingComment},
+		},
+		{
+			name:     "Reports bad prefixes under their rule",
+			funcDoc:  "This function is foo.",
+			expected: []string{RuleCommentPrefix},
+		},
+		{
+			name:     "Skips disabled rules",
+			disabled: []string{RuleSpelling, RuleCommentPrefix},
+			funcDoc:  "This function is foo.",
+			expected: nil,
+		},
+		{
+			name:     "Keeps rules that aren't disabled",
+			disabled: []string{RuleCommentPrefix},
+			expected: []string{RuleMissingComment},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetDisabledRules(test.disabled)
+			defer SetDisabledRules(nil)
+
+			funcDecl := &ast.FuncDecl{
+				Name: &ast.Ident{Name: "foo"},
+				Type: &ast.FuncType{},
+			}
+			if test.funcDoc != "" {
+				funcDecl.Doc = &ast.CommentGroup{List: []*ast.Comment{{Text: "// " + test.funcDoc}}}
+			}
+
+			r := &ruleRecorder{}
+			validateFuncDecl(r, funcDecl)
+
+			var rules []string
+			for _, d := range r.diagnostics {
+				rules = append(rules, d.Category)
+			}
+			assert.DeepEqual(t, rules, test.expected)
+		})
+	}
+}
+
+func TestRulesDocumented(t *testing.T) {
+	page, ok := docs.Lookup(Analyzer.Name)
+	assert.Assert(t, ok)
+
+	documented := make([]string, 0, len(page.Rules))
+	for _, r := range page.Rules {
+		documented = append(documented, r.ID)
+	}
+	assert.DeepEqual(t, documented, Rules)
+}
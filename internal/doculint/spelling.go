@@ -0,0 +1,301 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the opt-in spell check that doculint runs over doc
+// comments, reporting known misspellings along with a suggested fix for each.
+
+package doculint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Locales supported by the spell check. Besides misspellings, which are always reported,
+// each locale also reports the spellings of the other locale.
+const (
+	// LocaleUS reports British spellings, e.g. "colour", in favor of American ones.
+	LocaleUS = "en-US"
+
+	// LocaleGB reports American spellings, e.g. "color", in favor of British ones.
+	LocaleGB = "en-GB"
+)
+
+// misspellings maps common misspellings of English words to their correct spelling.
+var misspellings = map[string]string{
+	"accomodate":    "accommodate",
+	"acheive":       "achieve",
+	"accross":       "across",
+	"adress":        "address",
+	"agian":         "again",
+	"alot":          "a lot",
+	"alreay":        "already",
+	"apparant":      "apparent",
+	"arguement":     "argument",
+	"assosiated":    "associated",
+	"asynchronus":   "asynchronous",
+	"begining":      "beginning",
+	"beleive":       "believe",
+	"calender":      "calendar",
+	"cancelation":   "cancellation",
+	"commited":      "committed",
+	"comparision":   "comparison",
+	"completly":     "completely",
+	"concurent":     "concurrent",
+	"configuraiton": "configuration",
+	"connnection":   "connection",
+	"consistant":    "consistent",
+	"containg":      "containing",
+	"correspondant": "correspondent",
+	"definately":    "definitely",
+	"dependancy":    "dependency",
+	"dependant":     "dependent",
+	"desciption":    "description",
+	"doesnt":        "doesn't",
+	"enviroment":    "environment",
+	"existance":     "existence",
+	"explicitely":   "explicitly",
+	"facilitiy":     "facility",
+	"functino":      "function",
+	"garantee":      "guarantee",
+	"happend":       "happened",
+	"identifer":     "identifier",
+	"immediatly":    "immediately",
+	"implmentation": "implementation",
+	"independant":   "independent",
+	"initalize":     "initialize",
+	"lenght":        "length",
+	"maintainance":  "maintenance",
+	"managment":     "management",
+	"neccessary":    "necessary",
+	"occured":       "occurred",
+	"occurence":     "occurrence",
+	"paramter":      "parameter",
+	"persistant":    "persistent",
+	"posible":       "possible",
+	"preceeding":    "preceding",
+	"proccess":      "process",
+	"recieve":       "receive",
+	"recieved":      "received",
+	"reciever":      "receiver",
+	"recomend":      "recommend",
+	"reponse":       "response",
+	"requirments":   "requirements",
+	"retreive":      "retrieve",
+	"seperate":      "separate",
+	"seperated":     "separated",
+	"similiar":      "similar",
+	"succesful":     "successful",
+	"successfull":   "successful",
+	"sucess":        "success",
+	"teh":           "the",
+	"threshhold":    "threshold",
+	"transfered":    "transferred",
+	"truely":        "truly",
+	"unneccessary":  "unnecessary",
+	"untill":        "until",
+	"usefull":       "useful",
+	"wich":          "which",
+	"writting":      "writing",
+}
+
+// americanToBritish maps American spellings to their British counterparts.
+var americanToBritish = map[string]string{
+	"analyze":     "analyse",
+	"behavior":    "behaviour",
+	"behaviors":   "behaviours",
+	"canceled":    "cancelled",
+	"canceling":   "cancelling",
+	"catalog":     "catalogue",
+	"center":      "centre",
+	"color":       "colour",
+	"colors":      "colours",
+	"favor":       "favour",
+	"favorite":    "favourite",
+	"honor":       "honour",
+	"initialized": "initialised",
+	"labeled":     "labelled",
+	"labeling":    "labelling",
+	"modeled":     "modelled",
+	"modeling":    "modelling",
+	"neighbor":    "neighbour",
+	"normalize":   "normalise",
+	"organize":    "organise",
+	"organized":   "organised",
+	"recognize":   "recognise",
+	"recognized":  "recognised",
+	"summarize":   "summarise",
+	"traveled":    "travelled",
+	"utilize":     "utilise",
+	"visualize":   "visualise",
+}
+
+// speller reports known misspellings in doc comments.
+type speller struct {
+	// corrections maps lowercase words to their corrections.
+	corrections map[string]string
+
+	// ignored contains lowercase words that are never reported.
+	ignored map[string]bool
+}
+
+// newSpeller returns a speller for the given locale, which is either empty, LocaleUS, or
+// LocaleGB, that never reports any of the given words.
+func newSpeller(locale string, ignoreWords []string) *speller {
+	s := speller{
+		corrections: make(map[string]string, len(misspellings)+len(americanToBritish)),
+		ignored:     make(map[string]bool, len(ignoreWords)),
+	}
+
+	for word, correction := range misspellings {
+		s.corrections[word] = correction
+	}
+
+	switch {
+	case strings.EqualFold(locale, LocaleUS):
+		for american, british := range americanToBritish {
+			s.corrections[british] = american
+		}
+	case strings.EqualFold(locale, LocaleGB):
+		for american, british := range americanToBritish {
+			s.corrections[american] = british
+		}
+	}
+
+	for _, word := range ignoreWords {
+		s.ignored[strings.ToLower(strings.TrimSpace(word))] = true
+	}
+
+	return &s
+}
+
+// check reports every known misspelling in the given doc comment, along with a suggested
+// fix that replaces it with its correction.
+func (s *speller) check(r interface{ Report(analysis.Diagnostic) }, doc *ast.CommentGroup) {
+	if doc == nil {
+		return
+	}
+
+	for _, comment := range doc.List {
+		for _, w := range words(comment.Text) {
+			lower := strings.ToLower(w.text)
+			if s.ignored[lower] {
+				continue
+			}
+
+			correction, ok := s.corrections[lower]
+			if !ok {
+				continue
+			}
+			correction = matchCase(w.text, correction)
+
+			pos := comment.Slash + token.Pos(w.offset)
+			end := pos + token.Pos(len(w.text))
+			r.Report(analysis.Diagnostic{
+				Pos:      pos,
+				End:      end,
+				Category: RuleSpelling,
+				Message:  fmt.Sprintf("\"%s\" is a misspelling of \"%s\"", w.text, correction),
+				SuggestedFixes: []analysis.SuggestedFix{
+					{
+						Message: fmt.Sprintf("Replace \"%s\" with \"%s\"", w.text, correction),
+						TextEdits: []analysis.TextEdit{
+							{Pos: pos, End: end, NewText: []byte(correction)},
+						},
+					},
+				},
+			})
+		}
+	}
+}
+
+// word is a single word within a comment.
+type word struct {
+	// text is the word itself.
+	text string
+
+	// offset is the byte offset of the word from the start of the comment.
+	offset int
+}
+
+// words returns the words in the given comment text, skipping anything that isn't made up
+// solely of letters (and apostrophes) once surrounding punctuation is trimmed, such as
+// identifiers, URLs, and code.
+func words(text string) []word {
+	var result []word
+
+	isPunct := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}
+
+	for offset := 0; offset < len(text); {
+		// Skip to the start of the next field.
+		start := offset + strings.IndexFunc(text[offset:], func(r rune) bool { return !unicode.IsSpace(r) })
+		if start < offset {
+			break
+		}
+
+		end := len(text)
+		if i := strings.IndexFunc(text[start:], unicode.IsSpace); i >= 0 {
+			end = start + i
+		}
+		offset = end
+
+		field := text[start:end]
+		trimmed := strings.TrimLeftFunc(field, isPunct)
+		lead := len(field) - len(trimmed)
+		trimmed = strings.TrimRightFunc(trimmed, isPunct)
+
+		if trimmed == "" || strings.IndexFunc(trimmed, func(r rune) bool { return !unicode.IsLetter(r) && r != '\'' }) >= 0 {
+			continue
+		}
+
+		result = append(result, word{text: trimmed, offset: start + lead})
+	}
+
+	return result
+}
+
+// matchCase returns correction with the casing of the given word applied to it, which is
+// either entirely uppercase, capitalized, or left as is.
+func matchCase(w, correction string) string {
+	switch {
+	case len(w) > 1 && strings.ToUpper(w) == w:
+		return strings.ToUpper(correction)
+	case unicode.IsUpper([]rune(w)[0]):
+		return strings.ToUpper(correction[:1]) + correction[1:]
+	default:
+		return correction
+	}
+}
+
+// docComments returns every doc comment in the given file: the package comment and the
+// comments of declarations, specs, and fields.
+func docComments(file *ast.File) []*ast.CommentGroup {
+	var docs []*ast.CommentGroup
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.File:
+			docs = append(docs, node.Doc)
+		case *ast.FuncDecl:
+			docs = append(docs, node.Doc)
+		case *ast.GenDecl:
+			docs = append(docs, node.Doc)
+		case *ast.TypeSpec:
+			docs = append(docs, node.Doc)
+		case *ast.ValueSpec:
+			docs = append(docs, node.Doc)
+		case *ast.Field:
+			docs = append(docs, node.Doc)
+		}
+
+		return true
+	})
+
+	return docs
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+type diagnosticRecorder struct {
+	diagnostics []analysis.Diagnostic
+}
+
+func (r *diagnosticRecorder) Report(d analysis.Diagnostic) {
+	r.diagnostics = append(r.diagnostics, d)
+}
+
+func TestSpellerCheck(t *testing.T) {
+	tt := []struct {
+		name        string
+		locale      string
+		ignoreWords []string
+		doc         string
+		expected    []string
+	}{
+		{
+			name:     "Reports misspellings with a fix matching their case",
+			doc:      "// Foo will Recieve teh value.",
+			expected: []string{"Receive", "the"},
+		},
+		{
+			name:     "Ignores identifiers and URLs",
+			doc:      "// Foo calls teh.Recieve, see https://example.com/teh.",
+			expected: nil,
+		},
+		{
+			name:     "Ignores configured words",
+			doc:      "// Foo will recieve teh value.",
+			expected: []string{"the"},
+
+			ignoreWords: []string{"Recieve"},
+		},
+		{
+			name:     "Reports British spellings for en-US",
+			locale:   LocaleUS,
+			doc:      "// Foo sets the colour and color.",
+			expected: []string{"color"},
+		},
+		{
+			name:     "Reports American spellings for en-GB",
+			locale:   LocaleGB,
+			doc:      "// Foo sets the colour and color.",
+			expected: []string{"colour"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := "package foo\n\n" + test.doc + "\nfunc Foo() {}\n"
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r diagnosticRecorder
+			s := newSpeller(test.locale, test.ignoreWords)
+			for _, doc := range docComments(file) {
+				s.check(&r, doc)
+			}
+
+			var corrections []string
+			for _, d := range r.diagnostics {
+				edit := d.SuggestedFixes[0].TextEdits[0]
+				corrections = append(corrections, string(edit.NewText))
+
+				// The fix must replace exactly the misspelled word.
+				word := src[fset.Position(edit.Pos).Offset:fset.Position(edit.End).Offset]
+				_, ok := s.corrections[strings.ToLower(word)]
+				assert.Assert(t, ok, word)
+			}
+			assert.DeepEqual(t, corrections, test.expected)
+		})
+	}
+}
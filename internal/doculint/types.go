@@ -0,0 +1,75 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the checks of type declarations that go beyond their
+// comments: type aliases, and the fields embedded in exported structs.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// validateAlias validates the given type alias declaration, e.g. type Foo = bar.Baz, which
+// has the given comment. Aliases are held to the same rules as other types, but the issues
+// name the type they alias since their comments often describe that type instead, and with
+// skipAliases set they aren't required to have a comment at all.
+func validateAlias(r reporter.Reporter, ts *ast.TypeSpec, doc *ast.CommentGroup) {
+	if doc == nil {
+		if !skipAliases {
+			reportRule(r, RuleMissingComment, ts.Pos(), "type alias \"%s\" of \"%s\" has no comment associated with it",
+				ts.Name.Name, types.ExprString(ts.Type))
+		}
+		return
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(doc.Text()), ts.Name.Name) {
+		reportRule(r, RuleCommentPrefix, ts.Pos(), "comment for type alias \"%s\" of \"%s\" should begin with \"%s\"",
+			ts.Name.Name, types.ExprString(ts.Type), ts.Name.Name)
+	}
+}
+
+// checkEmbeddedFields reports the fields of the given exported struct type that embed an
+// exported type without a comment of their own. Embedding an exported type promotes its
+// exported fields and methods, making them part of the API of the struct, which is worth a
+// comment saying so, e.g. on why the struct implements an interface through it.
+func checkEmbeddedFields(r reporter.Reporter, ts *ast.TypeSpec) {
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok || !ts.Name.IsExported() || st.Fields == nil {
+		return
+	}
+
+	for _, field := range st.Fields.List {
+		if len(field.Names) > 0 || field.Doc != nil || field.Comment != nil {
+			continue
+		}
+
+		if embedded, ok := embeddedTypeName(field.Type); ok && ast.IsExported(embedded) {
+			reportRule(r, RuleEmbeddedField, field.Pos(),
+				"embedded field \"%s\" of type \"%s\" promotes its exported fields and methods, it should have a comment associated with it",
+				types.ExprString(field.Type), ts.Name.Name)
+		}
+	}
+}
+
+// embeddedTypeName returns the name of the type an embedded field with the given type
+// expression embeds, e.g. "Baz" for *bar.Baz[T], or false if it isn't a type name.
+func embeddedTypeName(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name, true
+	case *ast.SelectorExpr:
+		return expr.Sel.Name, true
+	case *ast.StarExpr:
+		return embeddedTypeName(expr.X)
+	case *ast.IndexExpr:
+		return embeddedTypeName(expr.X)
+	case *ast.IndexListExpr:
+		return embeddedTypeName(expr.X)
+	}
+
+	return "", false
+}
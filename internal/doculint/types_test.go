@@ -0,0 +1,108 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestTypeAliasesAndEmbeddedFields(t *testing.T) {
+	const aliases = `package foo
+
+import "bar"
+
+type Foo = bar.Foo
+
+// Baz is what bar calls it.
+type Qux = bar.Baz
+
+// Quux is an alias of bar.Quux.
+type Quux = bar.Quux
+`
+
+	const embedded = `package foo
+
+import (
+	"io"
+	"sync"
+)
+
+// Foo is a foo.
+type Foo struct {
+	*sync.Mutex
+	io.Reader // Reader is promoted to let Foo be read from.
+
+	// Writer is promoted to let Foo be written to.
+	io.Writer
+
+	inner
+	name string
+}
+
+// bar is a bar.
+type bar struct {
+	sync.Mutex
+}
+`
+
+	tt := []struct {
+		name             string
+		skipAliases      bool
+		validateEmbedded bool
+		src              string
+		expected         []string
+	}{
+		{
+			name: "Reports aliases by what they alias",
+			src:  aliases,
+			expected: []string{
+				`type alias "Foo" of "bar.Foo" has no comment associated with it`,
+				`comment for type alias "Qux" of "bar.Baz" should begin with "Qux"`,
+			},
+		},
+		{
+			name:        "Skips the comments of aliases",
+			skipAliases: true,
+			src:         aliases,
+			expected: []string{
+				`comment for type alias "Qux" of "bar.Baz" should begin with "Qux"`,
+			},
+		},
+		{
+			name: "Allows embedded fields without comments by default",
+			src:  embedded,
+		},
+		{
+			name:             "Reports embedded fields of exported structs without comments",
+			validateEmbedded: true,
+			src:              embedded,
+			expected: []string{
+				`embedded field "*sync.Mutex" of type "Foo" promotes its exported fields and methods, it should have a comment associated with it`,
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetTypeOptions(test.skipAliases, test.validateEmbedded)
+			t.Cleanup(func() { SetTypeOptions(false, false) })
+
+			file, err := parser.ParseFile(token.NewFileSet(), "foo.go", test.src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			for _, decl := range file.Decls {
+				if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+					validateGenDeclTypes(&r, genDecl)
+				}
+			}
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that reports exported identifiers of command
+// packages that nothing outside of their package references, which should be unexported.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// checkUnreferencedExports reports the exported top-level identifiers declared within the
+// given files of the package with the given name and import path, found in the given
+// directory, that nothing outside of the package references, suggesting to unexport them
+// rather than document them. Only command packages are checked, see unreferencedScope:
+// main packages, which can't be imported at all, and the packages beneath a cmd directory
+// or an internal directory of a main package, which are only imported by a few packages
+// whose files are searched for references.
+func checkUnreferencedExports(r reporter.Reporter, pkgName, pkgPath, dir string, files []*ast.File) {
+	var referenced map[string]bool
+	if pkgName != common.PackageMain {
+		root, ok := unreferencedScope(pkgPath, dir)
+		if !ok {
+			return
+		}
+
+		var all bool
+		if referenced, all = referencesTo(root, pkgPath, pkgName); all {
+			return
+		}
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			for _, ident := range exportedIdents(decl) {
+				if referenced[ident.ident.Name] {
+					continue
+				}
+
+				if pkgName == common.PackageMain {
+					reportRule(r, RuleUnreferencedExport, ident.ident.Pos(),
+						"exported %s \"%s\" of main package can't be referenced outside of it, unexport it rather than documenting it",
+						ident.kind, ident.ident.Name)
+					continue
+				}
+
+				reportRule(r, RuleUnreferencedExport, ident.ident.Pos(),
+					"exported %s \"%s\" is never referenced outside of package \"%s\", unexport it rather than documenting it",
+					ident.kind, ident.ident.Name, pkgName)
+			}
+		}
+	}
+}
+
+// exportedIdent is an exported identifier declared by a top-level declaration.
+type exportedIdent struct {
+	// ident is the identifier.
+	ident *ast.Ident
+
+	// kind is what the identifier is, e.g. "function".
+	kind string
+}
+
+// exportedIdents returns the exported identifiers the given top-level declaration declares.
+// Methods and fields aren't returned, since they're often exported to satisfy an interface
+// or to be encoded rather than to be referenced.
+func exportedIdents(decl ast.Decl) []exportedIdent {
+	var idents []exportedIdent
+
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		if decl.Recv == nil && decl.Name.IsExported() {
+			idents = append(idents, exportedIdent{decl.Name, "function"})
+		}
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if spec.Name.IsExported() {
+					idents = append(idents, exportedIdent{spec.Name, "type"})
+				}
+			case *ast.ValueSpec:
+				kind := "variable"
+				if decl.Tok == token.CONST {
+					kind = "constant"
+				}
+
+				for _, name := range spec.Names {
+					if name.IsExported() {
+						idents = append(idents, exportedIdent{name, kind})
+					}
+				}
+			}
+		}
+	}
+
+	return idents
+}
+
+// unreferencedScope returns the directory containing every package that can import the
+// package with the given import path, found in the given directory, if it is a command
+// package: the parent of the innermost internal directory it is beneath if that is a main
+// package, or else the parent of the cmd directory it is beneath, usually the root of the
+// module. Other packages, e.g. libraries, may be imported from anywhere and are never
+// checked.
+func unreferencedScope(pkgPath, dir string) (string, bool) {
+	segments := strings.Split(pkgPath, "/")
+
+	// parent returns the directory of the parent of the segment at the given index.
+	parent := func(i int) string {
+		root := dir
+		for range segments[i:] {
+			root = filepath.Dir(root)
+		}
+		return root
+	}
+
+	for i := len(segments) - 1; i > 0; i-- {
+		if segments[i] == "internal" {
+			if root := parent(i); isMainPackage(root) {
+				return root, true
+			}
+			break
+		}
+	}
+
+	for i := 1; i < len(segments); i++ {
+		if segments[i] == "cmd" {
+			return parent(i), true
+		}
+	}
+
+	return "", false
+}
+
+// isMainPackage reports whether or not the Go files directly within the given directory
+// belong to a main package.
+func isMainPackage(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, entry.Name()), nil, parser.PackageClauseOnly)
+		if err == nil {
+			return file.Name.Name == common.PackageMain
+		}
+	}
+
+	return false
+}
+
+// importingFile is what checkUnreferencedExports needs to know about a Go file: the
+// packages it imports and the identifiers it selects from them.
+type importingFile struct {
+	// imports maps the import path of each package the file imports to the name it is
+	// imported under, or an empty string if it is imported under its own name.
+	imports map[string]string
+
+	// selectors maps the name of each identifier the file selects from, e.g. "foo" of
+	// "foo.Bar", to the names of the identifiers selected from it.
+	selectors map[string]map[string]bool
+}
+
+// importingFiles caches the files beneath each directory searched for references, so that
+// the tree is only parsed once per run rather than once per package within it.
+var importingFiles = struct {
+	mu    sync.Mutex
+	files map[string][]importingFile
+}{
+	files: make(map[string][]importingFile),
+}
+
+// referencesTo returns the names of the identifiers of the package with the given import
+// path and name referenced by the Go files beneath the given directory, or true if one of
+// them dot-imports it, in which case any of its identifiers may be referenced.
+func referencesTo(root, pkgPath, pkgName string) (map[string]bool, bool) {
+	referenced := make(map[string]bool)
+
+	for _, file := range parseImportingFiles(root) {
+		name, ok := file.imports[pkgPath]
+		if !ok || name == "_" {
+			continue
+		}
+		if name == "." {
+			return nil, true
+		}
+		if name == "" {
+			name = pkgName
+		}
+
+		for sel := range file.selectors[name] {
+			referenced[sel] = true
+		}
+	}
+
+	return referenced, false
+}
+
+// parseImportingFiles returns the imports and selectors of every Go file beneath the given
+// directory, skipping the directories the go command skips when expanding "./...". Files
+// that can't be parsed are skipped.
+func parseImportingFiles(root string) []importingFile {
+	importingFiles.mu.Lock()
+	defer importingFiles.mu.Unlock()
+
+	if files, ok := importingFiles.files[root]; ok {
+		return files
+	}
+
+	var files []importingFile
+	fset := token.NewFileSet()
+	// The walk never fails, unreadable directories and files just hold no references.
+	_ = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		name := entry.Name()
+		if entry.IsDir() {
+			if path != root && skipDir(name) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil
+		}
+		files = append(files, newImportingFile(file))
+
+		return nil
+	})
+
+	importingFiles.files[root] = files
+	return files
+}
+
+// skipDir reports whether or not the directory with the given name is skipped when
+// searching for references, like the go command skips it when expanding "./...".
+func skipDir(name string) bool {
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" || name == "vendor"
+}
+
+// newImportingFile returns the imports and selectors of the given file.
+func newImportingFile(file *ast.File) importingFile {
+	f := importingFile{
+		imports:   make(map[string]string),
+		selectors: make(map[string]map[string]bool),
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		var name string
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		f.imports[path] = name
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if x, ok := sel.X.(*ast.Ident); ok {
+			if f.selectors[x.Name] == nil {
+				f.selectors[x.Name] = make(map[string]bool)
+			}
+			f.selectors[x.Name][sel.Sel.Name] = true
+		}
+
+		return true
+	})
+
+	return f
+}
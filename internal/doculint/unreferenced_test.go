@@ -0,0 +1,107 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package doculint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckUnreferencedExports(t *testing.T) {
+	root := t.TempDir()
+	for name, src := range map[string]string{
+		"cmd/foo/main.go": `package main
+
+import "example.com/m/cmd/foo/internal/bar"
+
+// Version is the version of foo.
+var Version = "v1"
+
+func main() { bar.Used() }
+`,
+		"cmd/foo/internal/bar/bar.go": `package bar
+
+type Config struct{ Name string }
+
+func (c Config) String() string { return c.Name }
+
+func Used() {}
+
+func Unused() {}
+
+const Limit, limit = 1, 2
+`,
+		"cmd/foo/internal/dot/dot.go": "package dot\n\nfunc Unused() {}\n",
+		"cmd/foo/internal/dot/dot_test.go": `package dot_test
+
+import . "example.com/m/cmd/foo/internal/dot"
+
+var _ = Unused
+`,
+		"internal/lib/lib.go": "package lib\n\nfunc Unused() {}\n",
+	} {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NilError(t, os.WriteFile(path, []byte(src), 0o600))
+	}
+
+	tt := []struct {
+		name     string
+		pkgName  string
+		pkgPath  string
+		file     string
+		expected []string
+	}{
+		{
+			name:    "Reports every export of main packages",
+			pkgName: "main",
+			pkgPath: "example.com/m/cmd/foo",
+			file:    "cmd/foo/main.go",
+			expected: []string{
+				`exported variable "Version" of main package can't be referenced outside of it, unexport it rather than documenting it`,
+			},
+		},
+		{
+			name:    "Reports exports of internal packages of commands that aren't referenced",
+			pkgName: "bar",
+			pkgPath: "example.com/m/cmd/foo/internal/bar",
+			file:    "cmd/foo/internal/bar/bar.go",
+			expected: []string{
+				`exported type "Config" is never referenced outside of package "bar", unexport it rather than documenting it`,
+				`exported function "Unused" is never referenced outside of package "bar", unexport it rather than documenting it`,
+				`exported constant "Limit" is never referenced outside of package "bar", unexport it rather than documenting it`,
+			},
+		},
+		{
+			name:    "Ignores dot-imported packages",
+			pkgName: "dot",
+			pkgPath: "example.com/m/cmd/foo/internal/dot",
+			file:    "cmd/foo/internal/dot/dot.go",
+		},
+		{
+			name:    "Ignores library packages",
+			pkgName: "lib",
+			pkgPath: "example.com/m/internal/lib",
+			file:    "internal/lib/lib.go",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(root, filepath.FromSlash(test.file))
+			file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			checkUnreferencedExports(&r, test.pkgName, test.pkgPath, filepath.Dir(path), []*ast.File{file})
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
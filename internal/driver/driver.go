@@ -0,0 +1,833 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package driver implements the driver that runs the lintroller analyzers when lintroller
+// is given a config file, as opposed to being ran as a vet tool. It loads the requested
+// packages, runs each analyzer over them, prints the resulting diagnostics, and finishes
+// the run with a summary of everything that was reported.
+package driver
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/companion"
+	"github.com/getoutreach/lintroller/internal/format"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"github.com/getoutreach/lintroller/internal/tracing"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// Exit codes returned by Run. These match the exit codes used by the drivers in
+// golang.org/x/tools/go/analysis so that lintroller behaves the same regardless of how
+// it is ran.
+const (
+	// ExitOK denotes that the run finished without any errors being reported.
+	ExitOK = 0
+
+	// ExitFailure denotes that packages could not be loaded or analyzed.
+	ExitFailure = 1
+
+	// ExitDiagnostics denotes that the run finished and errors were reported.
+	ExitDiagnostics = 3
+)
+
+// loadMode is the information that is loaded for each package the analyzers are ran on.
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedImports |
+	packages.NeedTypes | packages.NeedTypesSizes | packages.NeedSyntax | packages.NeedTypesInfo | packages.NeedModule
+
+// Group is a set of analyzers that is ran over the packages selected by Match. Each package
+// belongs to the first group that selects it.
+type Group struct {
+	// Match reports whether the package in the given directory, relative to the root of
+	// its module, belongs to this group. A nil Match selects every package.
+	Match func(dir string) bool
+
+	// Analyzers returns the analyzers to run over the packages in this group. It is called
+	// immediately before the packages in this group are analyzed under each configuration,
+	// see Options.Configurations, after the packages of every group before it have
+	// finished, which allows it to set the options of analyzers that are shared with other
+	// groups.
+	Analyzers func() []*analysis.Analyzer
+
+	// Companion checks the companion files, see Options.CompanionExtensions, in the
+	// directories this group selects. A nil Companion doesn't check them.
+	Companion *companion.Checker
+}
+
+// Options configures a single run of the driver.
+type Options struct {
+	// Output is where the summary and any errors are written to. Defaults to os.Stderr.
+	Output io.Writer
+
+	// Formatter writes the diagnostics. Defaults to the text format writing to Output.
+	Formatter format.Formatter
+
+	// Summary denotes whether or not to write the end-of-run summary. It is also given to
+	// formatters implementing format.Summarized.
+	Summary bool
+
+	// SummaryFiles is the maximum number of files to list in the end-of-run summary.
+	SummaryFiles int
+
+	// Scorer scores every package by the issues reported within it, as loaded under the
+	// first configuration, see Configurations. The scores are written after the summary
+	// and given to formatters implementing format.Scored. Packages aren't scored when nil.
+	Scorer *score.Scorer
+
+	// CompanionExtensions are the extensions of the non-Go files within the modules of
+	// the loaded packages that are checked by the Companion of each group, e.g. ".proto".
+	// No companion files are checked when empty.
+	CompanionExtensions []string
+
+	// Dir is the directory the patterns are resolved in. Defaults to the working directory.
+	Dir string
+
+	// Fix denotes whether or not the fixes suggested for the issues that were reported are
+	// applied to the files they concern once every package has been analyzed.
+	Fix bool
+
+	// Tests denotes whether or not the packages are loaded along with their tests, the
+	// equivalent of the -test flag of the drivers in golang.org/x/tools/go/analysis.
+	Tests bool
+
+	// Tags are the build tags the packages are loaded with under every configuration, e.g.
+	// "integration", the equivalent of the -tags flag of the go command.
+	Tags []string
+
+	// Configurations are the combinations of GOOS, GOARCH, and build tags the packages are
+	// loaded and analyzed under. A package is only analyzed under a configuration after the
+	// first if it has files none of the configurations before it selected, and is only
+	// scored under the first. Defaults to a single configuration of the platform lintroller
+	// is ran on.
+	Configurations []Configuration
+
+	// AnalyzerFlags are set on the flags of the analyzers of every group after they are
+	// returned by Group.Analyzers, so that flags given on the command line take precedence
+	// over the configuration.
+	AnalyzerFlags []AnalyzerFlag
+
+	// Stable denotes whether or not the diagnostics are written all at once after every
+	// package has been analyzed even when the formatter streams them, see format.Streamer,
+	// so that the output of identical runs is identical, e.g. to compare it to golden files.
+	Stable bool
+
+	// Overlay maps the absolute paths of files to contents that replace their contents on
+	// disk, e.g. the unsaved buffer of an editor, the equivalent of the -overlay flag of the
+	// go command. Files in the overlay don't need to exist on disk, but the directory of
+	// their package does. Fix is ignored when it isn't empty, since the offsets of the fixes
+	// would refer to contents that aren't on disk.
+	Overlay map[string][]byte
+}
+
+// Configuration is a combination of target platform and build tags the packages are loaded
+// with, see Options.Configurations.
+type Configuration struct {
+	// GOOS is the operating system the packages are loaded for. Defaults to the environment.
+	GOOS string
+
+	// GOARCH is the architecture the packages are loaded for. Defaults to the environment.
+	GOARCH string
+
+	// Tags are the build tags the packages are loaded with, in addition to Options.Tags.
+	Tags []string
+}
+
+// String returns the description of the configuration used in errors, e.g.
+// "GOOS=windows -tags=integration".
+func (c *Configuration) String() string {
+	var parts []string
+	if c.GOOS != "" {
+		parts = append(parts, "GOOS="+c.GOOS)
+	}
+	if c.GOARCH != "" {
+		parts = append(parts, "GOARCH="+c.GOARCH)
+	}
+	if len(c.Tags) > 0 {
+		parts = append(parts, "-tags="+strings.Join(c.Tags, ","))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// AnalyzerFlag is a flag of an analyzer given on the command line, e.g.
+// "-doculint.minFunLen=20".
+type AnalyzerFlag struct {
+	// Analyzer is the name of the analyzer the flag belongs to.
+	Analyzer string
+
+	// Name is the name of the flag within the flags of the analyzer.
+	Name string
+
+	// Value is the value the flag was given.
+	Value string
+}
+
+// Run loads the packages matching the given patterns, runs the analyzers of the group each
+// package belongs to over it, and writes the resulting diagnostics followed by the summary
+// of the run. The returned integer is the code the process should exit with. The run, the
+// loading of packages, and each analyzer ran over each package are traced, see
+// tracing.Init.
+func Run(patterns []string, groups []Group, opts *Options) int {
+	ctx, span := tracing.Tracer().Start(context.Background(), "lintroller.run")
+	defer span.End()
+
+	out := opts.Output
+	if out == nil {
+		out = os.Stderr
+	}
+
+	formatter := opts.Formatter
+	if formatter == nil {
+		formatter = format.NewText(out)
+	}
+
+	// Diagnostics are written by the formatter, which needs them as they were reported.
+	reporter.SetRawReports(true)
+
+	// Identical diagnostics are only emitted once per run, see reporter.Dedupe.
+	reporter.SetDedupe(reporter.NewDedupe())
+	defer reporter.SetDedupe(nil)
+
+	patterns, skipped, err := ExpandTargets(opts.Dir, patterns)
+	if err != nil {
+		fmt.Fprintln(out, errors.Wrap(err, "expand targets"))
+		return ExitFailure
+	}
+	for _, target := range skipped {
+		fmt.Fprintf(out, "skipping %s: no Go files\n", target)
+	}
+	if len(patterns) == 0 {
+		fmt.Fprintln(out, "no targets contain Go files")
+		return ExitFailure
+	}
+
+	_, loadSpan := tracing.Tracer().Start(ctx, "lintroller.load",
+		trace.WithAttributes(attribute.StringSlice("lintroller.patterns", patterns)))
+	pkgs, configurations, err := load(patterns, opts)
+	endSpan(loadSpan, err)
+	if err != nil {
+		fmt.Fprintln(out, err)
+		return ExitFailure
+	}
+	if len(pkgs) == 0 {
+		fmt.Fprintf(out, "%s matched no packages\n", strings.Join(patterns, " "))
+		return ExitFailure
+	}
+
+	exitCode := ExitOK
+	if packages.PrintErrors(pkgs) > 0 {
+		exitCode = ExitFailure
+	}
+
+	// Assign each package to the first group that selects it, within the configuration it
+	// was loaded under.
+	members := make([][][]int, max(len(opts.Configurations), 1))
+	for c := range members {
+		members[c] = make([][]int, len(groups))
+	}
+	for i := range pkgs {
+		if len(pkgs[i].Errors) > 0 {
+			// Errors for this package have already been printed, the analyzers rely on
+			// well-typed packages so skip it.
+			continue
+		}
+
+		dir := packageDir(pkgs[i])
+		for j := range groups {
+			if groups[j].Match == nil || groups[j].Match(dir) {
+				members[configurations[i]][j] = append(members[configurations[i]][j], i)
+				break
+			}
+		}
+	}
+
+	// Keep the results indexed by package so they can be written out in a deterministic
+	// order regardless of the order the packages finished in. Streaming formatters are
+	// instead given the results of each package as soon as it finishes, so they don't
+	// have to be held on to, unless the run is stable.
+	results := make([][]format.Diagnostic, len(pkgs))
+	failures := make([]error, len(pkgs))
+
+	var fixes *fixer
+	if opts.Fix && len(opts.Overlay) == 0 {
+		fixes = newFixer()
+	}
+
+	if sourced, ok := formatter.(format.Sourced); ok {
+		for filename, content := range opts.Overlay {
+			sourced.SetSource(filename, content)
+		}
+	}
+
+	streamer, ok := formatter.(format.Streamer)
+	stream := ok && streamer.Streaming() && !opts.Stable
+	var mu sync.Mutex
+
+	// Packages are analyzed one configuration after another, so that issues found under
+	// several configurations are reported by the packages of the first, see reporter.Pass.
+	for c := range members {
+		for j := range groups {
+			if len(members[c][j]) == 0 {
+				continue
+			}
+
+			analyzers := groups[j].Analyzers()
+			if len(analyzers) == 0 {
+				continue
+			}
+
+			if err := setAnalyzerFlags(analyzers, opts.AnalyzerFlags); err != nil {
+				fmt.Fprintln(out, err)
+				return ExitFailure
+			}
+
+			// Run every package in the group concurrently, bounded by the number of CPUs available.
+			var wg sync.WaitGroup
+			sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+			for _, i := range members[c][j] {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					sem <- struct{}{}
+					defer func() { <-sem }()
+
+					results[i], failures[i] = runPackage(ctx, pkgs[i], analyzers, opts.Overlay)
+					if opts.Scorer != nil && failures[i] == nil && !isExternalTest(pkgs[i]) && configurations[i] == 0 {
+						opts.Scorer.Add(pkgs[i].PkgPath, lineCount(pkgs[i]), findings(results[i]))
+					}
+
+					if stream {
+						mu.Lock()
+						defer mu.Unlock()
+
+						exitCode = writePackage(out, formatter, pkgs[i], results[i], failures[i], exitCode)
+						fixes.add(results[i])
+						results[i], failures[i] = nil, nil
+					}
+				}(i)
+			}
+			wg.Wait()
+		}
+	}
+
+	// The failures are reported in package order, followed by the diagnostics of every
+	// package sorted together, see sortDiagnostics, so that their order doesn't depend on
+	// how the packages were grouped or on the configuration they were loaded under.
+	var diagnostics []format.Diagnostic
+	for i := range pkgs {
+		if failures[i] != nil {
+			fmt.Fprintf(out, "%s: %v\n", pkgs[i].PkgPath, failures[i])
+			exitCode = ExitFailure
+		}
+		diagnostics = append(diagnostics, results[i]...)
+		fixes.add(results[i])
+	}
+
+	// Companion files are checked after every package, using the Companion of the group
+	// their directory belongs to. Their diagnostics are sorted along with the rest.
+	if len(opts.CompanionExtensions) > 0 {
+		_, companionSpan := tracing.Tracer().Start(ctx, "lintroller.companion")
+		companionDiagnostics, err := checkCompanionFiles(pkgs, groups, opts.CompanionExtensions)
+		endSpan(companionSpan, err)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			exitCode = ExitFailure
+		}
+
+		diagnostics = append(diagnostics, companionDiagnostics...)
+	}
+
+	sortDiagnostics(diagnostics)
+	exitCode = write(out, formatter, diagnostics, exitCode)
+
+	if fixes != nil {
+		if err := fixes.apply(out); err != nil {
+			fmt.Fprintln(out, errors.Wrap(err, "apply suggested fixes"))
+			exitCode = ExitFailure
+		}
+	}
+
+	var summary reporter.Summary
+	if opts.Summary {
+		summary = reporter.Summarize(opts.SummaryFiles)
+		if summarized, ok := formatter.(format.Summarized); ok {
+			summarized.SetSummary(&summary)
+		}
+	}
+
+	var report *score.Report
+	if opts.Scorer != nil {
+		report = opts.Scorer.Report()
+		if scored, ok := formatter.(format.Scored); ok {
+			scored.SetScore(report)
+		}
+	}
+
+	if err := formatter.Close(); err != nil {
+		fmt.Fprintln(out, errors.Wrap(err, "write diagnostics"))
+		exitCode = ExitFailure
+	}
+
+	if opts.Summary {
+		fmt.Fprintln(out)
+		if err := summary.Write(out); err != nil {
+			fmt.Fprintln(out, errors.Wrap(err, "write summary"))
+		}
+
+		if report != nil {
+			fmt.Fprintln(out)
+			if err := report.Write(out, opts.SummaryFiles); err != nil {
+				fmt.Fprintln(out, errors.Wrap(err, "write scores"))
+			}
+		}
+	}
+
+	return exitCode
+}
+
+// writePackage reports the failure to lint the given package, if any, to out and writes
+// its diagnostics with the given formatter, returning the updated exit code, see write.
+func writePackage(out io.Writer, formatter format.Formatter, pkg *packages.Package, diagnostics []format.Diagnostic,
+	failure error, exitCode int) int {
+	if failure != nil {
+		fmt.Fprintf(out, "%s: %v\n", pkg.PkgPath, failure)
+		exitCode = ExitFailure
+	}
+
+	return write(out, formatter, diagnostics, exitCode)
+}
+
+// write writes the given diagnostics with the given formatter and returns the exit code
+// updated with their outcome: ExitDiagnostics if any of them is an error, or ExitFailure if
+// they couldn't be written, which is reported to out.
+func write(out io.Writer, formatter format.Formatter, diagnostics []format.Diagnostic, exitCode int) int {
+	for i := range diagnostics {
+		if err := formatter.Write(&diagnostics[i]); err != nil {
+			fmt.Fprintln(out, errors.Wrap(err, "write diagnostic"))
+			return ExitFailure
+		}
+
+		if diagnostics[i].Severity == format.SeverityError && exitCode == ExitOK {
+			exitCode = ExitDiagnostics
+		}
+	}
+
+	return exitCode
+}
+
+// lineCount returns the number of lines of the Go files of the given package, excluding
+// its test files.
+func lineCount(pkg *packages.Package) int {
+	var lines int
+	for _, file := range pkg.Syntax {
+		if tf := pkg.Fset.File(file.Pos()); tf != nil && !strings.HasSuffix(tf.Name(), "_test.go") {
+			lines += tf.LineCount()
+		}
+	}
+
+	return lines
+}
+
+// load loads the packages matching the given patterns under every configuration of the
+// given options, see Options.Configurations, without duplicates, see dedupe, along with
+// the index of the configuration each package was loaded under. Packages loaded under a
+// configuration after the first are only kept if they have files that weren't selected by
+// an earlier configuration.
+func load(patterns []string, opts *Options) ([]*packages.Package, []int, error) {
+	configurations := opts.Configurations
+	if len(configurations) == 0 {
+		configurations = []Configuration{{}}
+	}
+
+	var pkgs []*packages.Package
+	var indexes []int
+	loaded := make(map[string]bool)
+
+	for i := range configurations {
+		c := &configurations[i]
+
+		cfg := &packages.Config{Mode: loadMode, Dir: opts.Dir, Tests: opts.Tests, Overlay: opts.Overlay}
+		if tags := append(append([]string(nil), opts.Tags...), c.Tags...); len(tags) > 0 {
+			cfg.BuildFlags = []string{"-tags=" + strings.Join(tags, ",")}
+		}
+		if c.GOOS != "" || c.GOARCH != "" {
+			cfg.Env = os.Environ()
+			if c.GOOS != "" {
+				cfg.Env = append(cfg.Env, "GOOS="+c.GOOS)
+			}
+			if c.GOARCH != "" {
+				cfg.Env = append(cfg.Env, "GOARCH="+c.GOARCH)
+			}
+		}
+
+		loadedPkgs, err := packages.Load(cfg, patterns...)
+		if err != nil && len(configurations) > 1 {
+			return nil, nil, errors.Wrapf(err, "load packages with %s", c)
+		} else if err != nil {
+			return nil, nil, errors.Wrap(err, "load packages")
+		}
+
+		for _, pkg := range dedupe(loadedPkgs) {
+			// Packages whose files are all excluded by this configuration, or whose files
+			// were all selected by an earlier one, don't need to be analyzed again.
+			if i > 0 && !hasNewFiles(pkg, loaded) {
+				continue
+			}
+
+			for _, file := range pkg.GoFiles {
+				loaded[file] = true
+			}
+			pkgs = append(pkgs, pkg)
+			indexes = append(indexes, i)
+		}
+	}
+
+	return pkgs, indexes, nil
+}
+
+// hasNewFiles reports whether or not the given package has Go files that aren't in the
+// given set of files that were already loaded.
+func hasNewFiles(pkg *packages.Package, loaded map[string]bool) bool {
+	for _, file := range pkg.GoFiles {
+		if !loaded[file] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dedupe returns the given packages without duplicates. When loaded along with their tests,
+// packages.Load returns every package with tests twice, once on its own and once compiled
+// with its test files, the latter being a superset of the former, along with the generated
+// main package of the test binary. Only the package compiled with its test files is kept
+// and the generated main packages are dropped, so that every file is analyzed once.
+func dedupe(pkgs []*packages.Package) []*packages.Package {
+	// Collect the packages that have a variant compiled with their test files, whose ID is
+	// of the form "<path> [<path>.test]".
+	tested := make(map[string]bool)
+	for _, pkg := range pkgs {
+		if strings.HasSuffix(pkg.ID, "]") && strings.Contains(pkg.ID, " [") {
+			tested[pkg.PkgPath] = true
+		}
+	}
+
+	seen := make(map[string]bool, len(pkgs))
+	deduped := make([]*packages.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		switch {
+		case seen[pkg.ID]:
+			continue
+		case pkg.ID == pkg.PkgPath && tested[pkg.PkgPath]:
+			// The variant compiled with its test files is analyzed instead.
+			continue
+		case pkg.Name == "main" && strings.HasSuffix(pkg.ID, ".test"):
+			// The generated main package of a test binary.
+			continue
+		}
+
+		seen[pkg.ID] = true
+		deduped = append(deduped, pkg)
+	}
+
+	return deduped
+}
+
+// isExternalTest reports whether or not the given package is an external test package,
+// i.e. a package suffixed with "_test" made up of test files only.
+func isExternalTest(pkg *packages.Package) bool {
+	return strings.HasSuffix(pkg.Name, "_test")
+}
+
+// setAnalyzerFlags sets the given flags on the given analyzers they belong to.
+func setAnalyzerFlags(analyzers []*analysis.Analyzer, flags []AnalyzerFlag) error {
+	for _, a := range analyzers {
+		for _, f := range flags {
+			if f.Analyzer != a.Name {
+				continue
+			}
+
+			if err := a.Flags.Set(f.Name, f.Value); err != nil {
+				return errors.Wrapf(err, "set flag -%s.%s", f.Analyzer, f.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findings returns the given diagnostics as findings to be scored.
+func findings(diagnostics []format.Diagnostic) []score.Finding {
+	f := make([]score.Finding, 0, len(diagnostics))
+	for i := range diagnostics {
+		f = append(f, score.Finding{Linter: diagnostics[i].Linter, Rule: diagnostics[i].Rule})
+	}
+
+	return f
+}
+
+// newDiagnostic returns the diagnostic reported by the given linter at the given position.
+func newDiagnostic(linter string, position token.Position, rule, message string) format.Diagnostic {
+	severity := format.SeverityError
+	switch {
+	case common.IsThirdPartyPath(position.Filename):
+		severity = format.SeverityInfo
+	case (reporter.IsWarning(linter) || reporter.IsRuleWarning(linter, rule)) && !reporter.IsRuleError(linter, rule) &&
+		!reporter.IsEscalated(linter, position.Filename):
+		severity = format.SeverityWarning
+	}
+
+	return format.Diagnostic{
+		Position: position,
+		Linter:   linter,
+		Rule:     rule,
+		Message:  message,
+		URL:      reporter.DocsURL(linter, rule),
+		Severity: severity,
+	}
+}
+
+// relatedLocations returns the given related information with their positions resolved.
+func relatedLocations(fset *token.FileSet, related []analysis.RelatedInformation) []format.RelatedLocation {
+	if len(related) == 0 {
+		return nil
+	}
+
+	resolved := make([]format.RelatedLocation, 0, len(related))
+	for i := range related {
+		resolved = append(resolved, format.RelatedLocation{
+			Position: fset.PositionFor(related[i].Pos, false),
+			Message:  related[i].Message,
+		})
+	}
+
+	return resolved
+}
+
+// packageDir returns the slash-separated directory of the given package relative to the
+// root of its module, or relative to the working directory if it isn't part of a module.
+func packageDir(pkg *packages.Package) string {
+	if len(pkg.GoFiles) == 0 {
+		return "."
+	}
+	dir := filepath.Dir(pkg.GoFiles[0])
+
+	var root string
+	if pkg.Module != nil && pkg.Module.Dir != "" {
+		root = pkg.Module.Dir
+	} else if wd, err := os.Getwd(); err == nil {
+		root = wd
+	}
+
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return filepath.ToSlash(dir)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// module returns the module the given package belongs to as given to analyzers, or nil if
+// it isn't part of a module.
+func module(pkg *packages.Package) *analysis.Module {
+	if pkg.Module == nil {
+		return nil
+	}
+
+	return &analysis.Module{
+		Path:      pkg.Module.Path,
+		Version:   pkg.Module.Version,
+		GoVersion: pkg.Module.GoVersion,
+	}
+}
+
+// checkCompanionFiles checks the companion files with the given extensions in the modules
+// of the given packages with the Companion of the group each file's directory belongs to,
+// and returns the resulting diagnostics sorted by position.
+func checkCompanionFiles(pkgs []*packages.Package, groups []Group, extensions []string) ([]format.Diagnostic, error) {
+	var diagnostics []format.Diagnostic
+
+	seen := make(map[string]bool)
+	for _, pkg := range pkgs {
+		if pkg.Module == nil || pkg.Module.Dir == "" || seen[pkg.Module.Dir] {
+			continue
+		}
+		root := pkg.Module.Dir
+		seen[root] = true
+
+		files, err := companion.Find(root, extensions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			dir, err := filepath.Rel(root, filepath.Dir(file))
+			if err != nil {
+				return nil, errors.Wrapf(err, "find directory of \"%s\" within its module", file)
+			}
+
+			var checker *companion.Checker
+			for j := range groups {
+				if groups[j].Match == nil || groups[j].Match(filepath.ToSlash(dir)) {
+					checker = groups[j].Companion
+					break
+				}
+			}
+			if checker == nil {
+				continue
+			}
+
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return nil, errors.Wrap(err, "read companion file")
+			}
+
+			for _, issue := range checker.Check(file, content) {
+				position := token.Position{Filename: file, Line: issue.Line, Column: 1}
+				if message, ok := reporter.Record(issue.Linter, position, issue.Message); ok {
+					diagnostics = append(diagnostics, newDiagnostic(issue.Linter, position, "", message))
+				}
+			}
+		}
+	}
+
+	sortDiagnostics(diagnostics)
+	return diagnostics, nil
+}
+
+// runPackage runs each of the given analyzers, and the analyzers they require, over a
+// single package and returns the diagnostics they reported sorted by position. Files in the
+// given overlay are read from it rather than from disk, see Options.Overlay.
+func runPackage(ctx context.Context, pkg *packages.Package, analyzers []*analysis.Analyzer,
+	overlay map[string][]byte) ([]format.Diagnostic, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "lintroller.package",
+		trace.WithAttributes(attribute.String("lintroller.package", pkg.PkgPath)))
+	defer span.End()
+
+	var diagnostics []format.Diagnostic
+	results := make(map[*analysis.Analyzer]interface{})
+
+	var run func(a *analysis.Analyzer) (interface{}, error)
+	run = func(a *analysis.Analyzer) (interface{}, error) {
+		if result, ok := results[a]; ok {
+			return result, nil
+		}
+
+		resultOf := make(map[*analysis.Analyzer]interface{}, len(a.Requires))
+		for _, req := range a.Requires {
+			result, err := run(req)
+			if err != nil {
+				return nil, err
+			}
+			resultOf[req] = result
+		}
+
+		pass := &analysis.Pass{
+			Analyzer:     a,
+			Fset:         pkg.Fset,
+			Files:        pkg.Syntax,
+			OtherFiles:   pkg.OtherFiles,
+			IgnoredFiles: pkg.IgnoredFiles,
+			Pkg:          pkg.Types,
+			TypesInfo:    pkg.TypesInfo,
+			TypesSizes:   pkg.TypesSizes,
+			TypeErrors:   pkg.TypeErrors,
+			ResultOf:     resultOf,
+			Module:       module(pkg),
+			ReadFile: func(filename string) ([]byte, error) {
+				if content, ok := overlay[filename]; ok {
+					return content, nil
+				}
+				return os.ReadFile(filename)
+			},
+			Report: func(d analysis.Diagnostic) {
+				diagnostic := newDiagnostic(a.Name, pkg.Fset.PositionFor(d.Pos, false), d.Category, d.Message)
+				diagnostic.SuggestedFixes = suggestedFixes(pkg.Fset, d.SuggestedFixes)
+				diagnostic.Related = relatedLocations(pkg.Fset, d.Related)
+				diagnostics = append(diagnostics, diagnostic)
+			},
+
+			// None of the lintroller analyzers make use of facts.
+			ImportObjectFact:  func(_ types.Object, _ analysis.Fact) bool { return false },
+			ExportObjectFact:  func(_ types.Object, _ analysis.Fact) {},
+			ImportPackageFact: func(_ *types.Package, _ analysis.Fact) bool { return false },
+			ExportPackageFact: func(_ analysis.Fact) {},
+			AllObjectFacts:    func() []analysis.ObjectFact { return nil },
+			AllPackageFacts:   func() []analysis.PackageFact { return nil },
+		}
+
+		_, analyzerSpan := tracing.Tracer().Start(ctx, "lintroller.analyzer",
+			trace.WithAttributes(attribute.String("lintroller.analyzer", a.Name)))
+		result, err := a.Run(pass)
+		endSpan(analyzerSpan, err)
+		if err != nil {
+			return nil, errors.Wrapf(err, "run analyzer %q", a.Name)
+		}
+		results[a] = result
+
+		return result, nil
+	}
+
+	for _, a := range analyzers {
+		if _, err := run(a); err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	span.SetAttributes(attribute.Int("lintroller.diagnostics", len(diagnostics)))
+
+	sortDiagnostics(diagnostics)
+	return diagnostics, nil
+}
+
+// endSpan ends the given span, marking it as failed if the given error isn't nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// sortDiagnostics sorts the given diagnostics by position, then by the linter and rule that
+// reported them, then by message, so that diagnostics are always written in the same order
+// regardless of the order the analyzers reporting them were ran in.
+func sortDiagnostics(diagnostics []format.Diagnostic) {
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := &diagnostics[i], &diagnostics[j]
+		if a.Position.Filename != b.Position.Filename {
+			return a.Position.Filename < b.Position.Filename
+		}
+		if a.Position.Line != b.Position.Line {
+			return a.Position.Line < b.Position.Line
+		}
+		if a.Position.Column != b.Position.Column {
+			return a.Position.Column < b.Position.Column
+		}
+		if a.Linter != b.Linter {
+			return a.Linter < b.Linter
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.Message < b.Message
+	})
+}
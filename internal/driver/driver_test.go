@@ -0,0 +1,322 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package driver
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/doculint"
+	"github.com/getoutreach/lintroller/internal/format"
+	"github.com/getoutreach/lintroller/internal/receiver"
+	"github.com/getoutreach/lintroller/internal/todo"
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+// fixture copies the module in testdata with the given name to a temporary directory and
+// returns its path, so that it can be modified and isn't matched by the ignored testdata
+// paths.
+func fixture(t *testing.T, name string) string {
+	t.Helper()
+
+	dst := t.TempDir()
+	src := filepath.Join("testdata", name)
+
+	entries, err := os.ReadDir(src)
+	assert.NilError(t, err)
+
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(src, entry.Name()))
+		assert.NilError(t, err)
+		assert.NilError(t, os.WriteFile(filepath.Join(dst, entry.Name()), content, 0o600))
+	}
+
+	return dst
+}
+
+// run runs the given analyzers over every package of the module in the given directory
+// with the given options, returning the exit code and the output of the run.
+func run(t *testing.T, dir string, analyzers []*analysis.Analyzer, opts Options) (int, string) {
+	t.Helper()
+
+	var out bytes.Buffer
+	opts.Output = &out
+	opts.Dir = dir
+
+	groups := []Group{{
+		Analyzers: func() []*analysis.Analyzer {
+			return analyzers
+		},
+	}}
+
+	return Run([]string{"./..."}, groups, &opts), out.String()
+}
+
+func TestRunFixRewrapsDocComments(t *testing.T) {
+	dir := fixture(t, "rewrap")
+
+	doculint.SetLineWidthOptions(true, 40)
+	defer doculint.SetLineWidthOptions(false, doculint.DefaultMaxLineWidth)
+
+	exitCode, out := run(t, dir, []*analysis.Analyzer{&doculint.Analyzer}, Options{Fix: true})
+	assert.Equal(t, exitCode, ExitDiagnostics, out)
+
+	content, err := os.ReadFile(filepath.Join(dir, "rewrap.go"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), `// Package rewrap has doc comments wider
+// than forty characters.
+package rewrap
+
+// Value is documented by a comment that
+// is far wider than forty characters.
+var Value = 1
+`)
+
+	// Every line fits once the fixes are applied.
+	exitCode, out = run(t, dir, []*analysis.Analyzer{&doculint.Analyzer}, Options{})
+	assert.Equal(t, exitCode, ExitOK, out)
+}
+
+func TestRunLintsTestFiles(t *testing.T) {
+	dir := fixture(t, "tests")
+	analyzers := []*analysis.Analyzer{receiver.NewAnalyzerWithOptions(receiver.DefaultMaxLength)}
+
+	// Test files are loaded, but skipped unless the linter is configured to lint them.
+	exitCode, out := run(t, dir, analyzers, Options{Tests: true})
+	assert.Equal(t, exitCode, ExitOK, out)
+
+	common.SetTestDetection(common.TestDetection{LintTests: []string{"receiver"}})
+	defer common.SetTestDetection(common.TestDetection{})
+
+	exitCode, out = run(t, dir, analyzers, Options{Tests: true})
+	assert.Equal(t, exitCode, ExitDiagnostics, out)
+
+	// The internal test file is reported once even though its package is loaded both on its
+	// own and compiled with its tests, and the external test package is reported too, both
+	// sorted by file.
+	var reported []string
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "(receiver") {
+			reported = append(reported, filepath.Base(strings.SplitN(line, ":", 2)[0]))
+		}
+	}
+	assert.DeepEqual(t, reported, []string{"external_test.go", "tests_test.go"})
+
+	// Test files aren't linted when tests aren't loaded.
+	exitCode, out = run(t, dir, analyzers, Options{})
+	assert.Equal(t, exitCode, ExitOK, out)
+}
+
+func TestRunLintsFilesGuardedByBuildConstraints(t *testing.T) {
+	analyzers := []*analysis.Analyzer{receiver.NewAnalyzerWithOptions(receiver.DefaultMaxLength)}
+
+	// reported returns the base names of the files issues were reported in, in order.
+	reported := func(out string) []string {
+		var files []string
+		for _, line := range strings.Split(out, "\n") {
+			if strings.Contains(line, "(receiver") {
+				files = append(files, filepath.Base(strings.SplitN(line, ":", 2)[0]))
+			}
+		}
+		return files
+	}
+
+	tt := []struct {
+		name     string
+		opts     Options
+		expected []string
+	}{
+		{
+			name:     "Skips guarded files by default",
+			expected: []string{"tags.go"},
+		},
+		{
+			name:     "Lints files selected by tags",
+			opts:     Options{Tags: []string{"integration"}},
+			expected: []string{"integration.go", "tags.go"},
+		},
+		{
+			name: "Lints files selected by every configuration once",
+			opts: Options{Configurations: []Configuration{
+				{},
+				{GOOS: "windows"},
+				{GOOS: "windows", Tags: []string{"integration"}},
+				{GOOS: "windows", GOARCH: "arm64"},
+			}},
+			expected: []string{"integration.go", "tags.go", "windows.go"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Issues are only reported once per process, so each run needs its own copy.
+			dir := fixture(t, "tags")
+
+			exitCode, out := run(t, dir, analyzers, test.opts)
+			assert.Equal(t, exitCode, ExitDiagnostics, out)
+			assert.DeepEqual(t, reported(out), test.expected)
+		})
+	}
+}
+
+func TestRunLintsFilesExcludedByBuildConstraints(t *testing.T) {
+	analyzers := []*analysis.Analyzer{&todo.Analyzer}
+
+	// reported returns the base names of the files issues were reported in, in order.
+	reported := func(out string) []string {
+		var files []string
+		for _, line := range strings.Split(out, "\n") {
+			if strings.Contains(line, "(todo") {
+				files = append(files, filepath.Base(strings.SplitN(line, ":", 2)[0]))
+			}
+		}
+		return files
+	}
+
+	tt := []struct {
+		name     string
+		excluded bool
+		opts     Options
+		exitCode int
+		expected []string
+	}{
+		{
+			name: "Skips excluded files by default",
+		},
+		{
+			name:     "Lints excluded files when enabled",
+			excluded: true,
+			exitCode: ExitDiagnostics,
+			expected: []string{"integration.go", "windows.go"},
+		},
+		{
+			name:     "Lints files excluded by some configurations once",
+			excluded: true,
+			opts:     Options{Configurations: []Configuration{{}, {GOOS: "windows"}}},
+			exitCode: ExitDiagnostics,
+			expected: []string{"integration.go", "windows.go"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			common.SetLintExcluded(test.excluded)
+			t.Cleanup(func() { common.SetLintExcluded(false) })
+
+			// Issues are only reported once per process, so each run needs its own copy.
+			dir := fixture(t, "tags")
+
+			exitCode, out := run(t, dir, analyzers, test.opts)
+			assert.Equal(t, exitCode, test.exitCode, out)
+			assert.DeepEqual(t, reported(out), test.expected)
+		})
+	}
+}
+
+func TestRunStable(t *testing.T) {
+	dir := fixture(t, "tests")
+	analyzers := []*analysis.Analyzer{receiver.NewAnalyzerWithOptions(receiver.DefaultMaxLength)}
+
+	common.SetTestDetection(common.TestDetection{LintTests: []string{"receiver"}})
+	defer common.SetTestDetection(common.TestDetection{})
+
+	// The issues of every package are written sorted even though the format streams them.
+	var w bytes.Buffer
+	exitCode, out := run(t, dir, analyzers, Options{Tests: true, Formatter: format.NewEditor(&w), Stable: true})
+	assert.Equal(t, exitCode, ExitDiagnostics, out)
+
+	var reported []string
+	for _, line := range strings.Split(strings.TrimSpace(w.String()), "\n") {
+		reported = append(reported, filepath.Base(strings.SplitN(line, ":", 2)[0]))
+	}
+	assert.DeepEqual(t, reported, []string{"external_test.go", "tests_test.go"})
+}
+
+func TestRunReportsAgainInLaterRuns(t *testing.T) {
+	dir := fixture(t, "tests")
+	analyzers := []*analysis.Analyzer{receiver.NewAnalyzerWithOptions(receiver.DefaultMaxLength)}
+
+	common.SetTestDetection(common.TestDetection{LintTests: []string{"receiver"}})
+	defer common.SetTestDetection(common.TestDetection{})
+
+	// Diagnostics are only deduplicated within a run, so a second run in the same process
+	// reports everything the first one did.
+	first, firstOut := run(t, dir, analyzers, Options{Tests: true})
+	assert.Equal(t, first, ExitDiagnostics, firstOut)
+
+	second, secondOut := run(t, dir, analyzers, Options{Tests: true})
+	assert.Equal(t, second, ExitDiagnostics, secondOut)
+	assert.Equal(t, secondOut, firstOut)
+}
+
+func TestRunOverlay(t *testing.T) {
+	dir := fixture(t, "tests")
+	analyzers := []*analysis.Analyzer{receiver.NewAnalyzerWithOptions(receiver.DefaultMaxLength)}
+
+	// The overlay replaces a file on disk and adds one that doesn't exist, and the source
+	// written alongside the issues is that of the overlay.
+	var w bytes.Buffer
+	exitCode, out := run(t, dir, analyzers, Options{
+		Formatter: format.NewTextWithContext(&w, 0),
+		Overlay: map[string][]byte{
+			filepath.Join(dir, "tests.go"): []byte("package tests\n\n// T is a type.\ntype T struct{}\n\n" +
+				"// M is a method.\nfunc (self T) M() {}\n"),
+			filepath.Join(dir, "buffer.go"): []byte("package tests\n\n// N is a method.\nfunc (this T) N() {}\n"),
+		},
+	})
+	assert.Equal(t, exitCode, ExitDiagnostics, out)
+
+	var reported []string
+	for _, line := range strings.Split(strings.TrimSpace(w.String()), "\n") {
+		if rel, ok := strings.CutPrefix(line, dir+string(filepath.Separator)); ok {
+			line = strings.SplitN(rel, " ", 2)[0]
+		}
+		reported = append(reported, line)
+	}
+	assert.DeepEqual(t, reported, []string{
+		"buffer.go:4:7:", "4\tfunc (this T) N() {}",
+		"tests.go:7:7:", "7\tfunc (self T) M() {}",
+	})
+
+	_, err := os.Stat(filepath.Join(dir, "buffer.go"))
+	assert.Assert(t, os.IsNotExist(err))
+}
+
+func TestSortDiagnostics(t *testing.T) {
+	at := func(filename string, line, column int) token.Position {
+		return token.Position{Filename: filename, Line: line, Column: column}
+	}
+
+	diagnostics := []format.Diagnostic{
+		{Position: at("b.go", 1, 1), Linter: "todo", Message: "a"},
+		{Position: at("a.go", 2, 1), Linter: "todo", Message: "a"},
+		{Position: at("a.go", 1, 5), Linter: "todo", Message: "a"},
+		{Position: at("a.go", 1, 1), Linter: "doculint", Rule: "spelling", Message: "b"},
+		{Position: at("a.go", 1, 1), Linter: "todo", Message: "a"},
+		{Position: at("a.go", 1, 1), Linter: "doculint", Rule: "spelling", Message: "a"},
+		{Position: at("a.go", 1, 1), Linter: "doculint", Rule: "comment", Message: "c"},
+	}
+	sortDiagnostics(diagnostics)
+
+	var got []string
+	for i := range diagnostics {
+		d := &diagnostics[i]
+		got = append(got, fmt.Sprintf("%s %s/%s %s", d.Position, d.Linter, d.Rule, d.Message))
+	}
+	assert.DeepEqual(t, got, []string{
+		"a.go:1:1 doculint/comment c",
+		"a.go:1:1 doculint/spelling a",
+		"a.go:1:1 doculint/spelling b",
+		"a.go:1:1 todo/ a",
+		"a.go:1:5 todo/ a",
+		"a.go:2:1 todo/ a",
+		"b.go:1:1 todo/ a",
+	})
+}
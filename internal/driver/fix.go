@@ -0,0 +1,193 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements applying the fixes suggested for the issues reported
+// during a run, see Options.Fix.
+
+package driver
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/getoutreach/lintroller/internal/format"
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/analysis"
+)
+
+// suggestedFixes returns the given suggested fixes with their positions resolved to the
+// files and offsets they refer to.
+func suggestedFixes(fset *token.FileSet, fixes []analysis.SuggestedFix) []format.SuggestedFix {
+	if len(fixes) == 0 {
+		return nil
+	}
+
+	resolved := make([]format.SuggestedFix, 0, len(fixes))
+	for i := range fixes {
+		fix := format.SuggestedFix{Message: fixes[i].Message}
+		for _, edit := range fixes[i].TextEdits {
+			start := fset.PositionFor(edit.Pos, false)
+
+			end := start
+			if edit.End.IsValid() {
+				end = fset.PositionFor(edit.End, false)
+			}
+
+			fix.Edits = append(fix.Edits, format.TextEdit{
+				Filename: start.Filename,
+				Start:    start.Offset,
+				End:      end.Offset,
+				NewText:  edit.NewText,
+			})
+		}
+		resolved = append(resolved, fix)
+	}
+
+	return resolved
+}
+
+// fixer collects the fixes suggested for the issues reported during a run and applies them
+// once the run finishes. A nil fixer collects nothing.
+type fixer struct {
+	mu    sync.Mutex
+	fixes []format.SuggestedFix
+}
+
+// newFixer returns a fixer that hasn't collected any fixes yet.
+func newFixer() *fixer {
+	return &fixer{}
+}
+
+// add collects the first fix suggested for each of the given diagnostics, if any.
+func (f *fixer) add(diagnostics []format.Diagnostic) {
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := range diagnostics {
+		if len(diagnostics[i].SuggestedFixes) > 0 {
+			f.fixes = append(f.fixes, diagnostics[i].SuggestedFixes[0])
+		}
+	}
+}
+
+// apply applies every collected fix to the files it concerns. Fixes overlapping a fix that
+// was already accepted are skipped and reported to out, running again applies them.
+func (f *fixer) apply(out io.Writer) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	edits := make(map[string][]format.TextEdit)
+	var skipped int
+
+fixes:
+	for i := range f.fixes {
+		for _, edit := range f.fixes[i].Edits {
+			if overlaps(edits[edit.Filename], &edit) {
+				skipped++
+				continue fixes
+			}
+		}
+
+		for _, edit := range f.fixes[i].Edits {
+			if !contains(edits[edit.Filename], &edit) {
+				edits[edit.Filename] = append(edits[edit.Filename], edit)
+			}
+		}
+	}
+
+	filenames := make([]string, 0, len(edits))
+	for filename := range edits {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		if err := applyEdits(filename, edits[filename]); err != nil {
+			return err
+		}
+	}
+
+	if skipped > 0 {
+		fmt.Fprintf(out, "skipped %d suggested fixes that conflict with other fixes, run again to apply them\n", skipped)
+	}
+
+	return nil
+}
+
+// contains reports whether or not the given edits of a file contain an edit identical to the
+// given edit.
+func contains(edits []format.TextEdit, edit *format.TextEdit) bool {
+	for i := range edits {
+		if sameEdit(&edits[i], edit) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameEdit reports whether or not the given edits of the same file are identical.
+func sameEdit(a, b *format.TextEdit) bool {
+	return a.Start == b.Start && a.End == b.End && bytes.Equal(a.NewText, b.NewText)
+}
+
+// overlaps reports whether or not the given edit overlaps any of the given edits of the same
+// file. Identical edits don't overlap so that a fix suggested twice is applied once.
+func overlaps(edits []format.TextEdit, edit *format.TextEdit) bool {
+	for i := range edits {
+		if sameEdit(&edits[i], edit) {
+			continue
+		}
+
+		if edit.Start < edits[i].End && edits[i].Start < edit.End {
+			return true
+		}
+
+		// Insertions at the same offset can't be ordered.
+		if edit.Start == edits[i].Start && (edit.Start == edit.End || edits[i].Start == edits[i].End) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyEdits applies the given non-overlapping edits to the file with the given name.
+func applyEdits(filename string, edits []format.TextEdit) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return errors.Wrap(err, "stat file to fix")
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return errors.Wrap(err, "read file to fix")
+	}
+
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].Start < edits[j].Start
+	})
+
+	var fixed bytes.Buffer
+	var last int
+	for i := range edits {
+		if edits[i].End > len(content) {
+			return fmt.Errorf("suggested fix for \"%s\" is out of range of the file", filename)
+		}
+
+		fixed.Write(content[last:edits[i].Start])
+		fixed.Write(edits[i].NewText)
+		last = edits[i].End
+	}
+	fixed.Write(content[last:])
+
+	return errors.Wrapf(os.WriteFile(filename, fixed.Bytes(), info.Mode().Perm()), "write fixed file \"%s\"", filename)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package driver
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/format"
+	"gotest.tools/v3/assert"
+)
+
+func TestFixerApply(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "foo.go")
+	assert.NilError(t, os.WriteFile(filename, []byte("// Teh foo is recieved.\npackage foo\n"), 0o600))
+
+	edit := func(start, end int, text string) format.SuggestedFix {
+		return format.SuggestedFix{Edits: []format.TextEdit{
+			{Filename: filename, Start: start, End: end, NewText: []byte(text)},
+		}}
+	}
+
+	f := newFixer()
+	f.add([]format.Diagnostic{
+		{SuggestedFixes: []format.SuggestedFix{edit(3, 6, "The"), edit(3, 6, "Ignored")}},
+		{SuggestedFixes: []format.SuggestedFix{edit(14, 22, "received")}},
+		// The same fix reported twice is applied once.
+		{SuggestedFixes: []format.SuggestedFix{edit(14, 22, "received")}},
+		// Overlaps the fix above, so it is skipped.
+		{SuggestedFixes: []format.SuggestedFix{edit(10, 16, "conflict")}},
+		{},
+	})
+
+	var out bytes.Buffer
+	assert.NilError(t, f.apply(&out))
+	assert.Equal(t, out.String(), "skipped 1 suggested fixes that conflict with other fixes, run again to apply them\n")
+
+	content, err := os.ReadFile(filename)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "// The foo is received.\npackage foo\n")
+}
+
+func TestFixerNil(t *testing.T) {
+	var f *fixer
+	f.add([]format.Diagnostic{{SuggestedFixes: []format.SuggestedFix{{Message: "noop"}}}})
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements expanding the targets given on the command line, e.g.
+// directories and shell-style globs, into the package patterns the packages are loaded with.
+
+package driver
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+)
+
+// globMeta contains the characters that make a target a shell-style glob.
+const globMeta = "*?["
+
+// ExpandTargets expands the given targets, resolved in the given directory (the working
+// directory if empty), into package patterns and returns them along with the targets, or
+// the directories matched by them, that were skipped because they contain no Go files or,
+// for globs, match nothing:
+//
+//   - A glob, e.g. "./internal/*/api" or "./pkg/**", is expanded into every directory
+//     matching it that contains Go files, see common.MatchGlob. Like "./...", hidden
+//     directories and directories named testdata or vendor, or starting with an
+//     underscore, are never matched. A .go file matching it selects its directory.
+//   - A directory, e.g. "./cmd/foo", that contains no Go files is skipped.
+//   - Every other target, e.g. "./internal/..." or an import path, is passed to the
+//     loader as it is.
+//
+// Each pattern is only returned once.
+func ExpandTargets(dir string, targets []string) (patterns, skipped []string, err error) {
+	if dir == "" {
+		if dir, err = os.Getwd(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	seen := make(map[string]bool)
+	add := func(pattern string) {
+		if !seen[pattern] {
+			seen[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	for _, target := range targets {
+		switch {
+		case strings.Contains(target, "=") || strings.Contains(target, "..."):
+			// Queries like "file=foo.go", and wildcards of the go command, are left to it.
+			add(target)
+		case strings.ContainsAny(target, globMeta):
+			dirs, err := expandGlob(dir, target)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if len(dirs) == 0 {
+				skipped = append(skipped, target)
+			}
+
+			for _, d := range dirs {
+				if hasGoFiles(resolve(dir, d)) {
+					add(d)
+				} else {
+					skipped = append(skipped, d)
+				}
+			}
+		case isDir(resolve(dir, target)) && !hasGoFiles(resolve(dir, target)):
+			skipped = append(skipped, target)
+		default:
+			add(target)
+		}
+	}
+
+	return patterns, skipped, nil
+}
+
+// expandGlob returns the directories, relative to the given directory and prefixed with
+// "./" unless the glob is absolute, matching the given glob or containing a .go file
+// matching it, in lexical order. Only the part of the tree beneath the static prefix of
+// the glob, e.g. "internal" for "./internal/*/api", is walked.
+func expandGlob(dir, glob string) ([]string, error) {
+	if err := common.ValidateGlob(glob); err != nil {
+		return nil, err
+	}
+
+	absolute := filepath.IsAbs(glob)
+	clean := filepath.ToSlash(filepath.Clean(glob))
+
+	// The static prefix is made up of the segments before the first one with a glob
+	// character in it.
+	segments := strings.Split(clean, "/")
+	var prefix []string
+	for _, segment := range segments {
+		if strings.ContainsAny(segment, globMeta) {
+			break
+		}
+		prefix = append(prefix, segment)
+	}
+
+	root := filepath.FromSlash(strings.Join(prefix, "/"))
+	if absolute && root == "" {
+		root = "/"
+	}
+	if !absolute {
+		root = filepath.Join(dir, root)
+	}
+
+	var dirs []string
+	seen := make(map[string]bool)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Like a shell, unreadable directories and a missing static prefix match nothing.
+			return nil
+		}
+
+		if entry.IsDir() && path != root && skipDir(entry.Name()) {
+			return filepath.SkipDir
+		}
+
+		name := path
+		if !absolute {
+			rel, relErr := filepath.Rel(dir, path)
+			if relErr != nil {
+				return nil
+			}
+			name = rel
+		}
+
+		if !common.MatchGlob(clean, filepath.ToSlash(name)) {
+			return nil
+		}
+
+		switch {
+		case entry.IsDir():
+		case strings.HasSuffix(name, ".go"):
+			name = filepath.Dir(name)
+		default:
+			return nil
+		}
+
+		if !absolute && name != "." && !strings.HasPrefix(name, "..") {
+			name = "./" + filepath.ToSlash(name)
+		}
+		if !seen[name] {
+			seen[name] = true
+			dirs = append(dirs, name)
+		}
+
+		return nil
+	})
+
+	return dirs, err
+}
+
+// resolve returns the given path resolved in the given directory, unless it is absolute.
+func resolve(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
+
+// skipDir reports whether or not the directory with the given name is skipped when
+// expanding globs, like the go command skips it when expanding "./...".
+func skipDir(name string) bool {
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" || name == "vendor"
+}
+
+// isDir reports whether or not the given path is a directory.
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// hasGoFiles reports whether or not the given directory directly contains a .go file.
+func hasGoFiles(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".go") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package driver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestExpandTargets(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"cmd/foo/main.go",
+		"cmd/bar/main.go",
+		"cmd/README.md",
+		"internal/a/api/api.go",
+		"internal/b/api/doc.md",
+		"internal/c/c.go",
+		"internal/testdata/api/api.go",
+		"docs/rules.md",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		assert.NilError(t, os.WriteFile(path, nil, 0o600))
+	}
+
+	tt := []struct {
+		name             string
+		targets          []string
+		expectedPatterns []string
+		expectedSkipped  []string
+	}{
+		{
+			name:             "Passes wildcards, queries, and import paths through",
+			targets:          []string{"./internal/...", "file=cmd/foo/main.go", "github.com/getoutreach/lintroller/cmd/lintroller"},
+			expectedPatterns: []string{"./internal/...", "file=cmd/foo/main.go", "github.com/getoutreach/lintroller/cmd/lintroller"},
+		},
+		{
+			name:             "Expands globs into the directories with Go files",
+			targets:          []string{"./internal/*/api"},
+			expectedPatterns: []string{"./internal/a/api"},
+			expectedSkipped:  []string{"./internal/b/api"},
+		},
+		{
+			name:             "Expands recursive globs, skipping testdata",
+			targets:          []string{"./internal/**/*.go"},
+			expectedPatterns: []string{"./internal/a/api", "./internal/c"},
+		},
+		{
+			name:             "Skips directories without Go files",
+			targets:          []string{"./cmd/*", "./docs"},
+			expectedPatterns: []string{"./cmd/bar", "./cmd/foo"},
+			expectedSkipped:  []string{"./docs"},
+		},
+		{
+			name:             "Skips globs matching nothing",
+			targets:          []string{"./pkg/*", "./cmd/foo", "./cmd/f*"},
+			expectedPatterns: []string{"./cmd/foo"},
+			expectedSkipped:  []string{"./pkg/*"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			patterns, skipped, err := ExpandTargets(dir, test.targets)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, patterns, test.expectedPatterns)
+			assert.DeepEqual(t, skipped, test.expectedSkipped)
+		})
+	}
+}
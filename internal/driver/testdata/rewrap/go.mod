@@ -0,0 +1,3 @@
+module example.com/rewrap
+
+go 1.22
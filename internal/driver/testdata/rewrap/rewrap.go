@@ -0,0 +1,5 @@
+// Package rewrap has doc comments wider than forty characters.
+package rewrap
+
+// Value is documented by a comment that is far wider than forty characters.
+var Value = 1
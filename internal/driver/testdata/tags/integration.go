@@ -0,0 +1,11 @@
+//go:build integration
+
+package tags
+
+// TODO Drop once the integration suite is gone.
+
+type integration struct{}
+
+func (self integration) value() int {
+	return 1
+}
@@ -0,0 +1,14 @@
+// Package tags has receivers named self, and TODOs without an owner, in files guarded by
+// build constraints.
+package tags
+
+type shared struct{}
+
+func (self shared) value() int {
+	return 1
+}
+
+// Value returns one.
+func Value() int {
+	return shared{}.value()
+}
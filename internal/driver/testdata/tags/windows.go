@@ -0,0 +1,11 @@
+//go:build windows
+
+package tags
+
+// TODO Drop once windows is supported natively.
+
+type windows struct{}
+
+func (self windows) value() int {
+	return 1
+}
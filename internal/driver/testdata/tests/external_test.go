@@ -0,0 +1,19 @@
+package tests_test
+
+import (
+	"testing"
+
+	"example.com/tests"
+)
+
+type external struct{}
+
+func (this external) value() int {
+	return tests.Value()
+}
+
+func TestExternal(t *testing.T) {
+	if (external{}).value() != 1 {
+		t.Fatal("value is not one")
+	}
+}
@@ -0,0 +1,7 @@
+// Package tests has a receiver named self in its test files only.
+package tests
+
+// Value returns one.
+func Value() int {
+	return 1
+}
@@ -0,0 +1,15 @@
+package tests
+
+import "testing"
+
+type fake struct{}
+
+func (self *fake) value() int {
+	return Value()
+}
+
+func TestValue(t *testing.T) {
+	if (&fake{}).value() != 1 {
+		t.Fatal("value is not one")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package dupstring contains the necessary logic for the dupstring linter. The dupstring
+// linter ensures that string literals aren't repeated throughout a package, since copies
+// of the same literal drift apart over time while a named constant can't.
+package dupstring
+
+import (
+	"go/ast"
+	"go/token"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the dupstring linter.
+const name = "dupstring"
+
+// doc defines the help text for the dupstring linter.
+const doc = `Ensures that string literals are repeated at most -maxOccurrences times within a
+package. Repeated literals should be extracted to a named constant instead.
+
+Literals shorter than -minLength characters, struct tags, import paths, and the values of
+constant declarations are not counted.`
+
+// Default limits used when none are given.
+const (
+	// DefaultMaxOccurrences is the number of times a string literal may occur within a
+	// package.
+	DefaultMaxOccurrences = 2
+
+	// DefaultMinLength is the number of characters a string literal needs to be counted.
+	DefaultMinLength = 5
+)
+
+// Analyzer exports the dupstring analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      dupstring,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_maxOccurrences, _minLength int) *analysis.Analyzer {
+	maxOccurrences = _maxOccurrences
+	minLength = _minLength
+	return &Analyzer
+}
+
+// Variables that get collected via flags.
+var (
+	// maxOccurrences is the number of times a string literal may occur within a package.
+	maxOccurrences int
+
+	// minLength is the number of characters a string literal needs to be counted.
+	minLength int
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.IntVar(&maxOccurrences, "maxOccurrences", DefaultMaxOccurrences,
+		"the number of times a string literal may occur within a package")
+	Analyzer.Flags.IntVar(&minLength, "minLength", DefaultMinLength, "the number of characters a string literal needs to be counted")
+}
+
+// dupstring is the function that gets passed to the Analyzer which runs the actual
+// analysis for the dupstring linter on a set of files.
+func dupstring(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	limit := maxOccurrences
+	if limit <= 0 {
+		limit = DefaultMaxOccurrences
+	}
+
+	length := minLength
+	if length <= 0 {
+		length = DefaultMinLength
+	}
+
+	var files []*ast.File
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		files = append(files, file)
+	}
+
+	checkFiles(pass, files, limit, length)
+
+	return nil, nil
+}
+
+// checkFiles reports the first occurrence of every string literal of at least minLength
+// characters that occurs more than maxOccurrences times within the given files of a single
+// package.
+func checkFiles(r reporter.Reporter, files []*ast.File, maxOccurrences, minLength int) {
+	occurrences := make(map[string][]token.Pos)
+
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.ImportSpec:
+				// Import paths can't be constants.
+				return false
+			case *ast.Field:
+				// Struct tags can't be constants, but the type of the field is still checked.
+				if node.Type != nil {
+					ast.Inspect(node.Type, func(n ast.Node) bool {
+						record(occurrences, n, minLength)
+						return true
+					})
+				}
+				return false
+			case *ast.GenDecl:
+				// Literals given to constants are already named.
+				return node.Tok != token.CONST
+			}
+
+			record(occurrences, n, minLength)
+			return true
+		})
+	}
+
+	values := make([]string, 0, len(occurrences))
+	for value, positions := range occurrences {
+		if len(positions) > maxOccurrences {
+			values = append(values, value)
+		}
+	}
+
+	// Report in the order the literals first occur so that reports are deterministic.
+	sort.Slice(values, func(i, j int) bool {
+		return occurrences[values[i]][0] < occurrences[values[j]][0]
+	})
+
+	for _, value := range values {
+		r.Reportf(occurrences[value][0], "string literal %s occurs %d times in this package, "+
+			"extract it to a named constant", strconv.Quote(value), len(occurrences[value]))
+	}
+}
+
+// record adds the position of the given node to occurrences if it is a string literal of at
+// least minLength characters.
+func record(occurrences map[string][]token.Pos, n ast.Node, minLength int) {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil || utf8.RuneCountInString(value) < minLength {
+		return
+	}
+
+	occurrences[value] = append(occurrences[value], lit.Pos())
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package dupstring
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "dupstring")
+}
+
+func TestCheckFiles(t *testing.T) {
+	tt := []struct {
+		name     string
+		srcs     []string
+		expected []string
+	}{
+		{
+			name: "Reports literals repeated across files",
+			srcs: []string{
+				`package foo
+
+func a() []string { return []string{"account_id", "account_id", "ok"} }`,
+				`package foo
+
+func b() string { return "account_id" }`,
+			},
+			expected: []string{
+				`string literal "account_id" occurs 3 times in this package, extract it to a named constant`,
+			},
+		},
+		{
+			name: "Allows literals repeated at most the maximum times",
+			srcs: []string{`package foo
+
+func a() []string { return []string{"account_id", "account_id"} }`},
+			expected: nil,
+		},
+		{
+			name: "Ignores short literals, struct tags, imports, and constants",
+			srcs: []string{`package foo
+
+import "strings"
+
+const accountID = "account_id"
+
+type user struct {
+	ID   string ` + "`json:\"account_id\"`" + `
+	Name string ` + "`json:\"account_id\"`" + `
+	Team string ` + "`json:\"account_id\"`" + `
+}
+
+func a() []string { return []string{"id", "id", "id", strings.ToLower(accountID)} }`},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+
+			var files []*ast.File
+			for i, src := range test.srcs {
+				file, err := parser.ParseFile(fset, fmt.Sprintf("foo%d.go", i), src, 0)
+				assert.NilError(t, err)
+				files = append(files, file)
+			}
+
+			var r linttest.Recorder
+			checkFiles(&r, files, DefaultMaxOccurrences, DefaultMinLength)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Package dupstring repeats string literals.
+package dupstring
+
+var accounts = []string{
+	"account_id", // want `string literal "account_id" occurs 3 times in this package`
+	"account_id",
+	"account_id",
+}
+
+var users = []string{
+	"user_id", //nolint:dupstring // Why: suppressed issues aren't reported.
+	"user_id",
+	"user_id",
+}
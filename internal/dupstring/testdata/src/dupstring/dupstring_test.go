@@ -0,0 +1,3 @@
+package dupstring_test
+
+var teams = []string{"team_id", "team_id", "team_id"}
@@ -0,0 +1,3 @@
+package dupstring
+
+var teams = []string{"team_id", "team_id", "team_id"}
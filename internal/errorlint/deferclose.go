@@ -0,0 +1,119 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that the errors of closing writable
+// resources in deferred calls aren't discarded, since a failed Close can lose data.
+
+package errorlint
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// DefaultWritableTypes are the types whose Close error must always be checked, written as
+// the import path of their package followed by a dot and their name. Writers buffer data
+// that is only flushed, and can only fail, when they are closed.
+var DefaultWritableTypes = []string{
+	"os.File",
+	"io.WriteCloser",
+	"archive/tar.Writer",
+	"archive/zip.Writer",
+	"compress/gzip.Writer",
+	"compress/zlib.Writer",
+}
+
+// checkDeferredCloses reports every deferred call to the Close method of a value of one of
+// the given writable types within the given function body whose error is discarded, e.g.
+// defer f.Close(). Files opened with os.Open are read-only, so closing them can't lose data
+// and they are skipped.
+func checkDeferredCloses(r reporter.Reporter, info *types.Info, body *ast.BlockStmt, writable []string) {
+	// Gather every assignment first so that where a closed file was opened can be looked up.
+	assignments := gatherAssignments(info, body)
+
+	check := func(call *ast.CallExpr, pos ast.Node) {
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Close" || len(call.Args) != 0 {
+			return
+		}
+
+		typeName := qualifiedTypeName(info.TypeOf(sel.X))
+		if typeName == "" || !contains(writable, typeName) {
+			return
+		}
+
+		if ident, ok := ast.Unparen(sel.X).(*ast.Ident); ok && typeName == "os.File" {
+			opened, isCall := lastAssigned(assignments[info.ObjectOf(ident)], pos.Pos()).(*ast.CallExpr)
+			if isCall && funcName(info, opened.Fun, "os") == "Open" {
+				return
+			}
+		}
+
+		reporter.ReportRule(r, RuleDeferClose, pos.Pos(), "deferred %s() discards the error, closing writable %s values can lose data, "+
+			"check it explicitly or assign it to a named error return", calleeName(call.Fun), typeName)
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		stmt, ok := n.(*ast.DeferStmt)
+		if !ok {
+			return true
+		}
+
+		// defer f.Close()
+		check(stmt.Call, stmt)
+
+		// defer func() { f.Close() }() and defer func() { _ = f.Close() }()
+		lit, ok := ast.Unparen(stmt.Call.Fun).(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+		for _, s := range lit.Body.List {
+			switch s := s.(type) {
+			case *ast.ExprStmt:
+				if call, ok := ast.Unparen(s.X).(*ast.CallExpr); ok {
+					check(call, s)
+				}
+			case *ast.AssignStmt:
+				if len(s.Lhs) != 1 || len(s.Rhs) != 1 {
+					continue
+				}
+				if blank, ok := s.Lhs[0].(*ast.Ident); !ok || blank.Name != "_" {
+					continue
+				}
+				if call, ok := ast.Unparen(s.Rhs[0]).(*ast.CallExpr); ok {
+					check(call, s)
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+// qualifiedTypeName returns the name of the given named type, or of the named type the
+// given pointer points to, qualified with the import path of its package, e.g. "os.File".
+func qualifiedTypeName(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return ""
+	}
+
+	return named.Obj().Pkg().Path() + "." + named.Obj().Name()
+}
+
+// contains reports whether or not the given type names contain the given type name.
+func contains(typeNames []string, typeName string) bool {
+	for _, name := range typeNames {
+		if strings.TrimSpace(name) == typeName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package errorlint
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckDeferredCloses(t *testing.T) {
+	tt := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "Reports discarded errors of writable files",
+			body: `f, _ := os.Create("a")
+	defer f.Close()
+	g, _ := os.Create("b")
+	defer func() { _ = g.Close() }()`,
+			expected: []string{
+				"deferred f.Close() discards the error, closing writable os.File values can lose data, " +
+					"check it explicitly or assign it to a named error return",
+				"deferred g.Close() discards the error, closing writable os.File values can lose data, " +
+					"check it explicitly or assign it to a named error return",
+			},
+		},
+		{
+			name: "Reports configured types",
+			body: `var w *Writer
+	defer w.Close()`,
+			expected: []string{
+				"deferred w.Close() discards the error, closing writable p.Writer values can lose data, " +
+					"check it explicitly or assign it to a named error return",
+			},
+		},
+		{
+			name: "Ignores read-only files and checked errors",
+			body: `f, _ := os.Open("a")
+	defer f.Close()
+	g, _ := os.Create("b")
+	defer func() {
+		if err := g.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	var r *Reader
+	defer r.Close()`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := `package p
+
+import "os"
+
+var _ = os.Open
+
+type Writer struct{}
+
+func (w *Writer) Close() error { return nil }
+
+type Reader struct{}
+
+func (r *Reader) Close() error { return nil }
+
+func f() {
+	` + test.body + `
+}`
+
+			file, info := linttest.TypeCheck(t, "p", src, stubs)
+
+			writable := append([]string{"p.Writer"}, DefaultWritableTypes...)
+
+			var r linttest.Recorder
+			checkDeferredCloses(&r, info, file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body, writable)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
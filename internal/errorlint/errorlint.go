@@ -0,0 +1,516 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the errorlint analyzer and its check of the messages
+// given to wrapped errors.
+
+// Package errorlint contains the necessary logic for the errorlint linter. The errorlint
+// linter enforces the style guide's guidance for errors and tracing: wrap messages that
+// add context rather than repeating what the wrapped error already says, and optionally
+// span names that follow a consistent naming convention, wrapped errors from other
+// modules, constant log messages, consistently named error variables, and checked errors
+// of closing writable resources, and error messages that aren't capitalized and don't end
+// with punctuation.
+package errorlint
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the errorlint linter.
+const name = "errorlint"
+
+// doc defines the help text for the errorlint linter.
+const doc = `Enforces the style guide's guidance for errors and tracing. Messages given to
+errors.Wrap and errors.Wrapf from github.com/pkg/errors must add context: they can not be
+empty, be the name of the function that returned the error, or repeat the message of the
+error being wrapped.
+
+When -validateSpanNames is set, the names given to trace.StartSpan and trace.StartCall
+must be lowercase and dot-separated, e.g. "accounts.get_user", and start with the
+-spanPrefix followed by a dot if one is given.
+
+When -requireExternalWraps is set, errors returned from calls into other modules must be
+wrapped before being returned, unless they come from errors, fmt, github.com/pkg/errors, or
+one of the -passThroughPackages.
+
+When -requireStaticMessages is set, the messages given to the logging functions of
+github.com/getoutreach/gobox/pkg/log and the names given to trace.StartSpan and
+trace.StartCall must be constant strings. Dynamic data belongs in log.F fields.
+
+When -requireErrNames is set, local variables of type error must be named err, or start
+with err when several errors are live at once, e.g. errClose, rather than e or error2.
+
+When -checkDeferredClose is set, deferred calls to Close on writable resources, e.g.
+defer f.Close() on an *os.File, can not discard the error. Files opened with os.Open are
+read-only and skipped. The writable types are DefaultWritableTypes and the -writableTypes,
+written as the import path of their package followed by a dot and their name.
+
+When -validateMessageStyle is set, the messages given to errors.New, fmt.Errorf, and the
+functions of github.com/pkg/errors that create or wrap errors can not be capitalized or
+end with punctuation. Each report of a message literal comes with a fix.`
+
+// pkgErrors is the import path of the package whose wrapping functions are checked.
+const pkgErrors = "github.com/pkg/errors"
+
+// Analyzer exports the errorlint analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      errorlint,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_validateSpanNames bool, _spanPrefix string) *analysis.Analyzer {
+	validateSpanNames = _validateSpanNames
+	spanPrefix = _spanPrefix
+	return &Analyzer
+}
+
+// SetExternalWrapOptions sets the options of the check that errors returned from calls
+// into other modules are wrapped that would have been defined via flags if this was ran as
+// a vet tool, see NewAnalyzerWithOptions.
+func SetExternalWrapOptions(_requireExternalWraps bool, passThroughPackages []string) {
+	requireExternalWraps = _requireExternalWraps
+	rawPassThroughPackages = strings.Join(passThroughPackages, ",")
+}
+
+// SetRequireStaticMessages sets the option of the check that log messages and span names
+// are constant strings that would have been defined via flags if this was ran as a vet
+// tool, see NewAnalyzerWithOptions.
+func SetRequireStaticMessages(_requireStaticMessages bool) {
+	requireStaticMessages = _requireStaticMessages
+}
+
+// SetRequireErrNames sets the option of the check that local error variables are named err
+// that would have been defined via flags if this was ran as a vet tool, see
+// NewAnalyzerWithOptions.
+func SetRequireErrNames(_requireErrNames bool) {
+	requireErrNames = _requireErrNames
+}
+
+// SetDeferredCloseOptions sets the options of the check that the errors of closing writable
+// resources in deferred calls aren't discarded that would have been defined via flags if
+// this was ran as a vet tool, see NewAnalyzerWithOptions.
+func SetDeferredCloseOptions(_checkDeferredClose bool, writableTypes []string) {
+	checkDeferredClose = _checkDeferredClose
+	rawWritableTypes = strings.Join(writableTypes, ",")
+}
+
+// SetValidateMessageStyle sets the option of the check that error messages aren't
+// capitalized and don't end with punctuation that would have been defined via flags if this
+// was ran as a vet tool, see NewAnalyzerWithOptions.
+func SetValidateMessageStyle(_validateMessageStyle bool) {
+	validateMessageStyle = _validateMessageStyle
+}
+
+// SetCapitalizedWords sets the words, e.g. proper nouns like Outreach, that error messages
+// may start with capitalized that would have been defined via flags if this was ran as a
+// vet tool, see NewAnalyzerWithOptions.
+func SetCapitalizedWords(words []string) {
+	rawCapitalizedWords = strings.Join(words, ",")
+}
+
+// Variable block to keep track of flags whose values are collected at runtime. See the
+// init function that immediately proceeds this block to see more.
+var (
+	// validateSpanNames is a variable that gets collected via flags. This variable denotes
+	// whether or not the names of trace spans and calls are checked.
+	validateSpanNames bool
+
+	// spanPrefix is a variable that gets collected via flags. This variable contains the
+	// prefix, e.g. the name of the service, that every span name must start with.
+	spanPrefix string
+
+	// requireExternalWraps is a variable that gets collected via flags. This variable
+	// denotes whether or not errors returned from calls into other modules must be wrapped
+	// before being returned.
+	requireExternalWraps bool
+
+	// rawPassThroughPackages is a variable that gets collected via flags. This variable
+	// contains a comma-separated list of the packages, in addition to
+	// DefaultPassThroughPackages, whose errors may be returned without being wrapped.
+	rawPassThroughPackages string
+
+	// requireStaticMessages is a variable that gets collected via flags. This variable
+	// denotes whether or not log messages and span names must be constant strings.
+	requireStaticMessages bool
+
+	// requireErrNames is a variable that gets collected via flags. This variable denotes
+	// whether or not local error variables must be named err.
+	requireErrNames bool
+
+	// checkDeferredClose is a variable that gets collected via flags. This variable denotes
+	// whether or not deferred calls to Close on writable resources can discard the error.
+	checkDeferredClose bool
+
+	// rawWritableTypes is a variable that gets collected via flags. This variable contains
+	// a comma-separated list of the types, in addition to DefaultWritableTypes, whose Close
+	// error must be checked.
+	rawWritableTypes string
+
+	// validateMessageStyle is a variable that gets collected via flags. This variable
+	// denotes whether or not error messages can be capitalized or end with punctuation.
+	validateMessageStyle bool
+
+	// rawCapitalizedWords is a variable that gets collected via flags. This variable
+	// contains a comma-separated list of the words error messages may start with
+	// capitalized, e.g. proper nouns like Outreach.
+	rawCapitalizedWords string
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.BoolVar(&validateSpanNames, "validateSpanNames", false,
+		"a boolean flag that denotes whether or not to validate the names of trace spans and calls")
+	Analyzer.Flags.StringVar(&spanPrefix, "spanPrefix", "", "the prefix, e.g. the service name, every span name must start with")
+	Analyzer.Flags.BoolVar(&requireExternalWraps, "requireExternalWraps", false,
+		"a boolean flag that denotes whether or not errors returned from calls into other modules must be wrapped")
+	Analyzer.Flags.StringVar(&rawPassThroughPackages, "passThroughPackages", "",
+		"comma-separated list of packages whose errors may be returned without being wrapped")
+	Analyzer.Flags.BoolVar(&requireStaticMessages, "requireStaticMessages", false,
+		"a boolean flag that denotes whether or not log messages and span names must be constant strings")
+	Analyzer.Flags.BoolVar(&requireErrNames, "requireErrNames", false,
+		"a boolean flag that denotes whether or not local error variables must be named err")
+	Analyzer.Flags.BoolVar(&checkDeferredClose, "checkDeferredClose", false,
+		"a boolean flag that denotes whether or not deferred calls to Close on writable resources must check the error")
+	Analyzer.Flags.StringVar(&rawWritableTypes, "writableTypes", "",
+		"comma-separated list of types, e.g. example.com/mod/store.Writer, whose Close error must be checked")
+	Analyzer.Flags.BoolVar(&validateMessageStyle, "validateMessageStyle", false,
+		"a boolean flag that denotes whether or not error messages must not be capitalized or end with punctuation")
+	Analyzer.Flags.StringVar(&rawCapitalizedWords, "capitalizedWords", "",
+		"comma-separated list of words, e.g. proper nouns like Outreach, error messages may start with capitalized")
+}
+
+// errorlint is the function that gets passed to the Analyzer which runs the actual
+// analysis for the errorlint linter on a set of files.
+func errorlint(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	// Which errors come from other modules can only be told when the module of the package
+	// is known, which depends on the driver.
+	var modulePath string
+	if pass.Module != nil {
+		modulePath = pass.Module.Path
+	}
+	passThrough := append(strings.Split(rawPassThroughPackages, ","), DefaultPassThroughPackages...)
+	writable := append(strings.Split(rawWritableTypes, ","), DefaultWritableTypes...)
+	capitalizedWords := strings.Split(rawCapitalizedWords, ",")
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		// Sentinel errors are usually declared at the package level, so error messages are
+		// checked throughout the file rather than within function bodies alone.
+		if validateMessageStyle {
+			checkMessageStyle(pass, pass.TypesInfo, file, capitalizedWords)
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			checkWraps(pass, pass.TypesInfo, fn.Body)
+			if validateSpanNames {
+				checkSpanNames(pass, pass.TypesInfo, fn.Body, strings.TrimSpace(spanPrefix))
+			}
+			if requireExternalWraps && modulePath != "" {
+				checkExternalWraps(pass, pass.TypesInfo, fn.Body, modulePath, passThrough)
+			}
+			if requireStaticMessages {
+				checkStaticMessages(pass, pass.TypesInfo, fn.Body)
+			}
+			if requireErrNames {
+				checkErrNames(pass, pass.TypesInfo, fn.Body)
+			}
+			if checkDeferredClose {
+				checkDeferredCloses(pass, pass.TypesInfo, fn.Body, writable)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// assignment is a value assigned to a variable.
+type assignment struct {
+	// pos is the position of the assignment.
+	pos token.Pos
+
+	// value is the expression that was assigned, for a multi-value assignment this is the
+	// call that returned every value.
+	value ast.Expr
+}
+
+// gatherAssignments returns every assignment to a variable within the given function body,
+// so that the value a variable was last assigned can be looked up with lastAssigned.
+func gatherAssignments(info *types.Info, body *ast.BlockStmt) map[types.Object][]assignment {
+	assignments := make(map[types.Object][]assignment)
+	record := func(lhs []ast.Expr, rhs []ast.Expr) {
+		for i, expr := range lhs {
+			ident, ok := expr.(*ast.Ident)
+			if !ok {
+				continue
+			}
+
+			obj := info.ObjectOf(ident)
+			if obj == nil {
+				continue
+			}
+
+			value := rhs[0]
+			if len(rhs) == len(lhs) {
+				value = rhs[i]
+			}
+			assignments[obj] = append(assignments[obj], assignment{pos: ident.Pos(), value: value})
+		}
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			if len(stmt.Rhs) > 0 {
+				record(stmt.Lhs, stmt.Rhs)
+			}
+		case *ast.ValueSpec:
+			if len(stmt.Values) > 0 {
+				lhs := make([]ast.Expr, len(stmt.Names))
+				for i := range stmt.Names {
+					lhs[i] = stmt.Names[i]
+				}
+				record(lhs, stmt.Values)
+			}
+		}
+		return true
+	})
+
+	return assignments
+}
+
+// checkWraps reports every call to errors.Wrap or errors.Wrapf within the given function
+// body whose message doesn't add context to the error being wrapped.
+func checkWraps(r reporter.Reporter, info *types.Info, body *ast.BlockStmt) {
+	// Gather every assignment first so that the value a wrapped error was last assigned can
+	// be looked up.
+	assignments := gatherAssignments(info, body)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		wrap := funcName(info, call.Fun, pkgErrors)
+		if (wrap != "Wrap" && wrap != "Wrapf") || len(call.Args) < 2 {
+			return true
+		}
+
+		msg, ok := stringConstant(info, call.Args[1])
+		if !ok {
+			return true
+		}
+
+		if strings.TrimSpace(msg) == "" {
+			reporter.ReportRule(r, RuleWrapMessage, call.Args[1].Pos(),
+				"errors.%s message is empty, describe what was being done when the error occurred", wrap)
+			return true
+		}
+
+		source, ok := ast.Unparen(call.Args[0]).(*ast.CallExpr)
+		if !ok {
+			ident, isIdent := ast.Unparen(call.Args[0]).(*ast.Ident)
+			if !isIdent {
+				return true
+			}
+			source, ok = lastAssigned(assignments[info.ObjectOf(ident)], call.Pos()).(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+		}
+
+		if constructed, ok := constructionMessage(info, source); ok {
+			if normalize(constructed) == normalize(msg) {
+				reporter.ReportRule(r, RuleWrapMessage, call.Args[1].Pos(),
+					"errors.%s message \"%s\" repeats the message of the error it wraps, describe what was being done instead", wrap, msg)
+			}
+			return true
+		}
+
+		if callee := calleeName(source.Fun); callee != "" && isCalleeName(msg, callee) {
+			reporter.ReportRule(r, RuleWrapMessage, call.Args[1].Pos(),
+				"errors.%s message \"%s\" only repeats the name of the function that returned the error, "+
+					"describe what was being done instead", wrap, msg)
+		}
+
+		return true
+	})
+}
+
+// lastAssigned returns the value of the last of the given assignments before pos, or nil if
+// there is none.
+func lastAssigned(assignments []assignment, pos token.Pos) ast.Expr {
+	var value ast.Expr
+	var last token.Pos
+	for i := range assignments {
+		if assignments[i].pos < pos && assignments[i].pos > last {
+			value, last = assignments[i].value, assignments[i].pos
+		}
+	}
+
+	return value
+}
+
+// constructionMessage returns the message of the given call if it constructs a new error,
+// e.g. errors.New("foo") or fmt.Errorf("foo: %w", err), with a constant message.
+func constructionMessage(info *types.Info, call *ast.CallExpr) (string, bool) {
+	if len(call.Args) == 0 {
+		return "", false
+	}
+
+	switch {
+	case funcName(info, call.Fun, "errors") == "New",
+		funcName(info, call.Fun, pkgErrors) == "New",
+		funcName(info, call.Fun, pkgErrors) == "Errorf",
+		funcName(info, call.Fun, "fmt") == "Errorf":
+		return stringConstant(info, call.Args[0])
+	}
+
+	return "", false
+}
+
+// funcName returns the name of the package-level function the given expression refers to
+// if it is declared in the package with the given import path, and an empty string
+// otherwise.
+func funcName(info *types.Info, fun ast.Expr, pkgPath string) string {
+	var ident *ast.Ident
+	switch f := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		ident = f
+	case *ast.SelectorExpr:
+		ident = f.Sel
+	default:
+		return ""
+	}
+
+	fn, ok := info.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != pkgPath {
+		return ""
+	}
+
+	return fn.Name()
+}
+
+// messageParams are the names of the parameters that hold the message of a log call or the
+// name of a span, see messageIndex.
+var messageParams = map[string]bool{
+	"message":  true,
+	"msg":      true,
+	"name":     true,
+	"spanName": true,
+}
+
+// messageIndex returns the index of the argument of the given call that holds its message,
+// resolved from the signature of the function it calls rather than assumed to follow the
+// context: the string parameter named like a message, see messageParams, or else the first
+// string parameter. Calls that spread a slice into the parameter, or don't pass it, have no
+// message argument to check.
+func messageIndex(info *types.Info, call *ast.CallExpr) (int, bool) {
+	sig, ok := info.TypeOf(call.Fun).(*types.Signature)
+	if !ok {
+		return 0, false
+	}
+
+	params := sig.Params()
+	index := -1
+	for i := 0; i < params.Len(); i++ {
+		if sig.Variadic() && i == params.Len()-1 {
+			break
+		}
+
+		param := params.At(i)
+		if basic, ok := param.Type().Underlying().(*types.Basic); !ok || basic.Kind() != types.String {
+			continue
+		}
+
+		if messageParams[param.Name()] {
+			index = i
+			break
+		}
+		if index == -1 {
+			index = i
+		}
+	}
+
+	if index == -1 || index >= len(call.Args) || (call.Ellipsis.IsValid() && index == len(call.Args)-1) {
+		return 0, false
+	}
+
+	return index, true
+}
+
+// calleeName returns the name of the function or method the given expression calls, as
+// it is written, e.g. "client.Get".
+func calleeName(fun ast.Expr) string {
+	switch f := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		if x := calleeName(f.X); x != "" {
+			return x + "." + f.Sel.Name
+		}
+		return f.Sel.Name
+	}
+
+	return ""
+}
+
+// isCalleeName reports whether or not the given message is nothing but the given callee,
+// e.g. "client.Get", or the last part of it, e.g. "Get" or "Get()".
+func isCalleeName(msg, callee string) bool {
+	msg = strings.TrimSuffix(normalize(msg), "()")
+
+	parts := strings.Split(normalize(callee), ".")
+	for i := range parts {
+		if msg == strings.Join(parts[i:], ".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringConstant returns the value of the given expression if it is a constant string.
+func stringConstant(info *types.Info, expr ast.Expr) (string, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+// normalize returns the given message with surrounding space and case ignored.
+func normalize(msg string) string {
+	return strings.ToLower(strings.TrimSpace(msg))
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package errorlint
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/getoutreach/lintroller/docs"
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+// stubs contains the source of the packages imported by the sources under test.
+var stubs = map[string]string{
+	"errors": `package errors
+func New(text string) error { return nil }`,
+	"fmt": `package fmt
+func Errorf(format string, a ...any) error { return nil }
+func Sprint(a ...any) string { return "" }
+func Sprintf(format string, a ...any) string { return "" }`,
+	"github.com/pkg/errors": `package errors
+func New(message string) error { return nil }
+func Errorf(format string, args ...interface{}) error { return nil }
+func Wrap(err error, message string) error { return nil }
+func Wrapf(err error, format string, args ...interface{}) error { return nil }`,
+	"example.com/other": `package other
+func Get() (int, error) { return 0, nil }
+func Do() error { return nil }`,
+	"example.com/other/passthrough": `package passthrough
+func Do() error { return nil }`,
+	"example.com/mod/store": `package store
+func Do() error { return nil }`,
+	"github.com/getoutreach/gobox/pkg/log": `package log
+type Marshaler interface{ MarshalLog(addField func(key string, value interface{})) }
+type F map[string]interface{}
+func (f F) MarshalLog(addField func(key string, value interface{})) {}
+func Info(ctx interface{}, message string, m ...Marshaler) {}
+func Error(ctx interface{}, message string, m ...Marshaler) {}`,
+	"os": `package os
+type File struct{}
+func (f *File) Close() error { return nil }
+func Open(name string) (*File, error) { return nil, nil }
+func Create(name string) (*File, error) { return nil, nil }`,
+	"github.com/getoutreach/gobox/pkg/trace": `package trace
+func StartSpan(ctx interface{}, name string, args ...interface{}) interface{} { return ctx }`,
+}
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "errorlint")
+}
+
+func TestCheckWraps(t *testing.T) {
+	tt := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "Accepts messages that add context",
+			body: `user, err := client.GetUser(id)
+	if err != nil {
+		return errors.Wrapf(err, "get user %d", id)
+	}
+	_ = user
+	return errors.Wrap(stderrors.New("boom"), "validate request")`,
+		},
+		{
+			name:     "Rejects empty messages",
+			body:     `return errors.Wrap(do(), " ")`,
+			expected: []string{"errors.Wrap message is empty, describe what was being done when the error occurred"},
+		},
+		{
+			name: "Rejects messages that repeat the callee",
+			body: `if _, err := client.GetUser(id); err != nil {
+		return errors.Wrap(err, "GetUser")
+	}
+	_, err := client.GetUser(id)
+	return errors.Wrap(err, "client.GetUser()")`,
+			expected: []string{
+				"errors.Wrap message \"GetUser\" only repeats the name of the function that returned the error, describe what was being done instead",
+				"errors.Wrap message \"client.GetUser()\" only repeats the name of the function that returned the error, " +
+					"describe what was being done instead",
+			},
+		},
+		{
+			name: "Rejects messages that repeat the wrapped error",
+			body: `err := fmt.Errorf("invalid id")
+	if id > 0 {
+		err = do()
+		return errors.Wrap(err, "invalid id")
+	}
+	return errors.Wrap(errors.New("Invalid ID"), "invalid id")`,
+			expected: []string{
+				"errors.Wrap message \"invalid id\" repeats the message of the error it wraps, describe what was being done instead",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := `package p
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type user struct{}
+
+type store struct{}
+
+func (store) GetUser(int) (user, error) { return user{}, nil }
+
+var client store
+
+func do() error { return nil }
+
+var _, _ = stderrors.New, fmt.Errorf
+
+func f(id int) error {
+	` + test.body + `
+}`
+
+			file, info := linttest.TypeCheck(t, "p", src, stubs)
+
+			var r linttest.Recorder
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == "f" {
+					checkWraps(&r, info, fn.Body)
+				}
+			}
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
+
+func TestMessageIndex(t *testing.T) {
+	tt := []struct {
+		name     string
+		call     string
+		expected int
+		ok       bool
+	}{
+		{
+			name:     "Resolves the message after the context",
+			call:     `info(ctx, "synced", 1, 2)`,
+			expected: 1,
+			ok:       true,
+		},
+		{
+			name:     "Resolves the message after other arguments",
+			call:     `infoAt(ctx, 2, "component", "synced")`,
+			expected: 3,
+			ok:       true,
+		},
+		{
+			name:     "Falls back to the first string parameter",
+			call:     `unnamed(ctx, "synced")`,
+			expected: 1,
+			ok:       true,
+		},
+		{
+			name: "Skips messages spread from a slice",
+			call: `spread(ctx, msgs...)`,
+		},
+		{
+			name: "Skips functions without a message",
+			call: `none(ctx, 1)`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := `package p
+
+func info(ctx interface{}, message string, m ...interface{}) {}
+func infoAt(ctx interface{}, level int, component string, msg string) {}
+func unnamed(interface{}, string) {}
+func spread(ctx interface{}, messages ...string) {}
+func none(ctx interface{}, n int) {}
+
+func f(ctx interface{}, msgs []string) {
+	` + test.call + `
+}`
+
+			file, info := linttest.TypeCheck(t, "p", src, stubs)
+
+			body := file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body
+			call := body.List[0].(*ast.ExprStmt).X.(*ast.CallExpr)
+
+			index, ok := messageIndex(info, call)
+			assert.Equal(t, ok, test.ok)
+			assert.Equal(t, index, test.expected)
+		})
+	}
+}
+
+func TestRulesDocumented(t *testing.T) {
+	page, ok := docs.Lookup(Analyzer.Name)
+	assert.Assert(t, ok)
+
+	documented := make([]string, 0, len(page.Rules))
+	for _, r := range page.Rules {
+		documented = append(documented, r.ID)
+	}
+	assert.DeepEqual(t, documented, Rules)
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that errors returned from calls into other
+// modules are wrapped, so that they carry the context of the call in traces.
+
+package errorlint
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// DefaultPassThroughPackages are the packages whose errors may always be returned without
+// being wrapped, since their functions construct or wrap errors themselves.
+var DefaultPassThroughPackages = []string{"errors", "fmt", pkgErrors}
+
+// checkExternalWraps reports every error within the given function body that is returned
+// as it was returned from a call into a package outside of the module with the given path,
+// unless the package is one of the given pass-through packages or within one of them.
+func checkExternalWraps(r reporter.Reporter, info *types.Info, body *ast.BlockStmt, modulePath string, passThrough []string) {
+	errorType := types.Universe.Lookup("error").Type()
+
+	assignments := gatherAssignments(info, body)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		ret, ok := n.(*ast.ReturnStmt)
+		if !ok {
+			return true
+		}
+
+		for _, result := range ret.Results {
+			if !types.Identical(info.TypeOf(result), errorType) {
+				continue
+			}
+
+			source, ok := ast.Unparen(result).(*ast.CallExpr)
+			if !ok {
+				ident, isIdent := ast.Unparen(result).(*ast.Ident)
+				if !isIdent {
+					continue
+				}
+				source, ok = lastAssigned(assignments[info.ObjectOf(ident)], ret.Pos()).(*ast.CallExpr)
+				if !ok {
+					continue
+				}
+			}
+
+			fn := calledFunc(info, source.Fun)
+			if fn == nil || fn.Pkg() == nil {
+				continue
+			}
+
+			pkg := fn.Pkg().Path()
+			if within(pkg, modulePath) || withinAny(pkg, passThrough) {
+				continue
+			}
+
+			reporter.ReportRule(r, RuleExternalWrap, result.Pos(), "error returned by %s from package \"%s\" should be wrapped with errors.Wrap or "+
+				"fmt.Errorf and %%w to add context", calleeName(source.Fun), pkg)
+		}
+
+		return true
+	})
+}
+
+// calledFunc returns the function or method the given expression calls, or nil if it
+// doesn't call one, e.g. when it calls a function value.
+func calledFunc(info *types.Info, fun ast.Expr) *types.Func {
+	var ident *ast.Ident
+	switch f := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		ident = f
+	case *ast.SelectorExpr:
+		ident = f.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := info.Uses[ident].(*types.Func)
+	return fn
+}
+
+// within reports whether or not the package with the given import path is the package or
+// module with the given path or is nested within it.
+func within(pkg, path string) bool {
+	return path != "" && (pkg == path || strings.HasPrefix(pkg, path+"/"))
+}
+
+// withinAny reports whether or not the package with the given import path is within any
+// of the given paths, see within.
+func withinAny(pkg string, paths []string) bool {
+	for _, path := range paths {
+		if within(pkg, strings.TrimSpace(path)) {
+			return true
+		}
+	}
+
+	return false
+}
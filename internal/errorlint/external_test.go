@@ -0,0 +1,89 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package errorlint
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckExternalWraps(t *testing.T) {
+	tt := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "Reports errors returned directly from other modules",
+			body: `return other.Do()`,
+			expected: []string{"error returned by other.Do from package \"example.com/other\" should be wrapped with errors.Wrap or fmt.Errorf " +
+				"and %w to add context"},
+		},
+		{
+			name: "Reports errors assigned from other modules",
+			body: `_, err := other.Get()
+	if err != nil {
+		return err
+	}
+	return nil`,
+			expected: []string{"error returned by other.Get from package \"example.com/other\" should be wrapped with errors.Wrap or fmt.Errorf " +
+				"and %w to add context"},
+		},
+		{
+			name: "Allows wrapped errors",
+			body: `if err := other.Do(); err != nil {
+		return errors.Wrap(err, "do the other thing")
+	}
+	_, err := other.Get()
+	return fmt.Errorf("get the other thing: %w", err)`,
+			expected: nil,
+		},
+		{
+			name:     "Allows errors from the same module",
+			body:     `return store.Do()`,
+			expected: nil,
+		},
+		{
+			name:     "Allows errors from pass-through packages",
+			body:     `return passthrough.Do()`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := `package p
+
+import (
+	"fmt"
+
+	"example.com/mod/store"
+	"example.com/other"
+	"example.com/other/passthrough"
+	"github.com/pkg/errors"
+)
+
+var _, _, _, _, _ = fmt.Errorf, errors.Wrap, other.Do, passthrough.Do, store.Do
+
+func f() error {
+	` + test.body + `
+}`
+
+			file, info := linttest.TypeCheck(t, "example.com/mod/p", src, stubs)
+
+			passThrough := append([]string{"example.com/other/passthrough"}, DefaultPassThroughPackages...)
+
+			var r linttest.Recorder
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == "f" {
+					checkExternalWraps(&r, info, fn.Body, "example.com/mod", passThrough)
+				}
+			}
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that local error variables are named err,
+// which keeps error handling easy to read and to search for.
+
+package errorlint
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// reErrName matches the names error variables may have: err, or err followed by what sets
+// the error apart when several are live at once, e.g. errClose or err2.
+var reErrName = regexp.MustCompile(`^err(?:[A-Z0-9_]\w*)?$`)
+
+// checkErrNames reports every variable of type error declared within the given function
+// body, including the parameters of function literals, that isn't named err or errX.
+func checkErrNames(r reporter.Reporter, info *types.Info, body *ast.BlockStmt) {
+	errorType := types.Universe.Lookup("error").Type()
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			return true
+		}
+
+		v, ok := info.Defs[ident].(*types.Var)
+		if !ok || v.IsField() || !types.Identical(v.Type(), errorType) {
+			return true
+		}
+
+		if !reErrName.MatchString(ident.Name) {
+			reporter.ReportRule(r, RuleErrorName, ident.Pos(), "error variable \"%s\" should be named err, or start with err when "+
+				"several errors are live at once, e.g. errClose", ident.Name)
+		}
+
+		return true
+	})
+}
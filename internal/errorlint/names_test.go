@@ -0,0 +1,73 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package errorlint
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckErrNames(t *testing.T) {
+	tt := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "Allows err and errX",
+			body: `err := errors.New("a")
+	errClose := errors.New("b")
+	var err2 error
+	_, _, _ = err, errClose, err2`,
+			expected: nil,
+		},
+		{
+			name: "Reports other names",
+			body: `e := errors.New("a")
+	var er, error2 error
+	_, _, _ = e, er, error2`,
+			expected: []string{
+				"error variable \"e\" should be named err, or start with err when several errors are live at once, e.g. errClose",
+				"error variable \"er\" should be named err, or start with err when several errors are live at once, e.g. errClose",
+				"error variable \"error2\" should be named err, or start with err when several errors are live at once, e.g. errClose",
+			},
+		},
+		{
+			name: "Reports parameters of function literals",
+			body: `_ = func(e error) error { return errors.New(e.Error()) }`,
+			expected: []string{
+				"error variable \"e\" should be named err, or start with err when several errors are live at once, e.g. errClose",
+			},
+		},
+		{
+			name: "Ignores variables of other types and blank identifiers",
+			body: `errs := []error{errors.New("a")}
+	msg := "failed"
+	_ = errors.New("b")
+	_, _ = errs, msg`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := `package p
+
+import "errors"
+
+func f() {
+	` + test.body + `
+}`
+
+			file, info := linttest.TypeCheck(t, "p", src, stubs)
+
+			var r linttest.Recorder
+			checkErrNames(&r, info, file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
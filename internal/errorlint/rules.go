@@ -0,0 +1,45 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file defines the IDs of the rules errorlint reports issues under, which
+// allow individual rules to have a severity of their own.
+
+package errorlint
+
+// IDs of the rules errorlint reports issues under. Each issue carries the ID of its rule as
+// the category of its diagnostic.
+const (
+	// RuleWrapMessage is the rule that errors.Wrap messages describe what was being done
+	// rather than repeat the error they wrap.
+	RuleWrapMessage = "wrap-message"
+
+	// RuleSpanName is the rule that span names are lowercase and dot-separated.
+	RuleSpanName = "span-name"
+
+	// RuleExternalWrap is the rule that errors returned by other modules are wrapped.
+	RuleExternalWrap = "external-wrap"
+
+	// RuleStaticMessage is the rule that log messages and span names are constant.
+	RuleStaticMessage = "static-message"
+
+	// RuleErrorName is the rule that error variables are named err.
+	RuleErrorName = "error-name"
+
+	// RuleDeferClose is the rule that the errors of deferred closes of writable values
+	// aren't discarded.
+	RuleDeferClose = "defer-close"
+
+	// RuleMessageStyle is the rule that error messages aren't capitalized and don't end with
+	// punctuation.
+	RuleMessageStyle = "message-style"
+)
+
+// Rules contains the ID of every rule errorlint reports issues under.
+var Rules = []string{
+	RuleWrapMessage,
+	RuleSpanName,
+	RuleExternalWrap,
+	RuleStaticMessage,
+	RuleErrorName,
+	RuleDeferClose,
+	RuleMessageStyle,
+}
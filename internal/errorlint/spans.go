@@ -0,0 +1,118 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check of the names given to trace spans and calls,
+// which keeps span names consistent so that tracing dashboards aren't fragmented.
+
+package errorlint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// pkgTrace is the import path of the package whose span and call names are checked.
+const pkgTrace = "github.com/getoutreach/gobox/pkg/trace"
+
+// spanFuncs are the functions of pkgTrace that take the name of a span, see messageIndex.
+var spanFuncs = map[string]bool{
+	"StartSpan":            true,
+	"StartSpanWithOptions": true,
+	"StartSpanAsync":       true,
+	"StartCall":            true,
+}
+
+// reSpanName matches span names that follow the convention: lowercase words, made up of
+// letters, digits, and underscores, separated by dots.
+var reSpanName = regexp.MustCompile(`^[a-z0-9_]+(\.[a-z0-9_]+)*$`)
+
+// reSpanSeparators matches the runs of characters that are replaced by a single dot when
+// suggesting a conventional span name.
+var reSpanSeparators = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// ValidSpanName reports whether or not the given span name, or span name prefix, follows
+// the naming convention.
+func ValidSpanName(spanName string) bool {
+	return reSpanName.MatchString(spanName)
+}
+
+// checkSpanNames reports every constant span or call name within the given function body
+// that doesn't follow the naming convention or, if prefix isn't empty, doesn't start with
+// the given prefix followed by a dot.
+func checkSpanNames(r reporter.Reporter, info *types.Info, body *ast.BlockStmt, prefix string) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		fn := funcName(info, call.Fun, pkgTrace)
+		if !spanFuncs[fn] {
+			return true
+		}
+
+		arg, ok := messageIndex(info, call)
+		if !ok {
+			return true
+		}
+
+		// Names that aren't constant can't be checked.
+		spanName, ok := stringConstant(info, call.Args[arg])
+		if !ok {
+			return true
+		}
+
+		if problem := spanNameProblem(spanName, prefix); problem != "" {
+			reporter.ReportRule(r, RuleSpanName, call.Args[arg].Pos(), "trace.%s name \"%s\" %s", fn, spanName, problem)
+		}
+
+		return true
+	})
+}
+
+// spanNameProblem returns a description of why the given span name doesn't follow the
+// naming convention, or an empty string if it does.
+func spanNameProblem(spanName, prefix string) string {
+	if !ValidSpanName(spanName) {
+		return fmt.Sprintf("must be lowercase and dot-separated without spaces, e.g. \"%s\"", conventionalSpanName(spanName, prefix))
+	}
+
+	if prefix != "" && !strings.HasPrefix(spanName, prefix+".") {
+		return fmt.Sprintf("must start with the service prefix \"%s.\", e.g. \"%s\"", prefix, conventionalSpanName(spanName, prefix))
+	}
+
+	return ""
+}
+
+// conventionalSpanName returns the given span name rewritten to follow the naming
+// convention, for use in suggestions.
+func conventionalSpanName(spanName, prefix string) string {
+	name := strings.Trim(reSpanSeparators.ReplaceAllString(strings.ToLower(camelToSnake(spanName)), "."), ".")
+
+	if prefix != "" && name != prefix && !strings.HasPrefix(name, prefix+".") {
+		name = strings.TrimSuffix(prefix+"."+name, ".")
+	}
+
+	return name
+}
+
+// camelToSnake inserts an underscore before each upper case letter that follows a lower
+// case letter or digit, e.g. "getUser" becomes "get_User".
+func camelToSnake(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			prev := s[i-1]
+			if (prev >= 'a' && prev <= 'z') || (prev >= '0' && prev <= '9') {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package errorlint
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSpanNameProblem(t *testing.T) {
+	tt := []struct {
+		name     string
+		spanName string
+		prefix   string
+		expected string
+	}{
+		{
+			name:     "Accepts lowercase dot-separated names",
+			spanName: "accounts.get_user",
+		},
+		{
+			name:     "Accepts names with the prefix",
+			spanName: "accounts.get_user",
+			prefix:   "accounts",
+		},
+		{
+			name:     "Rejects spaces",
+			spanName: "get user",
+			expected: "must be lowercase and dot-separated without spaces, e.g. \"get.user\"",
+		},
+		{
+			name:     "Rejects camel case",
+			spanName: "Accounts.GetUser",
+			prefix:   "accounts",
+			expected: "must be lowercase and dot-separated without spaces, e.g. \"accounts.get_user\"",
+		},
+		{
+			name:     "Rejects names without the prefix",
+			spanName: "get_user",
+			prefix:   "accounts",
+			expected: "must start with the service prefix \"accounts.\", e.g. \"accounts.get_user\"",
+		},
+		{
+			name:     "Rejects names that are only the prefix",
+			spanName: "accounts",
+			prefix:   "accounts",
+			expected: "must start with the service prefix \"accounts.\", e.g. \"accounts\"",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, spanNameProblem(test.spanName, test.prefix), test.expected)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that log messages and span names are
+// constant, which keeps their cardinality low enough for logs and traces to aggregate.
+
+package errorlint
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// pkgLog is the import path of the package whose log messages are checked.
+const pkgLog = "github.com/getoutreach/gobox/pkg/log"
+
+// logFuncs are the functions of pkgLog that take a message to log, see messageIndex.
+var logFuncs = map[string]bool{
+	"Debug": true,
+	"Info":  true,
+	"Warn":  true,
+	"Error": true,
+	"Fatal": true,
+}
+
+// checkStaticMessages reports every log message and span or call name within the given
+// function body that isn't a constant string, e.g. one built with fmt.Sprintf or by
+// concatenating a variable.
+func checkStaticMessages(r reporter.Reporter, info *types.Info, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		var kind, fn string
+		if fn = funcName(info, call.Fun, pkgLog); logFuncs[fn] {
+			kind = "log." + fn + " message"
+		} else if fn = funcName(info, call.Fun, pkgTrace); spanFuncs[fn] {
+			kind = "trace." + fn + " name"
+		} else {
+			return true
+		}
+
+		arg, ok := messageIndex(info, call)
+		if !ok {
+			return true
+		}
+
+		if _, ok := stringConstant(info, call.Args[arg]); !ok {
+			reporter.ReportRule(r, RuleStaticMessage, call.Args[arg].Pos(),
+				"%s must be a constant string, pass dynamic data as log.F fields instead", kind)
+		}
+
+		return true
+	})
+}
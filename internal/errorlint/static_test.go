@@ -0,0 +1,73 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package errorlint
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckStaticMessages(t *testing.T) {
+	tt := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "Allows constant messages",
+			body: `log.Info(ctx, "synced accounts", log.F{"count": n})
+	log.Error(ctx, "sync "+"failed")
+	_ = trace.StartSpan(ctx, prefix+".sync")`,
+			expected: nil,
+		},
+		{
+			name: "Reports formatted messages",
+			body: `log.Info(ctx, fmt.Sprintf("synced %d accounts", n))
+	_ = trace.StartSpan(ctx, fmt.Sprint("sync.", n))`,
+			expected: []string{
+				"log.Info message must be a constant string, pass dynamic data as log.F fields instead",
+				"trace.StartSpan name must be a constant string, pass dynamic data as log.F fields instead",
+			},
+		},
+		{
+			name: "Reports concatenated variables",
+			body: `msg := "sync failed"
+	log.Error(ctx, msg+" for "+user)`,
+			expected: []string{
+				"log.Error message must be a constant string, pass dynamic data as log.F fields instead",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := `package p
+
+import (
+	"fmt"
+
+	"github.com/getoutreach/gobox/pkg/log"
+	"github.com/getoutreach/gobox/pkg/trace"
+)
+
+const prefix = "accounts"
+
+var _, _ = fmt.Sprint, trace.StartSpan
+
+func f(ctx interface{}, n int, user string) {
+	_, _ = n, user
+	` + test.body + `
+}`
+
+			file, info := linttest.TypeCheck(t, "p", src, stubs)
+
+			var r linttest.Recorder
+			checkStaticMessages(&r, info, file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the check that error messages aren't capitalized and
+// don't end with punctuation, along with the fixes that correct their literals.
+
+package errorlint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// messageFuncs maps the import path of each package whose functions create errors to the
+// index of the message argument of each of those functions.
+var messageFuncs = map[string]map[string]int{
+	"errors": {"New": 0},
+	"fmt":    {"Errorf": 0},
+	pkgErrors: {
+		"New":          0,
+		"Errorf":       0,
+		"Wrap":         1,
+		"Wrapf":        1,
+		"WithMessage":  1,
+		"WithMessagef": 1,
+	},
+}
+
+// trailingPunctuation contains the characters error messages can't end with, since they
+// are usually wrapped by, or printed within, a sentence of their own.
+const trailingPunctuation = ".!?:;"
+
+// checkMessageStyle reports every message given to a function that creates an error within
+// the given node that is capitalized or ends with punctuation. Messages starting with one of
+// the given words, e.g. proper nouns, aren't capitalized, see capitalized. Messages written
+// as a literal come with a fix that lowercases their first letter and strips the
+// punctuation.
+func checkMessageStyle(r interface{ Report(analysis.Diagnostic) }, info *types.Info, node ast.Node, words []string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		fn, arg, ok := messageArg(info, call)
+		if !ok {
+			return true
+		}
+
+		msg, ok := stringConstant(info, call.Args[arg])
+		if !ok {
+			return true
+		}
+
+		problem := messageStyleProblem(msg, words)
+		if problem == "" {
+			return true
+		}
+
+		diagnostic := analysis.Diagnostic{
+			Pos:      call.Args[arg].Pos(),
+			Category: RuleMessageStyle,
+			Message:  fmt.Sprintf("%s message \"%s\" %s", fn, msg, problem),
+		}
+
+		if lit, ok := ast.Unparen(call.Args[arg]).(*ast.BasicLit); ok {
+			if fixed := fixMessageLiteral(lit.Value, words); fixed != lit.Value {
+				diagnostic.SuggestedFixes = []analysis.SuggestedFix{
+					{
+						Message: fmt.Sprintf("Replace %s with %s", lit.Value, fixed),
+						TextEdits: []analysis.TextEdit{
+							{Pos: lit.Pos(), End: lit.End(), NewText: []byte(fixed)},
+						},
+					},
+				}
+			}
+		}
+
+		r.Report(diagnostic)
+		return true
+	})
+}
+
+// messageArg returns the name, e.g. "fmt.Errorf", and the index of the message argument of
+// the function creating an error the given call calls, if it calls one.
+func messageArg(info *types.Info, call *ast.CallExpr) (string, int, bool) {
+	for pkgPath, funcs := range messageFuncs {
+		fn := funcName(info, call.Fun, pkgPath)
+		if arg, ok := funcs[fn]; ok && len(call.Args) > arg {
+			return pkgPath[strings.LastIndex(pkgPath, "/")+1:] + "." + fn, arg, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// messageStyleProblem returns what is wrong with the style of the given error message,
+// which may start with one of the given words capitalized, or an empty string if nothing
+// is.
+func messageStyleProblem(msg string, words []string) string {
+	capital := capitalized(msg, words)
+	punctuated := msg != strings.TrimRight(msg, trailingPunctuation)
+
+	switch {
+	case capital && punctuated:
+		return "must not be capitalized or end with punctuation"
+	case capital:
+		return "must not be capitalized"
+	case punctuated:
+		return "must not end with punctuation"
+	default:
+		return ""
+	}
+}
+
+// capitalized reports whether or not the given message starts with a capitalized word.
+// Only the first word is considered. Words with other upper case letters, e.g. acronyms
+// like HTTP or identifiers like GetUser, single letters like I, and the given words, e.g.
+// proper nouns like Outreach, are written that way on purpose and don't count.
+func capitalized(msg string, words []string) bool {
+	end := strings.IndexFunc(msg, func(r rune) bool { return !unicode.IsLetter(r) })
+	if end == -1 {
+		end = len(msg)
+	}
+	word := msg[:end]
+
+	// The given words may contain digits, e.g. S3, which end the word of the message.
+	token := msg
+	if i := strings.IndexFunc(msg, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }); i != -1 {
+		token = msg[:i]
+	}
+	for _, allowed := range words {
+		if allowed != "" && (allowed == word || allowed == token) {
+			return false
+		}
+	}
+
+	first, size := utf8.DecodeRuneInString(word)
+	if !unicode.IsUpper(first) || size == len(word) {
+		return false
+	}
+
+	return !strings.ContainsFunc(word[size:], unicode.IsUpper)
+}
+
+// fixMessageLiteral returns the given string literal, quotes included, with its first
+// letter lowercased if it is capitalized, see capitalized, and its trailing punctuation
+// stripped.
+func fixMessageLiteral(lit string, words []string) string {
+	if len(lit) < 2 {
+		return lit
+	}
+	quote, body := lit[:1], lit[1:len(lit)-1]
+
+	body = strings.TrimRight(body, trailingPunctuation)
+	if capitalized(body, words) {
+		first, size := utf8.DecodeRuneInString(body)
+		body = string(unicode.ToLower(first)) + body[size:]
+	}
+
+	return quote + body + quote
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package errorlint
+
+import (
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+// diagnosticRecorder records the diagnostics reported to it.
+type diagnosticRecorder struct {
+	diagnostics []analysis.Diagnostic
+}
+
+// Report records the given diagnostic.
+func (r *diagnosticRecorder) Report(d analysis.Diagnostic) {
+	r.diagnostics = append(r.diagnostics, d)
+}
+
+func TestCheckMessageStyle(t *testing.T) {
+	tt := []struct {
+		name     string
+		src      string
+		expected string
+		fix      string
+	}{
+		{
+			name: "Accepts lowercase messages",
+			src:  `var err = errors.New("account not found")`,
+		},
+		{
+			name:     "Reports capitalized messages",
+			src:      `var err = errors.New("Account not found")`,
+			expected: `errors.New message "Account not found" must not be capitalized`,
+			fix:      `"account not found"`,
+		},
+		{
+			name:     "Reports messages ending with punctuation",
+			src:      `var err = fmt.Errorf("look up account %d: %w.", 1, nil)`,
+			expected: `fmt.Errorf message "look up account %d: %w." must not end with punctuation`,
+			fix:      `"look up account %d: %w"`,
+		},
+		{
+			name:     "Reports both",
+			src:      "var err = pkgerrors.Wrap(nil, `Read config file!!`)",
+			expected: `errors.Wrap message "Read config file!!" must not be capitalized or end with punctuation`,
+			fix:      "`read config file`",
+		},
+		{
+			name: "Accepts acronyms and identifiers",
+			src:  `var err = pkgerrors.Errorf("HTTP request to %s failed", "GetUser")`,
+		},
+		{
+			name: "Accepts single letters",
+			src:  `var err = errors.New("I/O timeout")`,
+		},
+		{
+			name: "Accepts configured capitalized words",
+			src:  `var err = errors.New("Outreach's API returned nothing")`,
+		},
+		{
+			name: "Accepts configured capitalized words with digits",
+			src:  `var err = errors.New("Route53 zone not found")`,
+		},
+		{
+			name:     "Reports words only starting with a configured word",
+			src:      `var err = errors.New("Outreaches not found")`,
+			expected: `errors.New message "Outreaches not found" must not be capitalized`,
+			fix:      `"outreaches not found"`,
+		},
+		{
+			name:     "Reports constants without a fix",
+			src:      "const msg = \"Not found.\"\nvar err = errors.New(msg)",
+			expected: `errors.New message "Not found." must not be capitalized or end with punctuation`,
+		},
+		{
+			name: "Ignores dynamic messages",
+			src:  `var err = errors.New(fmt.Sprint("Not found."))`,
+		},
+		{
+			name: "Ignores other functions",
+			src:  `var msg = fmt.Sprintf("Not found.")`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := "package p\nimport (\n\"errors\"\n\"fmt\"\npkgerrors \"github.com/pkg/errors\"\n)\n" +
+				"var _, _ = errors.New, fmt.Sprint\nvar _ = pkgerrors.New\n" + test.src
+
+			file, info := linttest.TypeCheck(t, "p", src, stubs)
+
+			var r diagnosticRecorder
+			checkMessageStyle(&r, info, file, []string{"Outreach", "Route53"})
+
+			if test.expected == "" {
+				assert.Equal(t, len(r.diagnostics), 0)
+				return
+			}
+
+			assert.Equal(t, len(r.diagnostics), 1)
+			assert.Equal(t, r.diagnostics[0].Message, test.expected)
+			assert.Equal(t, r.diagnostics[0].Category, RuleMessageStyle)
+
+			if test.fix == "" {
+				assert.Equal(t, len(r.diagnostics[0].SuggestedFixes), 0)
+				return
+			}
+
+			assert.Equal(t, len(r.diagnostics[0].SuggestedFixes), 1)
+			edits := r.diagnostics[0].SuggestedFixes[0].TextEdits
+			assert.Equal(t, len(edits), 1)
+			assert.Equal(t, string(edits[0].NewText), test.fix)
+		})
+	}
+}
+
+func TestFixMessageLiteral(t *testing.T) {
+	tt := []struct {
+		lit      string
+		expected string
+	}{
+		{lit: `"Not found."`, expected: `"not found"`},
+		{lit: `"not found..."`, expected: `"not found"`},
+		{lit: "`Élan failed`", expected: "`élan failed`"},
+		{lit: `"HTTP failed."`, expected: `"HTTP failed"`},
+		{lit: `"\tNot found"`, expected: `"\tNot found"`},
+		{lit: `"."`, expected: `""`},
+		{lit: `"Outreach failed."`, expected: `"Outreach failed"`},
+	}
+
+	for _, test := range tt {
+		assert.Equal(t, fixMessageLiteral(test.lit, []string{"Outreach"}), test.expected, test.lit)
+	}
+}
@@ -0,0 +1,14 @@
+// Package errorlint wraps errors with messages that don't add context.
+package errorlint
+
+import "github.com/pkg/errors"
+
+func getUser() error { return errors.New("not found") }
+
+func load() error {
+	if err := getUser(); err != nil {
+		return errors.Wrap(err, "getUser") // want `errors.Wrap message "getUser" only repeats the name of the function`
+	}
+
+	return errors.Wrap(getUser(), "") //nolint:errorlint // Why: suppressed issues aren't reported.
+}
@@ -0,0 +1,7 @@
+package errorlint_test
+
+import "github.com/pkg/errors"
+
+func loadTest() error {
+	return errors.Wrap(errors.New("not found"), "New")
+}
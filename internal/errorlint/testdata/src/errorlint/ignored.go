@@ -0,0 +1,7 @@
+package errorlint
+
+import "github.com/pkg/errors"
+
+func loadIgnored() error {
+	return errors.Wrap(getUser(), "getUser")
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the codeclimate format, the Code Climate issue format
+// read by GitLab code quality reports.
+
+package format
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// CodeClimate is the name of the codeclimate format.
+const CodeClimate = "codeclimate"
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(CodeClimate, NewCodeClimate)
+}
+
+// codeClimateSeverities maps the severities of diagnostics to Code Climate severities.
+var codeClimateSeverities = map[string]string{
+	SeverityError:   "major",
+	SeverityWarning: "minor",
+	SeverityInfo:    "info",
+}
+
+// The subset of the Code Climate issue written by the codeclimate format, see
+// https://github.com/codeclimate/platform/blob/master/spec/analyzers/SPEC.md#data-types.
+type (
+	// codeClimateIssue is a single issue.
+	codeClimateIssue struct {
+		Type           string                `json:"type"`
+		CheckName      string                `json:"check_name"`
+		Description    string                `json:"description"`
+		Categories     []string              `json:"categories"`
+		Severity       string                `json:"severity"`
+		Fingerprint    string                `json:"fingerprint"`
+		Location       codeClimateLocation   `json:"location"`
+		OtherLocations []codeClimateLocation `json:"other_locations,omitempty"`
+	}
+
+	// codeClimateLocation is where an issue was reported.
+	codeClimateLocation struct {
+		Path  string           `json:"path"`
+		Lines codeClimateLines `json:"lines"`
+	}
+
+	// codeClimateLines are the lines of a location.
+	codeClimateLines struct {
+		Begin int `json:"begin"`
+	}
+)
+
+// codeClimateFormatter implements the codeclimate format.
+type codeClimateFormatter struct {
+	w      io.Writer
+	issues []codeClimateIssue
+}
+
+// NewCodeClimate returns a Formatter writing every diagnostic to the given io.Writer as a
+// JSON array of Code Climate issues once it is closed.
+func NewCodeClimate(w io.Writer) Formatter {
+	return &codeClimateFormatter{w: w, issues: []codeClimateIssue{}}
+}
+
+// Write implements the Formatter interface.
+func (f *codeClimateFormatter) Write(d *Diagnostic) error {
+	path := relativePath(d.Position.Filename)
+
+	// The fingerprint identifies an issue across runs, so that code quality reports can
+	// tell new issues from existing ones.
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%s", ruleID(d), path, d.Position.Line, d.Message)))
+
+	var others []codeClimateLocation
+	for i := range d.Related {
+		others = append(others, codeClimateLocation{
+			Path:  relativePath(d.Related[i].Position.Filename),
+			Lines: codeClimateLines{Begin: d.Related[i].Position.Line},
+		})
+	}
+
+	f.issues = append(f.issues, codeClimateIssue{
+		Type:        "issue",
+		CheckName:   ruleID(d),
+		Description: d.Message,
+		Categories:  []string{"Style"},
+		Severity:    codeClimateSeverities[d.Severity],
+		Fingerprint: hex.EncodeToString(sum[:]),
+		Location: codeClimateLocation{
+			Path:  path,
+			Lines: codeClimateLines{Begin: d.Position.Line},
+		},
+		OtherLocations: others,
+	})
+
+	return nil
+}
+
+// Close implements the Formatter interface.
+func (f *codeClimateFormatter) Close() error {
+	return errors.Wrap(writeJSON(f.w, f.issues), "write codeclimate issues")
+}
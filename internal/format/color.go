@@ -0,0 +1,47 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements deciding whether or not to color the text format, and
+// the ANSI escape sequences it is colored with.
+
+package format
+
+import (
+	"io"
+	"os"
+)
+
+// ANSI escape sequences the text format is colored with.
+const (
+	// colorReset resets every attribute set by the sequences below.
+	colorReset = "\x1b[0m"
+
+	// colorBold highlights text, e.g. the position of a diagnostic.
+	colorBold = "\x1b[1m"
+
+	// colorDim dims text, e.g. the linter of a diagnostic.
+	colorDim = "\x1b[2m"
+
+	// colorRed colors the messages of errors.
+	colorRed = "\x1b[31m"
+
+	// colorYellow colors the messages of warnings.
+	colorYellow = "\x1b[33m"
+)
+
+// ColorEnabled reports whether or not output written to the given io.Writer should be
+// colored, which is only the case when it is a terminal. Setting noColor, the NO_COLOR
+// environment variable to anything but an empty string (see https://no-color.org), or the
+// TERM environment variable to "dumb" disables colors.
+func ColorEnabled(w io.Writer, noColor bool) bool {
+	if noColor || os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the editor format, which writes each diagnostic on a
+// single line of a fixed grammar, along with the problem matchers of editors parsing it.
+
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Editor is the name of the editor format.
+const Editor = "editor"
+
+// EditorPattern is the regular expression matching a line written by the editor format,
+// "file:line:col: [linter] severity: message", whose groups are, in order, the file, line,
+// column, linter, with its rule if it has one, severity, and message.
+const EditorPattern = `^(.+?):(\d+):(\d+): \[([^\]]+)\] (error|warning|info): (.*)$`
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(Editor, NewEditor)
+}
+
+// editorFormatter implements the editor format.
+type editorFormatter struct {
+	w io.Writer
+}
+
+// NewEditor returns a Formatter writing each diagnostic to the given io.Writer as it is
+// given, on a single line matched by EditorPattern. Newlines within messages are replaced
+// by spaces so that every diagnostic is exactly one line.
+func NewEditor(w io.Writer) Formatter {
+	return &editorFormatter{w: w}
+}
+
+// Write implements the Formatter interface.
+func (f *editorFormatter) Write(d *Diagnostic) error {
+	message := d.Message
+	if d.URL != "" {
+		message = fmt.Sprintf("%s (see %s)", d.Message, d.URL)
+	}
+
+	_, err := fmt.Fprintf(f.w, "%s:%d:%d: [%s] %s: %s\n", relativePath(d.Position.Filename), d.Position.Line,
+		d.Position.Column, ruleID(d), d.Severity, singleLine(message))
+	return err
+}
+
+// Close implements the Formatter interface.
+func (f *editorFormatter) Close() error {
+	return nil
+}
+
+// Streaming implements the Streamer interface.
+func (f *editorFormatter) Streaming() bool {
+	return true
+}
+
+// singleLine returns the given message with every line break replaced by a space.
+func singleLine(s string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s)
+}
+
+// Editors that ProblemMatcher writes the problem matcher of.
+const (
+	// VSCode is Visual Studio Code, whose problem matcher is a problemMatcher of tasks.json.
+	VSCode = "vscode"
+
+	// IntelliJ is IntelliJ IDEA and the other JetBrains IDEs, whose problem matcher is an
+	// output filter of an external tool.
+	IntelliJ = "intellij"
+)
+
+// vscodeProblemMatcher is a problem matcher of Visual Studio Code, see
+// https://code.visualstudio.com/docs/editor/tasks#_defining-a-problem-matcher.
+type vscodeProblemMatcher struct {
+	Owner        string               `json:"owner"`
+	Source       string               `json:"source"`
+	FileLocation []string             `json:"fileLocation"`
+	Pattern      vscodeProblemPattern `json:"pattern"`
+}
+
+// vscodeProblemPattern is the pattern of a vscodeProblemMatcher, mapping the groups of
+// EditorPattern to the properties of a problem.
+type vscodeProblemPattern struct {
+	Regexp   string `json:"regexp"`
+	File     int    `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Code     int    `json:"code"`
+	Severity int    `json:"severity"`
+	Message  int    `json:"message"`
+}
+
+// ProblemMatcher writes the problem matcher of the given editor, one of VSCode or IntelliJ,
+// matching the output of the editor format to the given io.Writer: a problemMatcher object
+// to add to a task of tasks.json for VSCode, and the regular expression of an output filter
+// for IntelliJ, which only supports its own macros rather than groups.
+func ProblemMatcher(w io.Writer, editor string) error {
+	switch editor {
+	case VSCode:
+		b, err := json.MarshalIndent(vscodeProblemMatcher{
+			Owner:  "lintroller",
+			Source: "lintroller",
+			// The editor format writes paths relative to the working directory, which tasks
+			// run in by default, unless they're outside of it.
+			FileLocation: []string{"autoDetect", "${workspaceFolder}"},
+			Pattern: vscodeProblemPattern{
+				Regexp:   EditorPattern,
+				File:     1,
+				Line:     2,
+				Column:   3,
+				Code:     4,
+				Severity: 5,
+				Message:  6,
+			},
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	case IntelliJ:
+		_, err := fmt.Fprintln(w, "$FILE_PATH$:$LINE$:$COLUMN$: .*")
+		return err
+	default:
+		return fmt.Errorf("editor \"%s\" is not one of: %s, %s", editor, IntelliJ, VSCode)
+	}
+}
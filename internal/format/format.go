@@ -0,0 +1,221 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the registry of formatters and the diagnostic every
+// formatter takes as input.
+
+// Package format implements the formats the driver can write diagnostics in, selected
+// with the -format flag. Every format is a Formatter, a sink for Diagnostic values that
+// writes them to an io.Writer, registered under a name with Register, so adding a format
+// doesn't require any change to the driver.
+package format
+
+import (
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+)
+
+// Severities of a Diagnostic.
+const (
+	// SeverityError denotes an issue that fails the run.
+	SeverityError = "error"
+
+	// SeverityWarning denotes an issue that is reported but doesn't fail the run.
+	SeverityWarning = "warning"
+
+	// SeverityInfo denotes an issue in third-party code, which is reported but doesn't fail
+	// the run regardless of its linter, see common.SetThirdPartyPaths.
+	SeverityInfo = "info"
+)
+
+// Diagnostic is a single issue reported by a linter, the common input of every Formatter.
+type Diagnostic struct {
+	// Position is where the issue was reported.
+	Position token.Position
+
+	// Linter is the name of the linter that reported the issue.
+	Linter string
+
+	// Rule is the rule of the linter that reported the issue, for linters made up of
+	// several rules, e.g. doculint. It is empty for other linters.
+	Rule string
+
+	// Message describes the issue.
+	Message string
+
+	// URL is the documentation of the linter, or empty if documentation links are
+	// disabled.
+	URL string
+
+	// Severity is one of SeverityError, SeverityWarning, or SeverityInfo.
+	Severity string
+
+	// SuggestedFixes are the fixes the linter suggested for the issue, if any.
+	SuggestedFixes []SuggestedFix
+
+	// Related are the secondary positions the issue concerns besides Position, if any,
+	// e.g. the type of the enum-like constant block an issue was reported at.
+	Related []RelatedLocation
+}
+
+// RelatedLocation is a secondary position of a Diagnostic.
+type RelatedLocation struct {
+	// Position is the secondary position.
+	Position token.Position
+
+	// Message describes how the secondary position relates to the issue.
+	Message string
+}
+
+// SuggestedFix is a fix suggested for a Diagnostic, made up of edits to the files the issue
+// concerns.
+type SuggestedFix struct {
+	// Message describes the fix.
+	Message string
+
+	// Edits are the edits that make up the fix, which don't overlap.
+	Edits []TextEdit
+}
+
+// TextEdit replaces the bytes of a file between two offsets with new text.
+type TextEdit struct {
+	// Filename is the path of the file to edit.
+	Filename string
+
+	// Start and End are the byte offsets of the text to replace, End being exclusive. The
+	// new text is inserted when they are equal.
+	Start, End int
+
+	// NewText replaces the text between Start and End.
+	NewText []byte
+}
+
+// Formatter writes diagnostics in a single format. Formats that can't be written one
+// diagnostic at a time, e.g. a JSON document, buffer them until Close.
+type Formatter interface {
+	// Write writes, or buffers, the given diagnostic.
+	Write(d *Diagnostic) error
+
+	// Close finishes writing every diagnostic given to Write. It is called exactly once,
+	// even when no diagnostics were written.
+	Close() error
+}
+
+// Streamer is implemented by formatters that write each diagnostic as soon as it is given.
+// The driver gives such formatters the diagnostics of each package as soon as it is
+// linted, rather than holding on to them to write every package in a deterministic order.
+type Streamer interface {
+	// Streaming returns true if diagnostics should be given as soon as they are reported.
+	Streaming() bool
+}
+
+// Scored is implemented by formatters that include the quality scores of the run in their
+// output. The driver gives such formatters the scores before closing them when packages
+// are scored.
+type Scored interface {
+	// SetScore sets the scores written when the formatter is closed.
+	SetScore(r *score.Report)
+}
+
+// Summarized is implemented by formatters that include the end-of-run summary in their
+// output. The driver gives such formatters the summary before closing them unless it is
+// disabled.
+type Summarized interface {
+	// SetSummary sets the summary written when the formatter is closed.
+	SetSummary(s *reporter.Summary)
+}
+
+// Sourced is implemented by formatters that write the source of the lines diagnostics are
+// on. The driver gives such formatters the contents of the files that replace their
+// contents on disk, see driver.Options.Overlay, before writing any diagnostic.
+type Sourced interface {
+	// SetSource sets the content of the file with the given name, which is used instead of
+	// reading the file.
+	SetSource(filename string, content []byte)
+}
+
+// Factory returns a Formatter writing to the given io.Writer.
+type Factory func(w io.Writer) Formatter
+
+// registry maps the names of formats to the factories of their formatters.
+var registry = struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}{
+	factories: make(map[string]Factory),
+}
+
+// Register makes the format with the given name available to New, replacing any format
+// already registered under that name.
+func Register(name string, factory Factory) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.factories[name] = factory
+}
+
+// New returns the formatter of the format with the given name writing to the given
+// io.Writer.
+func New(name string, w io.Writer) (Formatter, error) {
+	registry.mu.RLock()
+	factory, ok := registry.factories[name]
+	registry.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("format \"%s\" is not one of: %s", name, strings.Join(Names(), ", "))
+	}
+
+	return factory(w), nil
+}
+
+// Names returns the names of every registered format, sorted.
+func Names() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// relativePath returns the given filename relative to the working directory, which is
+// usually the root of the module being linted, using forward slashes, unless it is
+// rewritten as configured with reporter.SetReportPaths. Filenames outside of the working
+// directory are returned as they are.
+func relativePath(filename string) string {
+	if rewritten, ok := reporter.ReportPath(filename); ok {
+		return filepath.ToSlash(rewritten)
+	}
+
+	if filepath.IsAbs(filename) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, filename); err == nil && !strings.HasPrefix(rel, "..") {
+				filename = rel
+			}
+		}
+	}
+
+	return filepath.ToSlash(filename)
+}
+
+// ruleID returns the identifier of the rule that reported the given diagnostic, the name
+// of its linter followed by its rule if it has one, e.g. "doculint/spelling".
+func ruleID(d *Diagnostic) string {
+	if d.Rule == "" {
+		return d.Linter
+	}
+
+	return d.Linter + "/" + d.Rule
+}
@@ -0,0 +1,466 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"gotest.tools/v3/assert"
+)
+
+// diagnostics are the diagnostics written by the formatters under test.
+var diagnostics = []Diagnostic{
+	{
+		Position: token.Position{Filename: "internal/foo/foo.go", Line: 3, Column: 1},
+		Linter:   "doculint",
+		Rule:     "missing-comment",
+		Message:  "function \"Foo\" has no comment associated with it",
+		URL:      "https://example.com/doculint.md",
+		Severity: SeverityError,
+	},
+	{
+		Position: token.Position{Filename: "internal/foo/foo.go", Line: 10, Column: 2},
+		Linter:   "todo",
+		Message:  "TODO comment has no username, ticket: follow up",
+		Severity: SeverityWarning,
+	},
+}
+
+// format writes diagnostics in the format of the given name and returns the output.
+func format(t *testing.T, name string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	f, err := New(name, &buf)
+	assert.NilError(t, err)
+
+	for i := range diagnostics {
+		assert.NilError(t, f.Write(&diagnostics[i]))
+	}
+	assert.NilError(t, f.Close())
+
+	return buf.String()
+}
+
+func TestNew(t *testing.T) {
+	assert.DeepEqual(t, Names(), []string{CodeClimate, Editor, GitHub, JSON, JSONL, SARIF, Sonar, Text})
+
+	_, err := New("xml", &bytes.Buffer{})
+	assert.Error(t, err, "format \"xml\" is not one of: codeclimate, editor, github, json, jsonl, sarif, sonar, text")
+}
+
+func TestText(t *testing.T) {
+	assert.Equal(t, format(t, Text),
+		"internal/foo/foo.go:3:1: function \"Foo\" has no comment associated with it "+
+			"(doculint, see https://example.com/doculint.md)\n"+
+			"internal/foo/foo.go:10:2: TODO comment has no username, ticket: follow up (todo) [WARNING]\n")
+}
+
+func TestJSON(t *testing.T) {
+	var out []jsonDiagnostic
+	assert.NilError(t, json.Unmarshal([]byte(format(t, JSON)), &out))
+
+	assert.DeepEqual(t, out, []jsonDiagnostic{
+		{
+			File:     "internal/foo/foo.go",
+			Line:     3,
+			Column:   1,
+			Linter:   "doculint",
+			Rule:     "missing-comment",
+			Severity: SeverityError,
+			Message:  "function \"Foo\" has no comment associated with it",
+			URL:      "https://example.com/doculint.md",
+		},
+		{
+			File:     "internal/foo/foo.go",
+			Line:     10,
+			Column:   2,
+			Linter:   "todo",
+			Severity: SeverityWarning,
+			Message:  "TODO comment has no username, ticket: follow up",
+		},
+	})
+}
+
+func TestJSONScored(t *testing.T) {
+	var buf bytes.Buffer
+	f, err := New(JSON, &buf)
+	assert.NilError(t, err)
+
+	report := &score.Report{
+		Score:    50,
+		Packages: []score.Package{{Package: "example.com/mod/internal/foo", Lines: 100, Findings: 1, Cost: 1, Score: 50}},
+	}
+
+	scored, ok := f.(Scored)
+	assert.Assert(t, ok)
+	scored.SetScore(report)
+
+	assert.NilError(t, f.Write(&diagnostics[0]))
+	assert.NilError(t, f.Close())
+
+	var out jsonOutput
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.DeepEqual(t, out, jsonOutput{
+		Diagnostics: []jsonDiagnostic{newJSONDiagnostic(&diagnostics[0])},
+		Score:       report,
+	})
+}
+
+// summary is the summary given to the formatters under test.
+var summary = &reporter.Summary{
+	Linters: []reporter.LinterSummary{{Linter: "doculint", Errors: 1, Rules: map[string]int{"missing-comment": 1}}},
+	Files:   []reporter.FileSummary{{Filename: "internal/foo/foo.go", Issues: 1}},
+}
+
+func TestJSONSummarized(t *testing.T) {
+	var buf bytes.Buffer
+	f, err := New(JSON, &buf)
+	assert.NilError(t, err)
+
+	summarized, ok := f.(Summarized)
+	assert.Assert(t, ok)
+	summarized.SetSummary(summary)
+
+	assert.NilError(t, f.Write(&diagnostics[0]))
+	assert.NilError(t, f.Close())
+
+	var out jsonOutput
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.DeepEqual(t, out, jsonOutput{
+		Diagnostics: []jsonDiagnostic{newJSONDiagnostic(&diagnostics[0])},
+		Summary:     summary,
+	})
+}
+
+func TestJSONSummarizedAndScored(t *testing.T) {
+	var buf bytes.Buffer
+	f, err := New(JSON, &buf)
+	assert.NilError(t, err)
+
+	report := &score.Report{Score: 100}
+	f.(Summarized).SetSummary(summary)
+	f.(Scored).SetScore(report)
+	assert.NilError(t, f.Close())
+
+	var out jsonOutput
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.DeepEqual(t, out, jsonOutput{Diagnostics: []jsonDiagnostic{}, Summary: summary, Score: report})
+}
+
+func TestJSONL(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(format(t, JSONL), "\n"), "\n")
+	assert.Equal(t, len(lines), len(diagnostics))
+
+	for i, line := range lines {
+		var out jsonDiagnostic
+		assert.NilError(t, json.Unmarshal([]byte(line), &out))
+		assert.DeepEqual(t, out, newJSONDiagnostic(&diagnostics[i]))
+	}
+
+	f, err := New(JSONL, &bytes.Buffer{})
+	assert.NilError(t, err)
+
+	s, ok := f.(Streamer)
+	assert.Assert(t, ok && s.Streaming())
+}
+
+func TestGitHub(t *testing.T) {
+	assert.Equal(t, format(t, GitHub),
+		"::error file=internal/foo/foo.go,line=3,col=1,title=doculint/missing-comment::"+
+			"function \"Foo\" has no comment associated with it%0ASee https://example.com/doculint.md\n"+
+			"::warning file=internal/foo/foo.go,line=10,col=2,title=todo::TODO comment has no username, ticket: follow up\n")
+}
+
+func TestEditor(t *testing.T) {
+	out := format(t, Editor)
+	assert.Equal(t, out,
+		"internal/foo/foo.go:3:1: [doculint/missing-comment] error: function \"Foo\" has no comment associated "+
+			"with it (see https://example.com/doculint.md)\n"+
+			"internal/foo/foo.go:10:2: [todo] warning: TODO comment has no username, ticket: follow up\n")
+
+	pattern := regexp.MustCompile(EditorPattern)
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		assert.Assert(t, pattern.MatchString(line), "line %q isn't matched by EditorPattern", line)
+	}
+
+	var buf bytes.Buffer
+	f := NewEditor(&buf)
+	assert.NilError(t, f.Write(&Diagnostic{
+		Position: token.Position{Filename: "foo.go", Line: 1, Column: 1},
+		Linter:   "errorlint",
+		Message:  "first line\nsecond line",
+		Severity: SeverityError,
+	}))
+	assert.Equal(t, buf.String(), "foo.go:1:1: [errorlint] error: first line second line\n")
+}
+
+func TestProblemMatcher(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NilError(t, ProblemMatcher(&buf, VSCode))
+
+	var matcher vscodeProblemMatcher
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &matcher))
+	assert.Equal(t, matcher.Pattern.Regexp, EditorPattern)
+
+	assert.Error(t, ProblemMatcher(&buf, "vim"), "editor \"vim\" is not one of: intellij, vscode")
+}
+
+func TestSARIF(t *testing.T) {
+	var out sarifLog
+	assert.NilError(t, json.Unmarshal([]byte(format(t, SARIF)), &out))
+
+	assert.Equal(t, out.Version, sarifVersion)
+	assert.Equal(t, len(out.Runs), 1)
+	assert.DeepEqual(t, out.Runs[0].Tool.Driver.Rules, []sarifRule{
+		{ID: "doculint/missing-comment", HelpURI: "https://example.com/doculint.md"},
+		{ID: "todo"},
+	})
+	assert.Equal(t, len(out.Runs[0].Results), 2)
+	assert.Equal(t, out.Runs[0].Results[1].Level, SeverityWarning)
+	assert.Equal(t, out.Runs[0].Results[1].Locations[0].PhysicalLocation.Region.StartLine, 10)
+	assert.Assert(t, out.Runs[0].Properties == nil)
+}
+
+func TestSARIFSummarizedAndScored(t *testing.T) {
+	var buf bytes.Buffer
+	f, err := New(SARIF, &buf)
+	assert.NilError(t, err)
+
+	summarized, ok := f.(Summarized)
+	assert.Assert(t, ok)
+	summarized.SetSummary(summary)
+
+	scored, ok := f.(Scored)
+	assert.Assert(t, ok)
+	report := &score.Report{Score: 100}
+	scored.SetScore(report)
+
+	assert.NilError(t, f.Write(&diagnostics[0]))
+	assert.NilError(t, f.Close())
+
+	var out sarifLog
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, len(out.Runs), 1)
+	assert.DeepEqual(t, out.Runs[0].Properties, &sarifRunProperties{Summary: summary, Score: report})
+}
+
+func TestCodeClimate(t *testing.T) {
+	var out []codeClimateIssue
+	assert.NilError(t, json.Unmarshal([]byte(format(t, CodeClimate)), &out))
+
+	assert.Equal(t, len(out), 2)
+	assert.Equal(t, out[0].CheckName, "doculint/missing-comment")
+	assert.Equal(t, out[0].Severity, "major")
+	assert.Equal(t, out[1].Severity, "minor")
+	assert.Equal(t, out[1].Location.Path, "internal/foo/foo.go")
+	assert.Assert(t, out[0].Fingerprint != out[1].Fingerprint)
+}
+
+func TestSonar(t *testing.T) {
+	var out sonarReport
+	assert.NilError(t, json.Unmarshal([]byte(format(t, Sonar)), &out))
+
+	assert.Equal(t, len(out.Issues), 2)
+	assert.Equal(t, out.Issues[0].EngineID, "lintroller")
+	assert.Equal(t, out.Issues[0].RuleID, "doculint/missing-comment")
+	assert.Equal(t, out.Issues[0].Severity, "MAJOR")
+	assert.Equal(t, out.Issues[1].Severity, "MINOR")
+	assert.Equal(t, out.Issues[1].PrimaryLocation.FilePath, "internal/foo/foo.go")
+	assert.Equal(t, out.Issues[1].PrimaryLocation.TextRange.StartLine, 10)
+	assert.Equal(t, *out.Issues[1].PrimaryLocation.TextRange.StartColumn, 1)
+}
+
+func TestTextWithContext(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "foo.go")
+	assert.NilError(t, os.WriteFile(filename, []byte("package foo\n\nfunc Foo() {}\n\nvar x = 1\n"), 0o600))
+
+	var buf bytes.Buffer
+	f := NewTextWithContext(&buf, 1)
+	assert.NilError(t, f.Write(&Diagnostic{
+		Position: token.Position{Filename: filename, Line: 3, Column: 1},
+		Linter:   "doculint",
+		Message:  "function \"Foo\" has no comment associated with it",
+		Severity: SeverityError,
+	}))
+	assert.NilError(t, f.Close())
+
+	assert.Equal(t, buf.String(), filename+":3:1: function \"Foo\" has no comment associated with it (doculint)\n"+
+		"2\t\n3\tfunc Foo() {}\n4\t\n")
+}
+
+func TestTextWithColor(t *testing.T) {
+	var buf bytes.Buffer
+	f := NewTextWithColor(&buf, -1, true)
+	assert.NilError(t, f.Write(&Diagnostic{
+		Position: token.Position{Filename: "foo.go", Line: 3, Column: 1},
+		Linter:   "doculint",
+		Message:  "function \"Foo\" has no comment associated with it",
+		Severity: SeverityError,
+	}))
+	assert.NilError(t, f.Write(&Diagnostic{
+		Position: token.Position{Filename: "foo.go", Line: 5, Column: 1},
+		Linter:   "todo",
+		Message:  "TODO has no owner",
+		Severity: SeverityWarning,
+	}))
+	assert.NilError(t, f.Close())
+
+	assert.Equal(t, buf.String(),
+		"\x1b[1mfoo.go:3:1\x1b[0m: \x1b[31mfunction \"Foo\" has no comment associated with it\x1b[0m \x1b[2m(doculint)\x1b[0m\n"+
+			"\x1b[1mfoo.go:5:1\x1b[0m: \x1b[33mTODO has no owner\x1b[0m \x1b[2m(todo)\x1b[0m \x1b[33m[WARNING]\x1b[0m\n")
+}
+
+func TestColorEnabled(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Equal(t, ColorEnabled(&buf, false), false, "colored output that isn't a terminal")
+
+	t.Setenv("NO_COLOR", "1")
+	assert.Equal(t, ColorEnabled(os.Stderr, false), false, "colored output with NO_COLOR set")
+}
+
+func TestRewrittenPaths(t *testing.T) {
+	reporter.SetReportPaths("", []reporter.PathRewrite{{From: "/sandbox/execroot/__main__/", To: ""}})
+	t.Cleanup(func() { reporter.SetReportPaths("", nil) })
+
+	d := &Diagnostic{
+		Position: token.Position{Filename: "/sandbox/execroot/__main__/internal/foo/foo.go", Line: 3, Column: 1},
+		Linter:   "todo",
+		Message:  "TODO has no owner",
+		Severity: SeverityError,
+	}
+
+	var text bytes.Buffer
+	f := NewText(&text)
+	assert.NilError(t, f.Write(d))
+	assert.NilError(t, f.Close())
+	assert.Equal(t, text.String(), "internal/foo/foo.go:3:1: TODO has no owner (todo)\n")
+
+	assert.Equal(t, newJSONDiagnostic(d).File, "internal/foo/foo.go")
+}
+
+func TestRelated(t *testing.T) {
+	d := Diagnostic{
+		Position: token.Position{Filename: "internal/foo/foo.go", Line: 8, Column: 1},
+		Linter:   "doculint",
+		Rule:     "missing-comment",
+		Message:  "constant block has no comment associated with it",
+		Severity: SeverityError,
+		Related: []RelatedLocation{{
+			Position: token.Position{Filename: "internal/foo/foo.go", Line: 6, Column: 6},
+			Message:  "enum type \"Color\" of the constant block",
+		}},
+	}
+
+	write := func(name string) string {
+		var buf bytes.Buffer
+		f, err := New(name, &buf)
+		assert.NilError(t, err)
+		assert.NilError(t, f.Write(&d))
+		assert.NilError(t, f.Close())
+		return buf.String()
+	}
+
+	assert.Equal(t, write(Text), "internal/foo/foo.go:8:1: constant block has no comment associated with it (doculint)\n"+
+		"\tinternal/foo/foo.go:6:6: enum type \"Color\" of the constant block\n")
+
+	var jsonOut []jsonDiagnostic
+	assert.NilError(t, json.Unmarshal([]byte(write(JSON)), &jsonOut))
+	assert.DeepEqual(t, jsonOut[0].Related, []jsonRelated{
+		{File: "internal/foo/foo.go", Line: 6, Column: 6, Message: "enum type \"Color\" of the constant block"},
+	})
+
+	var sarifOut sarifLog
+	assert.NilError(t, json.Unmarshal([]byte(write(SARIF)), &sarifOut))
+	related := sarifOut.Runs[0].Results[0].RelatedLocations
+	assert.Equal(t, len(related), 1)
+	assert.Equal(t, related[0].PhysicalLocation.Region.StartLine, 6)
+	assert.Equal(t, related[0].Message.Text, "enum type \"Color\" of the constant block")
+
+	var sonarOut sonarReport
+	assert.NilError(t, json.Unmarshal([]byte(write(Sonar)), &sonarOut))
+	assert.Equal(t, len(sonarOut.Issues[0].SecondaryLocations), 1)
+	assert.Equal(t, sonarOut.Issues[0].SecondaryLocations[0].Message, "enum type \"Color\" of the constant block")
+
+	var codeClimateOut []codeClimateIssue
+	assert.NilError(t, json.Unmarshal([]byte(write(CodeClimate)), &codeClimateOut))
+	assert.DeepEqual(t, codeClimateOut[0].OtherLocations, []codeClimateLocation{
+		{Path: "internal/foo/foo.go", Lines: codeClimateLines{Begin: 6}},
+	})
+}
+
+func TestTee(t *testing.T) {
+	var text, jsonBuf bytes.Buffer
+	f := Tee(NewText(&text), NewJSON(&jsonBuf))
+
+	streamer, ok := f.(Streamer)
+	assert.Assert(t, ok)
+	assert.Equal(t, streamer.Streaming(), false)
+
+	summarized, ok := f.(Summarized)
+	assert.Assert(t, ok)
+	summarized.SetSummary(summary)
+
+	assert.NilError(t, f.Write(&diagnostics[0]))
+	assert.NilError(t, f.Close())
+
+	assert.Assert(t, strings.Contains(text.String(), diagnostics[0].Message))
+
+	var out jsonOutput
+	assert.NilError(t, json.Unmarshal(jsonBuf.Bytes(), &out))
+	assert.DeepEqual(t, out, jsonOutput{
+		Diagnostics: []jsonDiagnostic{newJSONDiagnostic(&diagnostics[0])},
+		Summary:     summary,
+	})
+
+	streamer, ok = Tee(NewJSONL(&text), NewJSON(&jsonBuf)).(Streamer)
+	assert.Assert(t, ok)
+	assert.Equal(t, streamer.Streaming(), true)
+}
+
+func TestInfo(t *testing.T) {
+	d := Diagnostic{
+		Position: token.Position{Filename: "internal/copied/lru.go", Line: 1, Column: 1},
+		Linter:   "license",
+		Message:  "file has no license header",
+		Severity: SeverityInfo,
+	}
+
+	write := func(name string) string {
+		var buf bytes.Buffer
+		f, err := New(name, &buf)
+		assert.NilError(t, err)
+		assert.NilError(t, f.Write(&d))
+		assert.NilError(t, f.Close())
+		return buf.String()
+	}
+
+	assert.Equal(t, write(Text), "internal/copied/lru.go:1:1: file has no license header (license) [INFO]\n")
+	assert.Equal(t, write(GitHub), "::notice file=internal/copied/lru.go,line=1,col=1,title=license::file has no license header\n")
+
+	editor := write(Editor)
+	assert.Equal(t, editor, "internal/copied/lru.go:1:1: [license] info: file has no license header\n")
+	assert.Assert(t, regexp.MustCompile(EditorPattern).MatchString(strings.TrimSuffix(editor, "\n")))
+
+	var sarifOut sarifLog
+	assert.NilError(t, json.Unmarshal([]byte(write(SARIF)), &sarifOut))
+	assert.Equal(t, sarifOut.Runs[0].Results[0].Level, "note")
+
+	var sonarOut sonarReport
+	assert.NilError(t, json.Unmarshal([]byte(write(Sonar)), &sonarOut))
+	assert.Equal(t, sonarOut.Issues[0].Severity, "INFO")
+
+	var codeClimateOut []codeClimateIssue
+	assert.NilError(t, json.Unmarshal([]byte(write(CodeClimate)), &codeClimateOut))
+	assert.Equal(t, codeClimateOut[0].Severity, "info")
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the github format, which writes GitHub Actions
+// workflow commands that annotate the lines of a pull request.
+
+package format
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GitHub is the name of the github format.
+const GitHub = "github"
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(GitHub, NewGitHub)
+}
+
+// githubCommands maps the severities of diagnostics to the workflow commands annotating
+// them.
+var githubCommands = map[string]string{
+	SeverityError:   "error",
+	SeverityWarning: "warning",
+	SeverityInfo:    "notice",
+}
+
+// githubFormatter implements the github format.
+type githubFormatter struct {
+	w io.Writer
+}
+
+// NewGitHub returns a Formatter writing each diagnostic to the given io.Writer as it is
+// given, as an error, warning, or notice workflow command, see
+// https://docs.github.com/actions/using-workflows/workflow-commands-for-github-actions.
+func NewGitHub(w io.Writer) Formatter {
+	return &githubFormatter{w: w}
+}
+
+// Write implements the Formatter interface.
+func (f *githubFormatter) Write(d *Diagnostic) error {
+	message := d.Message
+	if d.URL != "" {
+		message = fmt.Sprintf("%s\nSee %s", d.Message, d.URL)
+	}
+
+	_, err := fmt.Fprintf(f.w, "::%s file=%s,line=%d,col=%d,title=%s::%s\n", githubCommands[d.Severity],
+		escapeGitHubProperty(relativePath(d.Position.Filename)), d.Position.Line, d.Position.Column,
+		escapeGitHubProperty(ruleID(d)), escapeGitHubData(message))
+	return err
+}
+
+// Close implements the Formatter interface.
+func (f *githubFormatter) Close() error {
+	return nil
+}
+
+// escapeGitHubData escapes the given message of a workflow command.
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeGitHubProperty escapes the given value of a property of a workflow command.
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer(":", "%3A", ",", "%2C").Replace(escapeGitHubData(s))
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the json format, which writes every diagnostic as a
+// single JSON array for tools to consume.
+
+package format
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"github.com/pkg/errors"
+)
+
+// JSON is the name of the json format.
+const JSON = "json"
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(JSON, NewJSON)
+}
+
+// jsonDiagnostic is the JSON representation of a Diagnostic.
+type jsonDiagnostic struct {
+	// File is the path of the file the issue was reported in, relative to the working
+	// directory.
+	File string `json:"file"`
+
+	// Line is the line the issue was reported on, starting at 1.
+	Line int `json:"line"`
+
+	// Column is the column the issue was reported at, starting at 1.
+	Column int `json:"column"`
+
+	// Linter is the name of the linter that reported the issue.
+	Linter string `json:"linter"`
+
+	// Rule is the rule of the linter that reported the issue, if it has rules.
+	Rule string `json:"rule,omitempty"`
+
+	// Severity is one of SeverityError, SeverityWarning, or SeverityInfo.
+	Severity string `json:"severity"`
+
+	// Message describes the issue.
+	Message string `json:"message"`
+
+	// URL is the documentation of the linter, if documentation links are enabled.
+	URL string `json:"url,omitempty"`
+
+	// Related are the secondary positions the issue concerns, if any.
+	Related []jsonRelated `json:"related,omitempty"`
+}
+
+// jsonRelated is the JSON representation of a RelatedLocation.
+type jsonRelated struct {
+	// File is the path of the file of the position, relative to the working directory.
+	File string `json:"file"`
+
+	// Line is the line of the position, starting at 1.
+	Line int `json:"line"`
+
+	// Column is the column of the position, starting at 1.
+	Column int `json:"column"`
+
+	// Message describes how the position relates to the issue.
+	Message string `json:"message"`
+}
+
+// newJSONDiagnostic returns the JSON representation of the given diagnostic.
+func newJSONDiagnostic(d *Diagnostic) jsonDiagnostic {
+	var related []jsonRelated
+	for i := range d.Related {
+		related = append(related, jsonRelated{
+			File:    relativePath(d.Related[i].Position.Filename),
+			Line:    d.Related[i].Position.Line,
+			Column:  d.Related[i].Position.Column,
+			Message: d.Related[i].Message,
+		})
+	}
+
+	return jsonDiagnostic{
+		File:     relativePath(d.Position.Filename),
+		Line:     d.Position.Line,
+		Column:   d.Position.Column,
+		Linter:   d.Linter,
+		Rule:     d.Rule,
+		Severity: d.Severity,
+		Message:  d.Message,
+		URL:      d.URL,
+		Related:  related,
+	}
+}
+
+// jsonFormatter implements the json format.
+type jsonFormatter struct {
+	w           io.Writer
+	diagnostics []jsonDiagnostic
+	summary     *reporter.Summary
+	score       *score.Report
+}
+
+// jsonOutput is the JSON document written along with the summary or the scores of the run.
+type jsonOutput struct {
+	// Diagnostics contains every diagnostic.
+	Diagnostics []jsonDiagnostic `json:"diagnostics"`
+
+	// Summary contains the end-of-run summary, if it is enabled.
+	Summary *reporter.Summary `json:"summary,omitempty"`
+
+	// Score contains the quality scores of the run, if packages are scored.
+	Score *score.Report `json:"score,omitempty"`
+}
+
+// NewJSON returns a Formatter writing every diagnostic to the given io.Writer as an
+// indented JSON array once it is closed. When given the summary, see Summarized, or the
+// scores, see Scored, an object holding the array under "diagnostics", the summary under
+// "summary" and the scores under "score" is written instead.
+func NewJSON(w io.Writer) Formatter {
+	return &jsonFormatter{w: w, diagnostics: []jsonDiagnostic{}}
+}
+
+// Write implements the Formatter interface.
+func (f *jsonFormatter) Write(d *Diagnostic) error {
+	f.diagnostics = append(f.diagnostics, newJSONDiagnostic(d))
+	return nil
+}
+
+// SetSummary implements the Summarized interface.
+func (f *jsonFormatter) SetSummary(s *reporter.Summary) {
+	f.summary = s
+}
+
+// SetScore implements the Scored interface.
+func (f *jsonFormatter) SetScore(r *score.Report) {
+	f.score = r
+}
+
+// Close implements the Formatter interface.
+func (f *jsonFormatter) Close() error {
+	if f.summary != nil || f.score != nil {
+		out := jsonOutput{Diagnostics: f.diagnostics, Summary: f.summary, Score: f.score}
+		return errors.Wrap(writeJSON(f.w, out), "write json diagnostics")
+	}
+
+	return errors.Wrap(writeJSON(f.w, f.diagnostics), "write json diagnostics")
+}
+
+// writeJSON writes the given value to the given io.Writer as indented JSON.
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the jsonl format, which streams each diagnostic as a
+// JSON object on a line of its own for very large runs to be piped into other tools.
+
+package format
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// JSONL is the name of the jsonl format.
+const JSONL = "jsonl"
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(JSONL, NewJSONL)
+}
+
+// jsonlFormatter implements the jsonl format.
+type jsonlFormatter struct {
+	enc *json.Encoder
+}
+
+// NewJSONL returns a Formatter writing each diagnostic to the given io.Writer as soon as
+// it is given, as a JSON object on a line of its own. Nothing is buffered, so the memory
+// it uses doesn't grow with the number of diagnostics.
+func NewJSONL(w io.Writer) Formatter {
+	return &jsonlFormatter{enc: json.NewEncoder(w)}
+}
+
+// Write implements the Formatter interface.
+func (f *jsonlFormatter) Write(d *Diagnostic) error {
+	return errors.Wrap(f.enc.Encode(newJSONDiagnostic(d)), "write jsonl diagnostic")
+}
+
+// Close implements the Formatter interface.
+func (f *jsonlFormatter) Close() error {
+	return nil
+}
+
+// Streaming implements the Streamer interface.
+func (f *jsonlFormatter) Streaming() bool {
+	return true
+}
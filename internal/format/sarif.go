@@ -0,0 +1,213 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the sarif format, the static analysis results
+// interchange format read by code scanning tools, e.g. GitHub code scanning.
+
+package format
+
+import (
+	"go/token"
+	"io"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"github.com/pkg/errors"
+)
+
+// SARIF is the name of the sarif format.
+const SARIF = "sarif"
+
+// Constants describing the SARIF log written by the sarif format.
+const (
+	// sarifVersion is the version of SARIF the log conforms to.
+	sarifVersion = "2.1.0"
+
+	// sarifSchema is the JSON schema of sarifVersion.
+	sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	// sarifToolURI is the URI of the tool that produced the log.
+	sarifToolURI = "https://github.com/getoutreach/lintroller"
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(SARIF, NewSARIF)
+}
+
+// sarifLevels maps the severities of diagnostics to the levels of SARIF results.
+var sarifLevels = map[string]string{
+	SeverityError:   "error",
+	SeverityWarning: "warning",
+	SeverityInfo:    "note",
+}
+
+// The subset of the SARIF object model written by the sarif format, see
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html.
+type (
+	// sarifLog is the top-level object of a SARIF log.
+	sarifLog struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	// sarifRun is a single run of a tool.
+	sarifRun struct {
+		Tool       sarifTool           `json:"tool"`
+		Results    []sarifResult       `json:"results"`
+		Properties *sarifRunProperties `json:"properties,omitempty"`
+	}
+
+	// sarifRunProperties is the property bag of a run, holding the end-of-run summary and
+	// the scores.
+	sarifRunProperties struct {
+		Summary *reporter.Summary `json:"summary,omitempty"`
+		Score   *score.Report     `json:"score,omitempty"`
+	}
+
+	// sarifTool describes the tool that produced the results.
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	// sarifDriver describes the component of the tool that produced the results.
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		InformationURI string      `json:"informationUri"`
+		Rules          []sarifRule `json:"rules"`
+	}
+
+	// sarifRule describes a rule results refer to.
+	sarifRule struct {
+		ID      string `json:"id"`
+		HelpURI string `json:"helpUri,omitempty"`
+	}
+
+	// sarifResult is a single issue.
+	sarifResult struct {
+		RuleID           string          `json:"ruleId"`
+		Level            string          `json:"level"`
+		Message          sarifMessage    `json:"message"`
+		Locations        []sarifLocation `json:"locations"`
+		RelatedLocations []sarifLocation `json:"relatedLocations,omitempty"`
+	}
+
+	// sarifMessage is the message of a result.
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	// sarifLocation is where a result was reported, or a related location of it along with
+	// a message describing how it relates.
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+		Message          *sarifMessage         `json:"message,omitempty"`
+	}
+
+	// sarifPhysicalLocation is a location within a file.
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           sarifRegion           `json:"region"`
+	}
+
+	// sarifArtifactLocation is the file of a location.
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	// sarifRegion is the position of a location within its file.
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn,omitempty"`
+	}
+)
+
+// sarifFormatter implements the sarif format.
+type sarifFormatter struct {
+	w       io.Writer
+	rules   []sarifRule
+	ruleIDs map[string]bool
+	results []sarifResult
+	summary *reporter.Summary
+	score   *score.Report
+}
+
+// NewSARIF returns a Formatter writing every diagnostic to the given io.Writer as a SARIF
+// log once it is closed. The summary, see Summarized, and the scores, see Scored, are
+// written under "summary" and "score" of the properties of its run.
+func NewSARIF(w io.Writer) Formatter {
+	return &sarifFormatter{
+		w:       w,
+		rules:   []sarifRule{},
+		ruleIDs: make(map[string]bool),
+		results: []sarifResult{},
+	}
+}
+
+// Write implements the Formatter interface.
+func (f *sarifFormatter) Write(d *Diagnostic) error {
+	id := ruleID(d)
+	if !f.ruleIDs[id] {
+		f.ruleIDs[id] = true
+		f.rules = append(f.rules, sarifRule{ID: id, HelpURI: d.URL})
+	}
+
+	var related []sarifLocation
+	for i := range d.Related {
+		related = append(related, sarifLocation{
+			PhysicalLocation: newSARIFPhysicalLocation(&d.Related[i].Position),
+			Message:          &sarifMessage{Text: d.Related[i].Message},
+		})
+	}
+
+	f.results = append(f.results, sarifResult{
+		RuleID:           id,
+		Level:            sarifLevels[d.Severity],
+		Message:          sarifMessage{Text: d.Message},
+		Locations:        []sarifLocation{{PhysicalLocation: newSARIFPhysicalLocation(&d.Position)}},
+		RelatedLocations: related,
+	})
+
+	return nil
+}
+
+// newSARIFPhysicalLocation returns the SARIF representation of the given position.
+func newSARIFPhysicalLocation(position *token.Position) sarifPhysicalLocation {
+	return sarifPhysicalLocation{
+		ArtifactLocation: sarifArtifactLocation{URI: relativePath(position.Filename)},
+		Region:           sarifRegion{StartLine: position.Line, StartColumn: position.Column},
+	}
+}
+
+// SetSummary implements the Summarized interface.
+func (f *sarifFormatter) SetSummary(s *reporter.Summary) {
+	f.summary = s
+}
+
+// SetScore implements the Scored interface.
+func (f *sarifFormatter) SetScore(r *score.Report) {
+	f.score = r
+}
+
+// Close implements the Formatter interface.
+func (f *sarifFormatter) Close() error {
+	var properties *sarifRunProperties
+	if f.summary != nil || f.score != nil {
+		properties = &sarifRunProperties{Summary: f.summary, Score: f.score}
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "lintroller",
+				InformationURI: sarifToolURI,
+				Rules:          f.rules,
+			}},
+			Results:    f.results,
+			Properties: properties,
+		}},
+	}
+
+	return errors.Wrap(writeJSON(f.w, log), "write sarif log")
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the sonar format, the SonarQube generic issue import
+// format.
+
+package format
+
+import (
+	"go/token"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Sonar is the name of the sonar format.
+const Sonar = "sonar"
+
+// sonarEngineID identifies lintroller as the engine that reported the issues in SonarQube.
+const sonarEngineID = "lintroller"
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(Sonar, NewSonar)
+}
+
+// sonarSeverities maps the severities of diagnostics to SonarQube severities.
+var sonarSeverities = map[string]string{
+	SeverityError:   "MAJOR",
+	SeverityWarning: "MINOR",
+	SeverityInfo:    "INFO",
+}
+
+// The subset of the SonarQube generic issue data written by the sonar format, see
+// https://docs.sonarsource.com/sonarqube/latest/analyzing-source-code/importing-external-issues/generic-issue-import-format/.
+type (
+	// sonarReport is the top-level object of the format.
+	sonarReport struct {
+		Issues []sonarIssue `json:"issues"`
+	}
+
+	// sonarIssue is a single issue.
+	sonarIssue struct {
+		EngineID           string          `json:"engineId"`
+		RuleID             string          `json:"ruleId"`
+		Severity           string          `json:"severity"`
+		Type               string          `json:"type"`
+		PrimaryLocation    sonarLocation   `json:"primaryLocation"`
+		SecondaryLocations []sonarLocation `json:"secondaryLocations,omitempty"`
+	}
+
+	// sonarLocation is where an issue was reported.
+	sonarLocation struct {
+		Message   string         `json:"message"`
+		FilePath  string         `json:"filePath"`
+		TextRange sonarTextRange `json:"textRange"`
+	}
+
+	// sonarTextRange is the range of a location. Unlike lines, columns start at 0.
+	sonarTextRange struct {
+		StartLine   int  `json:"startLine"`
+		StartColumn *int `json:"startColumn,omitempty"`
+	}
+)
+
+// sonarFormatter implements the sonar format.
+type sonarFormatter struct {
+	w      io.Writer
+	issues []sonarIssue
+}
+
+// NewSonar returns a Formatter writing every diagnostic to the given io.Writer as a
+// SonarQube generic issue report once it is closed. Issues are reported as code smells,
+// with the rule of the diagnostic as the rule key, see ruleID.
+func NewSonar(w io.Writer) Formatter {
+	return &sonarFormatter{w: w, issues: []sonarIssue{}}
+}
+
+// Write implements the Formatter interface.
+func (f *sonarFormatter) Write(d *Diagnostic) error {
+	var secondary []sonarLocation
+	for i := range d.Related {
+		secondary = append(secondary, newSonarLocation(&d.Related[i].Position, d.Related[i].Message))
+	}
+
+	f.issues = append(f.issues, sonarIssue{
+		EngineID:           sonarEngineID,
+		RuleID:             ruleID(d),
+		Severity:           sonarSeverities[d.Severity],
+		Type:               "CODE_SMELL",
+		PrimaryLocation:    newSonarLocation(&d.Position, d.Message),
+		SecondaryLocations: secondary,
+	})
+
+	return nil
+}
+
+// newSonarLocation returns the location at the given position with the given message.
+func newSonarLocation(position *token.Position, message string) sonarLocation {
+	textRange := sonarTextRange{StartLine: position.Line}
+	if position.Column > 0 {
+		column := position.Column - 1
+		textRange.StartColumn = &column
+	}
+
+	return sonarLocation{
+		Message:   message,
+		FilePath:  relativePath(position.Filename),
+		TextRange: textRange,
+	}
+}
+
+// Close implements the Formatter interface.
+func (f *sonarFormatter) Close() error {
+	return errors.Wrap(writeJSON(f.w, sonarReport{Issues: f.issues}), "write sonar issues")
+}
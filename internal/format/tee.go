@@ -0,0 +1,78 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements a formatter writing every diagnostic to two formatters,
+// e.g. to upload the results of a run in the json format along with the selected format.
+
+package format
+
+import (
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"github.com/pkg/errors"
+)
+
+// teeFormatter implements Tee.
+type teeFormatter struct {
+	primary, secondary Formatter
+}
+
+// Tee returns a Formatter writing every diagnostic to both of the given formatters, and
+// giving both the summary and the scores of the run when they take them. The diagnostics
+// are given as soon as they're reported only if the primary formatter streams them, see
+// Streamer, so that the output of the primary formatter is the same as without Tee.
+func Tee(primary, secondary Formatter) Formatter {
+	return &teeFormatter{primary: primary, secondary: secondary}
+}
+
+// Write implements the Formatter interface.
+func (f *teeFormatter) Write(d *Diagnostic) error {
+	if err := f.primary.Write(d); err != nil {
+		return err
+	}
+
+	return f.secondary.Write(d)
+}
+
+// Streaming implements the Streamer interface.
+func (f *teeFormatter) Streaming() bool {
+	streamer, ok := f.primary.(Streamer)
+	return ok && streamer.Streaming()
+}
+
+// SetSummary implements the Summarized interface.
+func (f *teeFormatter) SetSummary(s *reporter.Summary) {
+	for _, formatter := range []Formatter{f.primary, f.secondary} {
+		if summarized, ok := formatter.(Summarized); ok {
+			summarized.SetSummary(s)
+		}
+	}
+}
+
+// SetScore implements the Scored interface.
+func (f *teeFormatter) SetScore(r *score.Report) {
+	for _, formatter := range []Formatter{f.primary, f.secondary} {
+		if scored, ok := formatter.(Scored); ok {
+			scored.SetScore(r)
+		}
+	}
+}
+
+// SetSource implements the Sourced interface.
+func (f *teeFormatter) SetSource(filename string, content []byte) {
+	for _, formatter := range []Formatter{f.primary, f.secondary} {
+		if sourced, ok := formatter.(Sourced); ok {
+			sourced.SetSource(filename, content)
+		}
+	}
+}
+
+// Close implements the Formatter interface. Both formatters are closed even when closing
+// the primary formatter fails.
+func (f *teeFormatter) Close() error {
+	err := f.primary.Close()
+	if errSecondary := f.secondary.Close(); err == nil && errSecondary != nil {
+		err = errors.Wrap(errSecondary, "close secondary formatter")
+	}
+
+	return err
+}
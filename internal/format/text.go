@@ -0,0 +1,154 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the text format, the default, which writes one line
+// per diagnostic for people to read.
+
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/getoutreach/lintroller/internal/reporter"
+)
+
+// Text is the name of the text format.
+const Text = "text"
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to register the format.
+	Register(Text, NewText)
+}
+
+// textFormatter implements the text format.
+type textFormatter struct {
+	w io.Writer
+
+	// context is the number of lines of source written before and after the line of each
+	// diagnostic, or negative to not write any source.
+	context int
+
+	// sources maps filenames to the lines of the files source was written from.
+	sources map[string][][]byte
+
+	// color denotes whether or not the output is colored with ANSI escape sequences, see
+	// ColorEnabled.
+	color bool
+}
+
+// NewText returns a Formatter writing each diagnostic to the given io.Writer as it is
+// given, on a line of its own, followed by its linter and documentation link. Its related
+// positions, if any, follow on lines of their own, indented by a tab.
+func NewText(w io.Writer) Formatter {
+	return NewTextWithContext(w, -1)
+}
+
+// NewTextWithContext returns the text Formatter, see NewText, additionally writing the line
+// each diagnostic was reported on with the given number of lines of context before and
+// after it, like the -c flag of the drivers in golang.org/x/tools/go/analysis. No source is
+// written when the given number of lines is negative.
+func NewTextWithContext(w io.Writer, lines int) Formatter {
+	return NewTextWithColor(w, lines, false)
+}
+
+// NewTextWithColor returns the text Formatter with the given number of lines of context,
+// see NewTextWithContext, coloring its output when color is true: the position of each
+// diagnostic is highlighted, its message is colored by its severity, and its linter and
+// the line numbers of the source are dimmed.
+func NewTextWithColor(w io.Writer, lines int, color bool) Formatter {
+	return &textFormatter{w: w, context: lines, sources: make(map[string][][]byte), color: color}
+}
+
+// Write implements the Formatter interface.
+func (f *textFormatter) Write(d *Diagnostic) error {
+	annotation := d.Linter
+	if d.URL != "" {
+		annotation = fmt.Sprintf("%s, see %s", d.Linter, d.URL)
+	}
+
+	message := f.paint(colorRed, d.Message)
+
+	var suffix string
+	switch d.Severity {
+	case SeverityWarning:
+		message = f.paint(colorYellow, d.Message)
+		suffix = " " + f.paint(colorYellow, "[WARNING]")
+	case SeverityInfo:
+		message = d.Message
+		suffix = " " + f.paint(colorDim, "[INFO]")
+	}
+
+	// The position is written with its file rewritten as configured, if it is, while the
+	// source is still read from the file itself.
+	position := d.Position
+	position.Filename, _ = reporter.ReportPath(position.Filename)
+
+	if _, err := fmt.Fprintf(f.w, "%s: %s %s%s\n", f.paint(colorBold, position.String()), message,
+		f.paint(colorDim, "("+annotation+")"), suffix); err != nil {
+		return err
+	}
+
+	// Related positions are written indented beneath the diagnostic, so that they aren't
+	// mistaken for diagnostics of their own.
+	for i := range d.Related {
+		related := d.Related[i].Position
+		related.Filename, _ = reporter.ReportPath(related.Filename)
+
+		if _, err := fmt.Fprintf(f.w, "\t%s: %s\n", f.paint(colorBold, related.String()), d.Related[i].Message); err != nil {
+			return err
+		}
+	}
+
+	if f.context < 0 || d.Position.Line == 0 {
+		return nil
+	}
+
+	return f.writeSource(&d.Position)
+}
+
+// SetSource implements the Sourced interface.
+func (f *textFormatter) SetSource(filename string, content []byte) {
+	f.sources[filename] = bytes.Split(content, []byte("\n"))
+}
+
+// writeSource writes the line at the given position along with the configured number of
+// lines of context around it. Files that can't be read, e.g. because the position is in a
+// generated file that no longer exists, are skipped.
+func (f *textFormatter) writeSource(position *token.Position) error {
+	lines, ok := f.sources[position.Filename]
+	if !ok {
+		content, err := os.ReadFile(position.Filename)
+		if err == nil {
+			lines = bytes.Split(content, []byte("\n"))
+		}
+		f.sources[position.Filename] = lines
+	}
+
+	start := max(position.Line-f.context, 1)
+	end := min(position.Line+f.context, len(lines))
+	for i := start; i <= end; i++ {
+		if _, err := fmt.Fprintf(f.w, "%s\t%s\n", f.paint(colorDim, strconv.Itoa(i)), lines[i-1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// paint returns the given text wrapped in the given ANSI escape sequence, or the text as
+// it is if the output isn't colored.
+func (f *textFormatter) paint(color, text string) string {
+	if !f.color {
+		return text
+	}
+
+	return color + text + colorReset
+}
+
+// Close implements the Formatter interface.
+func (f *textFormatter) Close() error {
+	return nil
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package gogenerate contains the necessary logic for the gogenerate linter. The gogenerate
+// linter ensures that the tools invoked by //go:generate directives are version-pinned, so
+// that generated code is the same regardless of the machine it was generated on.
+package gogenerate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the gogenerate linter.
+const name = "gogenerate"
+
+// doc defines the help text for the gogenerate linter.
+const doc = `Ensures that the tools invoked by //go:generate directives are version-pinned, either by
+running them with "go run <package>@<version>", by running a package whose module is
+required by go.mod (e.g. through a tools.go file), or with "go tool".`
+
+// directive is the prefix of a go:generate directive.
+const directive = "//go:generate "
+
+// Analyzer exports the gogenerate analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      gogenerate,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(allowedCommands []string) *analysis.Analyzer {
+	rawAllowedCommands = strings.Join(allowedCommands, ",")
+	return &Analyzer
+}
+
+// rawAllowedCommands is a variable that gets collected via flags. This variable contains
+// a comma-separated list of commands that may be invoked by go:generate directives without
+// being version-pinned, e.g. "sh".
+var rawAllowedCommands string
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.StringVar(&rawAllowedCommands, "allowedCommands", "",
+		"comma-separated list of commands go:generate directives may invoke without pinning them")
+}
+
+// unpinnedVersions are the versions that can be given to "go run" which resolve to
+// different versions over time.
+var unpinnedVersions = map[string]bool{
+	"latest": true,
+	"main":   true,
+	"master": true,
+	"HEAD":   true,
+}
+
+// goRunValueFlags are the flags of "go run" that take their value as a separate argument.
+var goRunValueFlags = map[string]bool{
+	"-C":        true,
+	"-exec":     true,
+	"-gcflags":  true,
+	"-ldflags":  true,
+	"-mod":      true,
+	"-modfile":  true,
+	"-overlay":  true,
+	"-pgo":      true,
+	"-pkgdir":   true,
+	"-tags":     true,
+	"-toolexec": true,
+}
+
+// gogenerate is the function that gets passed to the Analyzer which runs the actual
+// analysis for the gogenerate linter on a set of files.
+func gogenerate(_pass *analysis.Pass) (interface{}, error) {
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	allowed := make(map[string]bool)
+	for _, command := range strings.Split(rawAllowedCommands, ",") {
+		if command = strings.TrimSpace(command); command != "" {
+			allowed[command] = true
+		}
+	}
+
+	for _, file := range pass.Files {
+		// Ignore generated files and files in ignored paths. Unlike the other linters, test
+		// files are checked since their directives are ran by go generate all the same.
+		if class := common.ClassifyFile(pass.Pass, file); class.Ignored || (class.Generated && !common.LintsGenerated(pass.Pass)) {
+			continue
+		}
+		filename := pass.Fset.PositionFor(file.Package, false).Filename
+
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				if !strings.HasPrefix(comment.Text, directive) {
+					continue
+				}
+
+				mod, err := modules.forDir(filepath.Dir(filename))
+				if err != nil {
+					return nil, err
+				}
+
+				if problem := check(splitArgs(strings.TrimPrefix(comment.Text, directive)), allowed, mod); problem != "" {
+					pass.Reportf(comment.Slash, "%s", problem)
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// check returns a description of why the given go:generate arguments aren't version-pinned,
+// or an empty string if they are.
+func check(args []string, allowed map[string]bool, mod *module) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	command := args[0]
+	switch {
+	case allowed[command]:
+		return ""
+	case strings.HasPrefix(command, "./") || strings.HasPrefix(command, "../"):
+		// Scripts that live in the module are versioned along with it.
+		return ""
+	case command != "go":
+		return fmt.Sprintf("go:generate invokes \"%s\" which isn't version-pinned, "+
+			"run it with \"go run <package>@<version>\" or track it in go.mod instead", command)
+	}
+
+	if len(args) < 2 || args[1] != "run" {
+		// Any other go command, e.g. "go tool", is pinned by the toolchain or go.mod.
+		return ""
+	}
+
+	pkg := goRunPackage(args[2:])
+	if pkg == "" {
+		return ""
+	}
+
+	if path, version, ok := strings.Cut(pkg, "@"); ok {
+		if unpinnedVersions[version] {
+			return fmt.Sprintf("go:generate runs \"%s\" at \"%s\", which isn't a fixed version", path, version)
+		}
+		return ""
+	}
+
+	if strings.HasPrefix(pkg, ".") || mod.requires(pkg) {
+		return ""
+	}
+
+	return fmt.Sprintf("go:generate runs \"%s\" which isn't required by go.mod, "+
+		"pin it with \"@<version>\" or track it in a tools.go file", pkg)
+}
+
+// goRunPackage returns the package argument of "go run" given the arguments after "run".
+func goRunPackage(args []string) string {
+	for i := 0; i < len(args); i++ {
+		if strings.HasPrefix(args[i], "-") {
+			if !strings.Contains(args[i], "=") && goRunValueFlags[args[i]] {
+				i++
+			}
+			continue
+		}
+
+		return args[i]
+	}
+
+	return ""
+}
+
+// splitArgs splits the arguments of a go:generate directive the same way go generate does,
+// on spaces, with double-quoted arguments kept together.
+func splitArgs(line string) []string {
+	var args []string
+	var arg strings.Builder
+	var quoted, inArg bool
+
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inArg = true
+		case (r == ' ' || r == '\t') && !quoted:
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		args = append(args, arg.String())
+	}
+
+	return args
+}
+
+// module contains the paths of a module and every module it requires.
+type module struct {
+	// path is the path of the module itself.
+	path string
+
+	// required contains the paths of the modules the module requires.
+	required []string
+}
+
+// requires reports whether or not the given package belongs to the module or one of the
+// modules it requires. A nil module, one that couldn't be found, requires nothing.
+func (m *module) requires(pkg string) bool {
+	if m == nil {
+		return false
+	}
+
+	within := func(modulePath string) bool {
+		return pkg == modulePath || strings.HasPrefix(pkg, modulePath+"/")
+	}
+
+	if within(m.path) {
+		return true
+	}
+
+	for _, required := range m.required {
+		if within(required) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// moduleCache caches the module that each directory belongs to.
+type moduleCache struct {
+	mu   sync.Mutex
+	dirs map[string]*module
+}
+
+// modules is the process-wide cache of modules by directory.
+var modules = moduleCache{
+	dirs: make(map[string]*module),
+}
+
+// forDir returns the module that the given directory belongs to, see common.FindGoMod. A
+// nil module is returned if there is none.
+func (c *moduleCache) forDir(dir string) (*module, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if m, ok := c.dirs[dir]; ok {
+		return m, nil
+	}
+
+	var m *module
+	if path := common.FindGoMod(dir); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "read go.mod")
+		}
+
+		f, err := modfile.ParseLax(path, content, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse \"%s\"", path)
+		}
+
+		m = &module{}
+		if f.Module != nil {
+			m.path = f.Module.Mod.Path
+		}
+		for _, r := range f.Require {
+			m.required = append(m.required, r.Mod.Path)
+		}
+	}
+
+	c.dirs[dir] = m
+	return m, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package gogenerate
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCheck(t *testing.T) {
+	mod := &module{
+		path:     "github.com/getoutreach/example",
+		required: []string{"github.com/golang/mock"},
+	}
+
+	tt := []struct {
+		name     string
+		line     string
+		expected string
+	}{
+		{
+			name: "Accepts go run with a version",
+			line: "go run golang.org/x/tools/cmd/stringer@v0.24.0 -type=Foo",
+		},
+		{
+			name: "Accepts go run of a required module",
+			line: "go run -mod=mod github.com/golang/mock/mockgen -source foo.go",
+		},
+		{
+			name: "Accepts go run of a package in the module",
+			line: "go run -tags tools github.com/getoutreach/example/cmd/gen",
+		},
+		{
+			name: "Accepts go tool",
+			line: "go tool stringer -type=Foo",
+		},
+		{
+			name: "Accepts scripts in the module",
+			line: "./scripts/gen.sh \"some arg\"",
+		},
+		{
+			name: "Accepts allowed commands",
+			line: "sh -c \"echo hi\"",
+		},
+		{
+			name:     "Rejects bare binaries",
+			line:     "mockgen -source foo.go",
+			expected: "go:generate invokes \"mockgen\" which isn't version-pinned",
+		},
+		{
+			name:     "Rejects floating versions",
+			line:     "go run golang.org/x/tools/cmd/stringer@latest -type=Foo",
+			expected: "at \"latest\", which isn't a fixed version",
+		},
+		{
+			name:     "Rejects go run of modules that aren't required",
+			line:     "go run golang.org/x/tools/cmd/stringer -type=Foo",
+			expected: "isn't required by go.mod",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			problem := check(splitArgs(test.line), map[string]bool{"sh": true}, mod)
+			if test.expected == "" {
+				assert.Equal(t, problem, "")
+				return
+			}
+
+			assert.Assert(t, problem != "")
+			assert.Assert(t, strings.Contains(problem, test.expected), problem)
+		})
+	}
+}
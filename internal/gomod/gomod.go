@@ -0,0 +1,230 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package gomod contains the necessary logic for the gomod linter. The gomod linter lints
+// the go.mod file of the module each analyzed package belongs to against a policy:
+// forbidden replace directives, a minimum Go version, banned modules, and pseudo-versions
+// outside of allowlisted module paths.
+//
+// The go.mod file is added to the file set of each pass so that the issues found in it
+// flow through the same reporter, and therefore the same nolint handling, summary, and
+// output, as every other linter. Since every package of a module shares its go.mod file,
+// the same issues are found for each of them and rely on the reporter only emitting each
+// issue once per run.
+package gomod
+
+import (
+	"fmt"
+	"go/version"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the gomod linter.
+const name = "gomod"
+
+// doc defines the help text for the gomod linter.
+const doc = `Lints the go.mod file of each module against a policy of forbidden replace directives,
+a minimum Go version, banned modules, and pseudo-versions outside of allowlisted paths.`
+
+// Analyzer exports the gomod analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      gomod,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer},
+}
+
+// Policy is the policy that go.mod files are linted against. The zero value allows
+// everything.
+type Policy struct {
+	// ForbidReplace denotes whether or not replace directives are forbidden, except for
+	// those replacing a module matching AllowedReplace.
+	ForbidReplace bool
+
+	// AllowedReplace contains globs, see common.MatchGlob, of the module paths that may
+	// be replaced when ForbidReplace is set.
+	AllowedReplace []string
+
+	// MinGoVersion is the minimum version, e.g. "1.22", that the go directive must be set to.
+	MinGoVersion string
+
+	// BannedModules contains globs of module paths that must not be required.
+	BannedModules []string
+
+	// ForbidPseudoVersions denotes whether or not requiring a pseudo-version is forbidden,
+	// except for modules matching PseudoVersionAllowlist.
+	ForbidPseudoVersions bool
+
+	// PseudoVersionAllowlist contains globs of module paths that may be required at a
+	// pseudo-version when ForbidPseudoVersions is set.
+	PseudoVersionAllowlist []string
+}
+
+// policy is the policy go.mod files are currently linted against, see
+// NewAnalyzerWithOptions.
+var policy Policy
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(p *Policy) *analysis.Analyzer {
+	policy = *p
+	return &Analyzer
+}
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.BoolVar(&policy.ForbidReplace, "forbidReplace", false,
+		"a boolean flag that denotes whether or not replace directives are forbidden")
+	Analyzer.Flags.Var(commaList{&policy.AllowedReplace}, "allowedReplace",
+		"comma-separated list of module path globs that may be replaced")
+	Analyzer.Flags.StringVar(&policy.MinGoVersion, "minGoVersion", "", "the minimum version the go directive must be set to")
+	Analyzer.Flags.Var(commaList{&policy.BannedModules}, "bannedModules",
+		"comma-separated list of module path globs that must not be required")
+	Analyzer.Flags.BoolVar(&policy.ForbidPseudoVersions, "forbidPseudoVersions", false,
+		"a boolean flag that denotes whether or not requiring pseudo-versions is forbidden")
+	Analyzer.Flags.Var(commaList{&policy.PseudoVersionAllowlist}, "pseudoVersionAllowlist",
+		"comma-separated list of module path globs that may be required at pseudo-versions")
+}
+
+// commaList is a flag.Value that sets a slice of strings from a comma-separated list.
+type commaList struct {
+	values *[]string
+}
+
+// String implements the flag.Value interface.
+func (c commaList) String() string {
+	if c.values == nil {
+		return ""
+	}
+	return strings.Join(*c.values, ",")
+}
+
+// Set implements the flag.Value interface.
+func (c commaList) Set(value string) error {
+	*c.values = nil
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*c.values = append(*c.values, v)
+		}
+	}
+	return nil
+}
+
+// gomod is the function that gets passed to the Analyzer which runs the actual analysis
+// for the gomod linter on the go.mod file of the package being analyzed.
+func gomod(_pass *analysis.Pass) (interface{}, error) {
+	if len(_pass.Files) == 0 {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	path := common.FindGoMod(filepath.Dir(pass.Fset.PositionFor(pass.Files[0].Package, false).Filename))
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read go.mod")
+	}
+
+	// Unlike ParseLax, Parse keeps replace directives.
+	f, err := modfile.Parse(path, content, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse \"%s\"", path)
+	}
+
+	tf := pass.Fset.AddFile(path, -1, len(content))
+	tf.SetLinesForContent(content)
+
+	for _, v := range check(f, &policy) {
+		pass.Reportf(tf.Pos(v.offset), "%s", v.message)
+	}
+
+	return nil, nil
+}
+
+// violation is a single violation of the policy within a go.mod file.
+type violation struct {
+	// offset is the byte offset within the go.mod file the violation was found at.
+	offset int
+
+	// message describes the violation.
+	message string
+}
+
+// check returns every violation of the given policy in the given go.mod file.
+func check(f *modfile.File, p *Policy) []violation {
+	var violations []violation
+
+	if p.MinGoVersion != "" {
+		switch {
+		case f.Go == nil:
+			offset := 0
+			if f.Module != nil && f.Module.Syntax != nil {
+				offset = f.Module.Syntax.Start.Byte
+			}
+			violations = append(violations, violation{offset, fmt.Sprintf("go.mod has no go directive, go %s or later is required", p.MinGoVersion)})
+		case !atLeast(f.Go.Version, p.MinGoVersion):
+			violations = append(violations, violation{f.Go.Syntax.Start.Byte,
+				fmt.Sprintf("go.mod requires go %s, go %s or later is required", f.Go.Version, p.MinGoVersion)})
+		}
+	}
+
+	for _, r := range f.Require {
+		if matchesAny(p.BannedModules, r.Mod.Path) {
+			violations = append(violations, violation{r.Syntax.Start.Byte, fmt.Sprintf("module \"%s\" is banned", r.Mod.Path)})
+		}
+
+		if p.ForbidPseudoVersions && module.IsPseudoVersion(r.Mod.Version) && !matchesAny(p.PseudoVersionAllowlist, r.Mod.Path) {
+			violations = append(violations, violation{r.Syntax.Start.Byte,
+				fmt.Sprintf("module \"%s\" is required at pseudo-version %s, require a tagged version instead", r.Mod.Path, r.Mod.Version)})
+		}
+	}
+
+	if p.ForbidReplace {
+		for _, r := range f.Replace {
+			if !matchesAny(p.AllowedReplace, r.Old.Path) {
+				violations = append(violations, violation{r.Syntax.Start.Byte,
+					fmt.Sprintf("module \"%s\" must not be replaced", r.Old.Path)})
+			}
+		}
+	}
+
+	return violations
+}
+
+// atLeast reports whether or not the given go directive version is at least the given
+// minimum. A language version, e.g. "1.21", is treated as its first release, "1.21.0",
+// rather than as coming before it.
+func atLeast(v, minimum string) bool {
+	v, minimum = "go"+v, "go"+minimum
+	if version.Lang(v) == v && version.Lang(minimum) == v {
+		return true
+	}
+	return version.Compare(v, minimum) >= 0
+}
+
+// matchesAny reports whether or not the given module path matches any of the given globs.
+func matchesAny(globs []string, path string) bool {
+	for _, glob := range globs {
+		if common.MatchGlob(glob, path) {
+			return true
+		}
+	}
+
+	return false
+}
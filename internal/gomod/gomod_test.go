@@ -0,0 +1,82 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package gomod
+
+import (
+	"testing"
+
+	"golang.org/x/mod/modfile"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheck(t *testing.T) {
+	const content = `module github.com/getoutreach/example
+
+go 1.21
+
+require (
+	github.com/getoutreach/gobox v1.90.0
+	github.com/getoutreach/internal v0.0.0-20240102150405-abcdefabcdef
+	github.com/golang/mock v1.6.0
+	golang.org/x/exp v0.0.0-20240102150405-abcdefabcdef
+)
+
+replace github.com/getoutreach/gobox => ../gobox
+
+replace golang.org/x/exp => golang.org/x/exp v0.0.0-20240102150405-abcdefabcdef
+`
+
+	f, err := modfile.Parse("go.mod", []byte(content), nil)
+	assert.NilError(t, err)
+
+	tt := []struct {
+		name     string
+		policy   Policy
+		expected []string
+	}{
+		{
+			name: "Allows everything by default",
+		},
+		{
+			name:     "Rejects a go directive below the minimum",
+			policy:   Policy{MinGoVersion: "1.22"},
+			expected: []string{"go.mod requires go 1.21, go 1.22 or later is required"},
+		},
+		{
+			name:   "Accepts a go directive at the minimum",
+			policy: Policy{MinGoVersion: "1.21.0"},
+		},
+		{
+			name:     "Rejects banned modules",
+			policy:   Policy{BannedModules: []string{"github.com/golang/**"}},
+			expected: []string{"module \"github.com/golang/mock\" is banned"},
+		},
+		{
+			name:   "Rejects pseudo-versions outside of the allowlist",
+			policy: Policy{ForbidPseudoVersions: true, PseudoVersionAllowlist: []string{"github.com/getoutreach/*"}},
+			expected: []string{
+				"module \"golang.org/x/exp\" is required at pseudo-version v0.0.0-20240102150405-abcdefabcdef, " +
+					"require a tagged version instead",
+			},
+		},
+		{
+			name:   "Rejects replace directives outside of the allowlist",
+			policy: Policy{ForbidReplace: true, AllowedReplace: []string{"golang.org/x/*"}},
+			expected: []string{
+				"module \"github.com/getoutreach/gobox\" must not be replaced",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			var messages []string
+			for _, v := range check(f, &test.policy) {
+				assert.Assert(t, v.offset > 0)
+				messages = append(messages, v.message)
+			}
+
+			assert.DeepEqual(t, messages, test.expected)
+		})
+	}
+}
@@ -9,9 +9,12 @@ package header
 
 import (
 	"fmt"
+	"go/ast"
+	"go/token"
 	"strings"
 
 	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -36,6 +39,15 @@ This would mean the linter would pass on files that resemble the following:
 
 	package foo
 
+The header can also be a block comment, e.g. when it is emitted by a tool:
+
+	/*
+	 * Authors(s): <value>
+	 * Description: <value>
+	 */
+
+	package foo
+
 The <value> portion of each field can extend to the next line, as shown in the
 value for the description field above; however, there can't be a break in the
 comment group for the header, as follows:
@@ -49,20 +61,33 @@ comment group for the header, as follows:
 
 // Analyzer exports the doculint analyzer (linter).
 var Analyzer = analysis.Analyzer{
-	Name: name,
-	Doc:  doc,
-	Run:  header,
+	Name:     name,
+	Doc:      doc,
+	Run:      header,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
 }
 
 // NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
 // that would have been defined via flags if this was ran as a vet tool. This is so the
 // analyzers can be ran outside of the context of a vet tool and config can be gathered
 // from elsewhere.
-func NewAnalyzerWithOptions(_rawFields string) *analysis.Analyzer {
+func NewAnalyzerWithOptions(_rawFields string, _pathFields []PathFields) *analysis.Analyzer {
 	rawFields = _rawFields
+	pathFields = append(pathFieldsFlag(nil), _pathFields...)
 	return &Analyzer
 }
 
+// PathFields are the header fields required in the files matching any of a set of path
+// globs, on top of the fields given by the -fields flag.
+type PathFields struct {
+	// Paths are the path globs, relative to the working directory, of the files the fields
+	// are required in, see common.MatchGlob.
+	Paths []string
+
+	// Fields are the fields required in the files matching any of Paths.
+	Fields []string
+}
+
 // Variable block to keep track of flags whose values are collected at runtime. See the
 // init function that immediately proceeds this block to see more.
 var (
@@ -70,73 +95,167 @@ var (
 	// comma-separated list of fields required to be filled out within the header of a
 	// file.
 	rawFields string
+
+	// pathFields is a variable that gets collected via flags. This variable contains the
+	// fields required in the files matching path globs.
+	pathFields pathFieldsFlag
 )
 
+// pathFieldsFlag is a flag.Value that collects the -path-fields flag, which is given once
+// per set of path globs in the form of "<glob>|<glob>=<field>,<field>".
+type pathFieldsFlag []PathFields
+
+// String implements the flag.Value interface.
+func (p *pathFieldsFlag) String() string {
+	values := make([]string, 0, len(*p))
+	for _, pf := range *p {
+		values = append(values, strings.Join(pf.Paths, "|")+"="+strings.Join(pf.Fields, ","))
+	}
+
+	return strings.Join(values, " ")
+}
+
+// Set implements the flag.Value interface.
+func (p *pathFieldsFlag) Set(value string) error {
+	paths, fields, ok := strings.Cut(value, "=")
+	if !ok || paths == "" || fields == "" {
+		return fmt.Errorf("path fields \"%s\" are not in the form of \"<glob>|<glob>=<field>,<field>\"", value)
+	}
+
+	*p = append(*p, PathFields{
+		Paths:  strings.Split(paths, "|"),
+		Fields: strings.Split(fields, ","),
+	})
+	return nil
+}
+
 func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
 	Analyzer.Flags.StringVar(&rawFields, "fields", "Description", "comma-separated list of fields required to be filled out in the header")
+	//nolint:lll // Why: usage long
+	Analyzer.Flags.Var(&pathFields, "path-fields", "fields additionally required in the header of the files matching path globs, in the form of \"<glob>|<glob>=<field>,<field>\", may be given more than once")
 }
 
 // header is the function that gets passed to the Analyzer which runs the actual
 // analysis for the header linter on a set of files.
-func header(pass *analysis.Pass) (interface{}, error) { //nolint:funlen // Why: Doesn't make sense to break this up.
+func header(_pass *analysis.Pass) (interface{}, error) {
 	// Ignore test packages.
-	if common.IsTestPackage(pass) {
+	if common.SkipPackage(_pass) {
 		return nil, nil
 	}
 
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
 	fields := strings.Split(rawFields, ",")
-	validFields := make(map[string]bool, len(fields))
 
-	for _, file := range pass.Files {
-		// Ignore generated files and test files.
-		if common.IsGenerated(file) || common.IsTestFile(pass, file) {
-			continue
+	pass.ForEachFile(common.FilesWithExcluded(pass.Pass), func(r *reporter.Buffer, file *ast.File) {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			return
 		}
 
-		if pass.Pkg.Name() == common.PackageMain {
+		filename := common.RelativePath(pass.Fset.PositionFor(file.Package, false).Filename)
+		required := requiredFields(filename, fields, pathFields)
+
+		if file.Name.Name == common.PackageMain {
 			// Ignore the main package, there should really one ever be one file in the
 			// main package and it should contain func main, leaving implementation to
-			// exist in the calling functions that exist in their own packages.
-
-			continue
+			// exist in the calling functions that exist in their own packages. Fields
+			// required by path are still required, since they were asked for explicitly.
+			required = requiredFields(filename, nil, pathFields)
 		}
 
-		// Reset the validFields variable and assume all fields are valid by default
-		// for each file in this pass (package).
-		for i := range fields {
-			validFields[fields[i]] = false
+		if len(required) == 0 {
+			return
 		}
 
-		// Note the package keyword line. All of these header comments must exist before
-		// this line number.
-		packageKeywordLine := pass.Fset.PositionFor(file.Package, false).Line
+		checkFile(r, pass.Fset, file, required)
+	})
 
-		for _, commentGroup := range file.Comments {
-			line := pass.Fset.PositionFor(commentGroup.Pos(), false).Line
-			if line >= packageKeywordLine {
-				// Ignore comments past the line that the package keyword is on. These header
-				// fields are required to exist before that.
-				continue
+	return nil, nil
+}
+
+// requiredFields returns the given fields followed by the fields of every entry of the
+// given path fields matching the given filename, without duplicates.
+func requiredFields(filename string, fields []string, pathFields []PathFields) []string {
+	required := append([]string(nil), fields...)
+
+	for i := range pathFields {
+		var matches bool
+		for _, pattern := range pathFields[i].Paths {
+			if common.MatchGlob(pattern, filename) {
+				matches = true
+				break
 			}
+		}
 
-			var numFound int
+		if !matches {
+			continue
+		}
 
-			// Look to see if all of the fields are found in the format we expect them to be
-			// in (sans-quotes):
-			// "<field>: "
-			// by a simple strings.Contains check in the entire text of the comment group. If
-			// we end up finding all fields we will do further validation.
-			for i := range fields {
-				if strings.Contains(commentGroup.Text(), fmt.Sprintf("%s: ", fields[i])) {
-					numFound++
+		for _, field := range pathFields[i].Fields {
+			var found bool
+			for _, r := range required {
+				if r == field {
+					found = true
+					break
 				}
 			}
 
-			// All fields are found, do further validation.
-			if len(fields) == numFound {
-				for _, comment := range commentGroup.List {
+			if !found {
+				required = append(required, field)
+			}
+		}
+	}
+
+	return required
+}
+
+// checkFile reports each of the given fields that isn't filled out in the header of the
+// given file.
+func checkFile(r reporter.Reporter, fset *token.FileSet, file *ast.File, fields []string) {
+	// Assume all fields are invalid until they are found filled out.
+	validFields := make(map[string]bool, len(fields))
+
+	// partial is the header comment containing the most of the fields, if any contains
+	// some, which the issues point at as well as the package keyword.
+	var partial *ast.CommentGroup
+	var partialFound int
+
+	// Note the package keyword line. All of these header comments must exist before
+	// this line number.
+	packageKeywordLine := fset.PositionFor(file.Package, false).Line
+
+	for _, commentGroup := range file.Comments {
+		line := fset.PositionFor(commentGroup.Pos(), false).Line
+		if line >= packageKeywordLine {
+			// Ignore comments past the line that the package keyword is on. These header
+			// fields are required to exist before that.
+			continue
+		}
+
+		var numFound int
+
+		// Look to see if all of the fields are found in the format we expect them to be
+		// in (sans-quotes):
+		// "<field>: "
+		// by a simple strings.Contains check in the entire text of the comment group. If
+		// we end up finding all fields we will do further validation.
+		for i := range fields {
+			if strings.Contains(commentGroup.Text(), fmt.Sprintf("%s: ", fields[i])) {
+				numFound++
+			}
+		}
+
+		if numFound > partialFound {
+			partial, partialFound = commentGroup, numFound
+		}
+
+		// All fields are found, do further validation.
+		if len(fields) == numFound {
+			for _, comment := range commentGroup.List {
+				for _, cleanComment := range commentLines(comment) {
 					for i := range fields {
-						cleanComment := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
 						prefix := fmt.Sprintf("%s: ", fields[i])
 
 						if strings.HasPrefix(cleanComment, prefix) {
@@ -149,26 +268,56 @@ func header(pass *analysis.Pass) (interface{}, error) { //nolint:funlen // Why:
 						}
 					}
 				}
-
-				// We found a comment block containing all fields, we don't need to search any further.
-				break
 			}
+
+			// We found a comment block containing all fields, we don't need to search any further.
+			break
 		}
+	}
 
-		// Get current filepath for potential reporting.
-		fp := pass.Fset.PositionFor(file.Package, false).Filename
-
-		for field, valid := range validFields {
-			if !valid {
-				// Required field not found, report it.
-				pass.Reportf(
-					0,
-					"file \"%s\" does not contain the required header key \"%s\" and corresponding value existing before the package keyword",
-					fp,
-					field)
+	// Get current filepath for potential reporting.
+	fp := fset.PositionFor(file.Package, false).Filename
+
+	for _, field := range fields {
+		if !validFields[field] {
+			// Required field not found, report it, pointing at the header comment with the
+			// most of the fields as well if there is one.
+			var related []analysis.RelatedInformation
+			if partial != nil {
+				related = append(related, analysis.RelatedInformation{
+					Pos:     partial.Pos(),
+					Message: fmt.Sprintf("header comment without a value for \"%s\"", field),
+				})
 			}
+
+			reporter.ReportRelated(r, "",
+				file.Package, related,
+				"file \"%s\" does not contain the required header key \"%s\" and corresponding value existing before the package keyword",
+				fp,
+				field)
 		}
 	}
+}
 
-	return nil, nil
+// commentLines returns the lines of the given comment with their comment markers and
+// surrounding space trimmed. Line comments are a single line, while block comments, e.g.
+// the ones emitted by some code generation tools, span several lines which may each be
+// prefixed by an asterisk:
+//
+//	/*
+//	 * Description: <value>
+//	 */
+func commentLines(comment *ast.Comment) []string {
+	if !strings.HasPrefix(comment.Text, "/*") {
+		return []string{strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))}
+	}
+
+	text := strings.TrimSuffix(strings.TrimPrefix(comment.Text, "/*"), "*/")
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		lines = append(lines, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*")))
+	}
+
+	return lines
 }
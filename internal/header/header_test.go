@@ -0,0 +1,175 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package header
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, NewAnalyzerWithOptions("Description", nil), "header")
+}
+
+func TestCheckFile(t *testing.T) {
+	tt := []struct {
+		name     string
+		src      string
+		expected []string
+		related  []string
+	}{
+		{
+			name: "Allows line comment headers",
+			src: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: Does things.
+// Gotchas: None.
+
+package foo
+`,
+			expected: nil,
+		},
+		{
+			name: "Allows block comment headers",
+			src: `/*
+ * Description: Does things,
+ * across several lines.
+ * Gotchas: None.
+ */
+
+package foo
+`,
+			expected: nil,
+		},
+		{
+			name: "Allows single line block comment headers",
+			src: `/* Description: Does things. */
+/* Gotchas: None. */
+
+package foo
+`,
+			expected: nil,
+		},
+		{
+			name: "Reports block comment headers with empty fields",
+			src: `/*
+ * Description: Does things.
+ * Gotchas: 
+ */
+
+package foo
+`,
+			expected: []string{
+				"file \"foo.go\" does not contain the required header key \"Description\" and corresponding value " +
+					"existing before the package keyword",
+				"file \"foo.go\" does not contain the required header key \"Gotchas\" and corresponding value " +
+					"existing before the package keyword",
+			},
+			related: []string{
+				"header comment without a value for \"Description\"",
+				"header comment without a value for \"Gotchas\"",
+			},
+		},
+		{
+			name: "Points at headers missing fields",
+			src: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: Does things.
+
+package foo
+`,
+			expected: []string{
+				"file \"foo.go\" does not contain the required header key \"Description\" and corresponding value " +
+					"existing before the package keyword",
+				"file \"foo.go\" does not contain the required header key \"Gotchas\" and corresponding value " +
+					"existing before the package keyword",
+			},
+			related: []string{
+				"header comment without a value for \"Description\"",
+				"header comment without a value for \"Gotchas\"",
+			},
+		},
+		{
+			name: "Reports missing headers",
+			src:  "package foo\n",
+			expected: []string{
+				"file \"foo.go\" does not contain the required header key \"Description\" and corresponding value " +
+					"existing before the package keyword",
+				"file \"foo.go\" does not contain the required header key \"Gotchas\" and corresponding value " +
+					"existing before the package keyword",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", test.src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			checkFile(&r, fset, file, []string{"Description", "Gotchas"})
+			assert.DeepEqual(t, r.Messages, test.expected)
+			assert.DeepEqual(t, r.Related, test.related)
+		})
+	}
+}
+
+func TestRequiredFields(t *testing.T) {
+	pathFields := []PathFields{
+		{Paths: []string{"cmd/**"}, Fields: []string{"Command"}},
+		{Paths: []string{"internal/api/**", "pkg/api/**"}, Fields: []string{"API-Owner", "Description"}},
+	}
+
+	tt := []struct {
+		name     string
+		filename string
+		fields   []string
+		expected []string
+	}{
+		{
+			name:     "Requires the fields of matching paths",
+			filename: "cmd/foo/main.go",
+			fields:   []string{"Description"},
+			expected: []string{"Description", "Command"},
+		},
+		{
+			name:     "Doesn't require fields twice",
+			filename: "pkg/api/api.go",
+			fields:   []string{"Description"},
+			expected: []string{"Description", "API-Owner"},
+		},
+		{
+			name:     "Only requires the given fields elsewhere",
+			filename: "internal/store/store.go",
+			fields:   []string{"Description"},
+			expected: []string{"Description"},
+		},
+		{
+			name:     "Requires nothing without fields or matching paths",
+			filename: "main.go",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, requiredFields(test.filename, test.fields, pathFields), test.expected)
+		})
+	}
+}
+
+func TestPathFieldsFlag(t *testing.T) {
+	var p pathFieldsFlag
+	assert.NilError(t, p.Set("cmd/**|tools/**=Command,Usage"))
+	assert.DeepEqual(t, []PathFields(p), []PathFields{
+		{Paths: []string{"cmd/**", "tools/**"}, Fields: []string{"Command", "Usage"}},
+	})
+	assert.Equal(t, p.String(), "cmd/**|tools/**=Command,Usage")
+
+	assert.ErrorContains(t, p.Set("cmd/**"), "not in the form of")
+}
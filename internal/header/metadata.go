@@ -0,0 +1,99 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the analyzer that extracts the fields in the header
+// of each file of a package for other linters to compare against.
+
+package header
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"regexp"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"golang.org/x/tools/go/analysis"
+)
+
+// FieldDescription is the name of the header field that describes what a file contains.
+const FieldDescription = "Description"
+
+// Fields maps the name of each field in the header of a file to its value, e.g.
+// "Description" to what the file contains. Values that extend to the following lines are
+// joined by a space.
+type Fields map[string]string
+
+// Metadata maps each file of a package to the fields in its header.
+type Metadata map[*ast.File]Fields
+
+// MetadataAnalyzer is the analyzer that extracts the fields in the header of each file of
+// a package. It reports no issues of its own, so other linters can list it in their
+// Requires to check the headers of files against the rest of the package, e.g. doculint
+// checks descriptions against the package comment, whether or not header is enabled.
+var MetadataAnalyzer = analysis.Analyzer{
+	Name:       "headermetadata",
+	Doc:        "Extracts the fields in the header of each file of a package for the lintroller linters.",
+	Run:        extractMetadata,
+	Requires:   []*analysis.Analyzer{&common.FilesAnalyzer},
+	ResultType: reflect.TypeOf(Metadata(nil)),
+}
+
+// reField matches a line of a header that starts a field, capturing its name and value.
+var reField = regexp.MustCompile(`^([A-Z][\w()]*): (.+)$`)
+
+// extractMetadata is the function that gets passed to MetadataAnalyzer which builds the
+// Metadata of a package.
+func extractMetadata(pass *analysis.Pass) (interface{}, error) {
+	metadata := make(Metadata, len(pass.Files))
+	for _, file := range pass.Files {
+		// Files without a field in their header are left without walking their comments.
+		if !common.HasMarker(pass, file, common.MarkerHeader) {
+			metadata[file] = make(Fields)
+			continue
+		}
+
+		metadata[file] = headerFields(pass.Fset, file)
+	}
+
+	return metadata, nil
+}
+
+// headerFields returns the fields in the comments before the package keyword of the given
+// file, other than its package comment. A field's value extends to each following line up
+// to a blank line or the next field, and only the first occurrence of each field counts.
+func headerFields(fset *token.FileSet, file *ast.File) Fields {
+	fields := make(Fields)
+	packageKeywordLine := fset.PositionFor(file.Package, false).Line
+
+	for _, commentGroup := range file.Comments {
+		if fset.PositionFor(commentGroup.Pos(), false).Line >= packageKeywordLine {
+			break
+		}
+		if commentGroup == file.Doc {
+			continue
+		}
+
+		// field is the name of the field the current line extends, if any.
+		var field string
+		for _, comment := range commentGroup.List {
+			for _, line := range commentLines(comment) {
+				if match := reField.FindStringSubmatch(line); match != nil {
+					field = ""
+					if _, exists := fields[match[1]]; !exists {
+						field = match[1]
+						fields[field] = match[2]
+					}
+					continue
+				}
+
+				if line == "" {
+					field = ""
+				} else if field != "" {
+					fields[field] += " " + line
+				}
+			}
+		}
+	}
+
+	return fields
+}
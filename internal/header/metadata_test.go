@@ -0,0 +1,91 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package header
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestHeaderFields(t *testing.T) {
+	tt := []struct {
+		name     string
+		src      string
+		expected Fields
+	}{
+		{
+			name: "Extracts fields",
+			src: `// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: Does things.
+// Gotchas: None.
+
+// Package foo does things.
+package foo
+`,
+			expected: Fields{"Description": "Does things.", "Gotchas": "None."},
+		},
+		{
+			name: "Joins values that extend to the following lines",
+			src: `// Description: Does things
+// over several lines.
+//
+// Not part of the description.
+
+package foo
+`,
+			expected: Fields{"Description": "Does things over several lines."},
+		},
+		{
+			name: "Extracts fields from block comments",
+			src: `/*
+ * Description: Does things.
+ */
+
+package foo
+`,
+			expected: Fields{"Description": "Does things."},
+		},
+		{
+			name: "Ignores the package comment and directives",
+			src: `//go:build linux
+
+// Package foo does things.
+// Example: Not a field.
+package foo
+`,
+			expected: Fields{},
+		},
+		{
+			name: "Keeps the first occurrence of a field",
+			src: `// Description: First.
+
+// Description: Second.
+
+package foo
+`,
+			expected: Fields{"Description": "First."},
+		},
+		{
+			name: "Ignores comments after the package keyword",
+			src: `package foo
+
+// Description: Not a header.
+`,
+			expected: Fields{},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", test.src, parser.ParseComments)
+			assert.NilError(t, err)
+
+			assert.DeepEqual(t, headerFields(fset, file), test.expected)
+		})
+	}
+}
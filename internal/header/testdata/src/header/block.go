@@ -0,0 +1,5 @@
+/*
+ * Description: This file has the required header in a block comment.
+ */
+
+package header
@@ -0,0 +1,4 @@
+// Description: This file has the required header.
+
+// Package header has files with and without the required header.
+package header
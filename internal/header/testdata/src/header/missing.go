@@ -0,0 +1 @@
+package header // want `does not contain the required header key "Description"`
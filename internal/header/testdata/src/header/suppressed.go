@@ -0,0 +1 @@
+package header //nolint:header // Why: suppressed issues aren't reported.
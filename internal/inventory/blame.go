@@ -0,0 +1,180 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements annotating the suppression inventory with who last
+// changed each suppression and when, according to git blame.
+
+package inventory
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"github.com/pkg/errors"
+)
+
+// blameLine is the author and date of a single line according to git blame.
+type blameLine struct {
+	author, email, date string
+}
+
+// Blame annotates every suppression of the inventory with the author and date of its line
+// according to git blame, see Suppression.Author, and counts the suppressions of each
+// author in ByAuthor. Nolint directives are blamed in the files they are in, and the
+// entries of the suppressions file in the file at the given path, which is skipped when
+// empty. Files that aren't tracked by git, and lines that aren't committed yet, are left
+// without an author.
+func (s *Suppressions) Blame(suppressionsFile string) error {
+	blames := make(map[string]map[int]blameLine)
+	blame := func(path string, line int) (blameLine, error) {
+		lines, ok := blames[path]
+		if !ok {
+			var err error
+			if lines, err = blameFile(path); err != nil {
+				return blameLine{}, err
+			}
+			blames[path] = lines
+		}
+
+		return lines[line], nil
+	}
+
+	var entryLines []int
+	if suppressionsFile != "" {
+		f, err := os.Open(suppressionsFile)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "open suppressions file")
+		}
+		if err == nil {
+			defer f.Close()
+
+			if entryLines, err = config.DecodeSuppressionLines(f); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.ByAuthor = make(map[string]int)
+
+	var entry int
+	for i := range s.Suppressions {
+		sup := &s.Suppressions[i]
+
+		path, line := filepath.FromSlash(sup.File), sup.Line
+		if sup.Source == SourceSuppressionsFile {
+			// The entries of the suppressions file are listed in the order of the file.
+			if entry >= len(entryLines) {
+				return errors.New("suppressions file doesn't match the entries of the inventory")
+			}
+			path, line = suppressionsFile, entryLines[entry]
+			entry++
+		}
+
+		b, err := blame(path, line)
+		if err != nil {
+			return errors.Wrapf(err, "blame %s", path)
+		}
+
+		sup.Author, sup.AuthorEmail, sup.Date = b.author, b.email, b.date
+		s.ByAuthor[b.email]++
+	}
+
+	return nil
+}
+
+// blameFile returns the author and date of every committed line of the file at the given
+// path, keyed by line, or nil if the file isn't tracked by git.
+func blameFile(path string) (map[int]blameLine, error) {
+	dir, name := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+
+	out, err := git(dir, "blame", "--line-porcelain", "--", name)
+	if err != nil {
+		// Files that aren't tracked have no history to blame.
+		if _, lsErr := git(dir, "ls-files", "--error-unmatch", "--", name); lsErr != nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return parseBlame(out)
+}
+
+// parseBlame parses the output of git blame --line-porcelain into the author and date of
+// every committed line, keyed by line.
+func parseBlame(out []byte) (map[int]blameLine, error) {
+	lines := make(map[int]blameLine)
+
+	var line int
+	var committed bool
+	var current blameLine
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		// The content of each line ends the headers describing it.
+		if strings.HasPrefix(text, "\t") {
+			if committed {
+				lines[line] = current
+			}
+			line, current = 0, blameLine{}
+			continue
+		}
+
+		key, value, _ := strings.Cut(text, " ")
+		switch {
+		case line == 0:
+			// Each line starts with a header of the form "<commit> <original line> <line>
+			// [<lines in group>]". Lines that aren't committed yet have a commit of zeros.
+			fields := strings.Fields(value)
+			if len(fields) < 2 {
+				return nil, errors.Errorf("malformed blame header %q", text)
+			}
+
+			var err error
+			if line, err = strconv.Atoi(fields[1]); err != nil {
+				return nil, errors.Wrapf(err, "malformed blame header %q", text)
+			}
+			committed = strings.Trim(key, "0") != ""
+		case key == "author":
+			current.author = value
+		case key == "author-mail":
+			current.email = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+		case key == "author-time":
+			seconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "malformed author time %q", value)
+			}
+			current.date = time.Unix(seconds, 0).UTC().Format(time.DateOnly)
+		}
+	}
+
+	return lines, errors.Wrap(scanner.Err(), "read blame")
+}
+
+// git runs git with the given arguments in the given directory and returns its output.
+func git(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, errors.Wrap(err, message)
+		}
+		return nil, err
+	}
+
+	return out, nil
+}
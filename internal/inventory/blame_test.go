@@ -0,0 +1,113 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package inventory
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"gotest.tools/v3/assert"
+)
+
+func TestParseBlame(t *testing.T) {
+	lines, err := parseBlame([]byte(`1f2e3d4c5b6a79881f2e3d4c5b6a79881f2e3d4c 1 1 2
+author Jane Doe
+author-mail <jane@example.com>
+author-time 1767225600
+author-tz +0000
+summary Add foo
+filename foo.go
+	package foo
+1f2e3d4c5b6a79881f2e3d4c5b6a79881f2e3d4c 3 2
+author Jane Doe
+author-mail <jane@example.com>
+author-time 1767225600
+author-tz +0000
+summary Add foo
+filename foo.go
+	
+0000000000000000000000000000000000000000 3 3 1
+author Not Committed Yet
+author-mail <not.committed.yet>
+author-time 1767312000
+author-tz +0000
+summary Version of foo.go from foo.go
+filename foo.go
+	const a = 1 //nolint:magicnumber // Why: new
+`))
+	assert.NilError(t, err)
+
+	// The lines are compared as arrays since their fields are unexported.
+	got := make(map[int][3]string, len(lines))
+	for line, b := range lines {
+		got[line] = [3]string{b.author, b.email, b.date}
+	}
+	assert.DeepEqual(t, got, map[int][3]string{
+		1: {"Jane Doe", "jane@example.com", "2026-01-01"},
+		2: {"Jane Doe", "jane@example.com", "2026-01-01"},
+	})
+}
+
+func TestBlame(t *testing.T) {
+	dir := t.TempDir()
+
+	git := func(args ...string) {
+		t.Helper()
+
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=Jane Doe", "GIT_AUTHOR_EMAIL=jane@example.com",
+			"GIT_AUTHOR_DATE=2026-01-01T12:00:00Z", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		assert.NilError(t, err, string(out))
+	}
+
+	write := func(name, content string) {
+		t.Helper()
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	git("init", "-q")
+	write("foo.go", "package foo\n\nconst a = 1 //nolint:magicnumber // Why: committed\n")
+	write(config.DefaultSuppressionsFile, "suppressions:\n  - file: foo.go\n    rule: doculint\n"+
+		"    owner: \"@team\"\n    reason: Committed.\n")
+	git("add", ".")
+	git("commit", "-q", "-m", "base")
+
+	write("foo.go", "package foo\n\nconst a = 1 //nolint:magicnumber // Why: committed\n\n"+
+		"const b = 2 //nolint:magicnumber // Why: uncommitted\n")
+	write("bar.go", "package foo\n\nconst c = 3 //nolint:magicnumber // Why: untracked\n")
+
+	wd, err := os.Getwd()
+	assert.NilError(t, err)
+	assert.NilError(t, os.Chdir(dir))
+	t.Cleanup(func() { assert.NilError(t, os.Chdir(wd)) })
+
+	entries := []config.Suppression{{File: "foo.go", Rule: "doculint", Owner: "@team", Reason: "Committed."}}
+	inv, err := CollectSuppressions([]string{"."}, entries, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, inv.Blame(filepath.Join(dir, config.DefaultSuppressionsFile)))
+
+	var got []string
+	for i := range inv.Suppressions {
+		s := &inv.Suppressions[i]
+		got = append(got, strings.Join([]string{s.File, s.Author, s.AuthorEmail, s.Date}, " | "))
+	}
+	assert.DeepEqual(t, got, []string{
+		"bar.go |  |  | ",
+		"foo.go | Jane Doe | jane@example.com | 2026-01-01",
+		"foo.go |  |  | ",
+		"foo.go | Jane Doe | jane@example.com | 2026-01-01",
+	})
+	assert.DeepEqual(t, inv.ByAuthor, map[string]int{"": 2, "jane@example.com": 2})
+
+	var w bytes.Buffer
+	assert.NilError(t, inv.Write(&w, config.ReportFormatText))
+	assert.Assert(t, strings.Contains(w.String(), "AUTHOR            SUPPRESSIONS\n"+
+		"(not committed)   2\njane@example.com  2\n"), w.String())
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements parsing of CODEOWNERS files, used to look up the owners
+// of suppressed lines.
+
+package inventory
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/pkg/errors"
+)
+
+// codeOwnersPaths are the paths a CODEOWNERS file is looked for at, relative to the root
+// of the repository, in the order GitHub looks for it.
+var codeOwnersPaths = []string{
+	filepath.Join(".github", "CODEOWNERS"),
+	"CODEOWNERS",
+	filepath.Join("docs", "CODEOWNERS"),
+}
+
+// codeOwnersRule is a single line of a CODEOWNERS file.
+type codeOwnersRule struct {
+	pattern string
+	owners  []string
+}
+
+// CodeOwners are the rules of a CODEOWNERS file, in the order they appear in it.
+type CodeOwners []codeOwnersRule
+
+// LoadCodeOwners reads the CODEOWNERS file of the repository rooted at the given
+// directory. Repositories without one have no owners.
+func LoadCodeOwners(root string) (CodeOwners, error) {
+	for _, path := range codeOwnersPaths {
+		f, err := os.Open(filepath.Join(root, path))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrap(err, "open CODEOWNERS")
+		}
+		defer f.Close()
+
+		co, err := ParseCodeOwners(f)
+		return co, errors.Wrapf(err, "read %s", path)
+	}
+
+	return nil, nil
+}
+
+// ParseCodeOwners parses the rules of the CODEOWNERS file read from r.
+func ParseCodeOwners(r io.Reader) (CodeOwners, error) {
+	var co CodeOwners
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i != -1 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		co = append(co, codeOwnersRule{pattern: fields[0], owners: fields[1:]})
+	}
+
+	return co, scanner.Err()
+}
+
+// Owners returns the owners of the file at the given slash-separated path, relative to
+// the root of the repository. As in GitHub, the last rule matching the file wins, which
+// may have no owners at all.
+func (co CodeOwners) Owners(file string) []string {
+	for i := len(co) - 1; i >= 0; i-- {
+		if matchCodeOwners(co[i].pattern, file) {
+			return co[i].owners
+		}
+	}
+
+	return nil
+}
+
+// matchCodeOwners reports whether or not the given CODEOWNERS pattern matches the given
+// file. Patterns follow the gitignore rules CODEOWNERS files use: patterns starting with a
+// slash, or containing one, are relative to the root, other patterns match at any depth,
+// and patterns matching a directory match everything beneath it.
+func matchCodeOwners(pattern, file string) bool {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.Trim(pattern, "/")
+	if pattern == "" {
+		return false
+	}
+
+	if !strings.Contains(pattern, "/") && !strings.HasPrefix(pattern, "**") {
+		pattern = "**/" + pattern
+	}
+
+	if dirOnly {
+		// The file itself can't be the directory, so only match beneath it.
+		return common.MatchGlob(pattern+"/*/**", file)
+	}
+
+	return common.MatchGlob(pattern+"/**", file)
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the suppression inventory, which lists every nolint
+// directive and suppression of a repository for suppression budgets and audits.
+
+// Package inventory implements the inventories printed by the inventory subcommand, which
+// describe the state of a repository rather than report issues in it.
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/config"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+)
+
+// Sources of the suppressions in an inventory.
+const (
+	// SourceNoLint denotes a nolint directive in a Go file.
+	SourceNoLint = "nolint"
+
+	// SourceSuppressionsFile denotes an entry of the suppressions file, see
+	// config.Suppression.
+	SourceSuppressionsFile = "suppressionsFile"
+)
+
+// Suppression is a single nolint directive or entry of the suppressions file.
+type Suppression struct {
+	// Source is either SourceNoLint or SourceSuppressionsFile.
+	Source string `json:"source"`
+
+	// File is the slash-separated path of the file the directive is in, relative to the
+	// working directory, or the path glob of a suppressions file entry.
+	File string `json:"file"`
+
+	// Line is the line the directive is on, or the line a suppressions file entry applies
+	// to, zero for every line.
+	Line int `json:"line"`
+
+	// Linters are the linters, or rules of linters, that are suppressed. It is empty for
+	// naked nolint directives, which suppress every linter that honors them.
+	Linters []string `json:"linters"`
+
+	// Reason is the reason given for the suppression, empty if there is none.
+	Reason string `json:"reason"`
+
+	// Owners are the owners of the file the directive is in according to CODEOWNERS, or
+	// the owner of a suppressions file entry.
+	Owners []string `json:"owners"`
+
+	// ApprovedBy and ApprovedOn are who approved a suppressions file entry and when, see
+	// config.Suppression. They are empty for nolint directives.
+	ApprovedBy string `json:"approvedBy,omitempty"`
+	ApprovedOn string `json:"approvedOn,omitempty"`
+
+	// Author, AuthorEmail, and Date are who last changed the line the directive is on, or
+	// the suppressions file entry starts on, and the date they did so, according to git
+	// blame. They are empty unless the inventory was annotated by Blame, and for lines that
+	// aren't committed yet.
+	Author      string `json:"author,omitempty"`
+	AuthorEmail string `json:"authorEmail,omitempty"`
+	Date        string `json:"date,omitempty"`
+}
+
+// Suppressions is the inventory of every suppression of a repository.
+type Suppressions struct {
+	// Total is the number of suppressions.
+	Total int `json:"total"`
+
+	// ByLinter maps each linter, or rule of a linter, to the number of suppressions of it.
+	// Naked nolint directives are counted under "*".
+	ByLinter map[string]int `json:"byLinter"`
+
+	// ByOwner maps each owner to the number of suppressions they own. Suppressions without
+	// an owner are counted under "".
+	ByOwner map[string]int `json:"byOwner"`
+
+	// MissingReason is the number of suppressions without a reason.
+	MissingReason int `json:"missingReason"`
+
+	// ByAuthor maps the email of each author to the number of suppressions whose line they
+	// last changed, see Suppression.Author. Suppressions that aren't committed yet are
+	// counted under "". It is nil unless the inventory was annotated by Blame.
+	ByAuthor map[string]int `json:"byAuthor,omitempty"`
+
+	// Suppressions contains every suppression, nolint directives sorted by position
+	// followed by the entries of the suppressions file in the order they were given.
+	Suppressions []Suppression `json:"suppressions"`
+}
+
+// CollectSuppressions walks the given directories, relative to the working directory,
+// for the nolint directives of every Go file in them and returns the inventory of them and
+// of the given suppressions file entries. Hidden, vendor, and testdata directories are
+// skipped, as are ignored paths, see common.IsIgnoredPath. Owners are looked up in the
+// given CODEOWNERS.
+func CollectSuppressions(dirs []string, entries []config.Suppression, co CodeOwners) (*Suppressions, error) {
+	var suppressions []Suppression
+
+	fset := token.NewFileSet()
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() {
+				name := d.Name()
+				if path != dir && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if filepath.Ext(path) != ".go" || common.IsIgnoredPath(path) {
+				return nil
+			}
+
+			found, err := fileSuppressions(fset, path, co)
+			if err != nil {
+				return err
+			}
+			suppressions = append(suppressions, found...)
+
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "walk %s", dir)
+		}
+	}
+
+	sort.SliceStable(suppressions, func(i, j int) bool {
+		if suppressions[i].File != suppressions[j].File {
+			return suppressions[i].File < suppressions[j].File
+		}
+		return suppressions[i].Line < suppressions[j].Line
+	})
+
+	for i := range entries {
+		suppressions = append(suppressions, Suppression{
+			Source:     SourceSuppressionsFile,
+			File:       entries[i].File,
+			Line:       entries[i].Line,
+			Linters:    []string{entries[i].Rule},
+			Reason:     entries[i].Reason,
+			Owners:     []string{entries[i].Owner},
+			ApprovedBy: entries[i].ApprovedBy,
+			ApprovedOn: entries[i].ApprovedOn,
+		})
+	}
+
+	inv := Suppressions{
+		Total:        len(suppressions),
+		ByLinter:     make(map[string]int),
+		ByOwner:      make(map[string]int),
+		Suppressions: suppressions,
+	}
+	if inv.Suppressions == nil {
+		inv.Suppressions = []Suppression{}
+	}
+
+	for i := range suppressions {
+		s := &suppressions[i]
+
+		if len(s.Linters) == 0 {
+			inv.ByLinter["*"]++
+		}
+		for _, linter := range s.Linters {
+			inv.ByLinter[linter]++
+		}
+
+		if len(s.Owners) == 0 {
+			inv.ByOwner[""]++
+		}
+		for _, owner := range s.Owners {
+			inv.ByOwner[owner]++
+		}
+
+		if s.Reason == "" {
+			inv.MissingReason++
+		}
+	}
+
+	return &inv, nil
+}
+
+// fileSuppressions returns the nolint directives of the Go file at the given path.
+func fileSuppressions(fset *token.FileSet, path string, co CodeOwners) ([]Suppression, error) {
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse file")
+	}
+
+	filename := filepath.ToSlash(filepath.Clean(path))
+	owners := co.Owners(filename)
+
+	var suppressions []Suppression
+	for _, commentGroup := range file.Comments {
+		for _, comment := range commentGroup.List {
+			// The end of a range is listed as the directive starting it.
+			directive, ok := reporter.ParseDirective(comment.Text)
+			if !ok || directive.End {
+				continue
+			}
+
+			suppressions = append(suppressions, Suppression{
+				Source:  SourceNoLint,
+				File:    filename,
+				Line:    fset.PositionFor(comment.Pos(), false).Line,
+				Linters: directive.Linters,
+				Reason:  directive.Reason,
+				Owners:  owners,
+			})
+		}
+	}
+
+	return suppressions, nil
+}
+
+// Write writes the inventory to w in the given format, one of config.ReportFormatJSON or
+// config.ReportFormatText. The text format lists the author and date of each suppression,
+// followed by the number of suppressions of each author, when the inventory was annotated
+// by Blame.
+func (s *Suppressions) Write(w io.Writer, format string) error {
+	switch format {
+	case config.ReportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return errors.Wrap(encoder.Encode(s), "encode suppression inventory")
+	case config.ReportFormatText:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+		blamed := s.ByAuthor != nil
+
+		if blamed {
+			fmt.Fprintln(tw, "SOURCE\tFILE\tLINE\tLINTERS\tOWNERS\tAUTHOR\tDATE\tREASON")
+		} else {
+			fmt.Fprintln(tw, "SOURCE\tFILE\tLINE\tLINTERS\tOWNERS\tREASON")
+		}
+		for i := range s.Suppressions {
+			sup := &s.Suppressions[i]
+
+			linters := strings.Join(sup.Linters, ",")
+			if len(sup.Linters) == 0 {
+				linters = "*"
+			}
+
+			if blamed {
+				fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\n", sup.Source, sup.File, sup.Line, linters,
+					strings.Join(sup.Owners, ","), sup.AuthorEmail, sup.Date, sup.Reason)
+			} else {
+				fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\n",
+					sup.Source, sup.File, sup.Line, linters, strings.Join(sup.Owners, ","), sup.Reason)
+			}
+		}
+		fmt.Fprintf(tw, "\n%d suppressions, %d without a reason\n", s.Total, s.MissingReason)
+
+		if blamed {
+			authors := make([]string, 0, len(s.ByAuthor))
+			for author := range s.ByAuthor {
+				authors = append(authors, author)
+			}
+
+			// Authors with the most suppressions come first.
+			sort.Slice(authors, func(i, j int) bool {
+				if s.ByAuthor[authors[i]] != s.ByAuthor[authors[j]] {
+					return s.ByAuthor[authors[i]] > s.ByAuthor[authors[j]]
+				}
+				return authors[i] < authors[j]
+			})
+
+			fmt.Fprintln(tw, "\nAUTHOR\tSUPPRESSIONS")
+			for _, author := range authors {
+				name := author
+				if name == "" {
+					name = "(not committed)"
+				}
+				fmt.Fprintf(tw, "%s\t%d\n", name, s.ByAuthor[author])
+			}
+		}
+
+		return tw.Flush()
+	default:
+		return fmt.Errorf("format %q is not one of %q or %q", format, config.ReportFormatJSON, config.ReportFormatText)
+	}
+}
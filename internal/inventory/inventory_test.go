@@ -0,0 +1,105 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package inventory
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"gotest.tools/v3/assert"
+)
+
+func TestCodeOwners(t *testing.T) {
+	co, err := ParseCodeOwners(strings.NewReader(`# Default owners.
+*               @org/platform
+*.proto         @org/api
+/internal/gen/  @org/codegen
+docs/           @org/docs
+internal/legacy
+`))
+	assert.NilError(t, err)
+
+	tt := []struct {
+		name     string
+		file     string
+		expected []string
+	}{
+		{name: "Matches the catch-all rule", file: "cmd/main.go", expected: []string{"@org/platform"}},
+		{name: "Matches extensions at any depth", file: "api/v1/foo.proto", expected: []string{"@org/api"}},
+		{name: "Matches anchored directories", file: "internal/gen/api/api.go", expected: []string{"@org/codegen"}},
+		{name: "Matches directories beneath the root", file: "docs/rules/why.md", expected: []string{"@org/docs"}},
+		{name: "Matches rules without owners", file: "internal/legacy/sort.go", expected: []string{}},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, append([]string{}, co.Owners(test.file)...), test.expected)
+		})
+	}
+}
+
+func TestCollectSuppressions(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"foo/foo.go": `package foo
+
+//nolint:doculint // Why: Generated elsewhere.
+func Foo() {}
+
+func Bar() {} //nolint
+`,
+		"foo/testdata/bar.go": "package bar //nolint:todo",
+		"vendor/baz/baz.go":   "package baz //nolint:todo",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NilError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	wd, err := os.Getwd()
+	assert.NilError(t, err)
+	assert.NilError(t, os.Chdir(dir))
+	t.Cleanup(func() { assert.NilError(t, os.Chdir(wd)) })
+
+	co := CodeOwners{{pattern: "/foo/", owners: []string{"@org/foo"}}}
+	entries := []config.Suppression{
+		{File: "gen/**", Rule: "doculint/spelling", Owner: "@org/gen", Reason: "Vendor spec."},
+	}
+
+	inv, err := CollectSuppressions([]string{"."}, entries, co)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, inv, &Suppressions{
+		Total:         3,
+		ByLinter:      map[string]int{"doculint": 1, "*": 1, "doculint/spelling": 1},
+		ByOwner:       map[string]int{"@org/foo": 2, "@org/gen": 1},
+		MissingReason: 1,
+		Suppressions: []Suppression{
+			{
+				Source:  SourceNoLint,
+				File:    "foo/foo.go",
+				Line:    3,
+				Linters: []string{"doculint"},
+				Reason:  "Generated elsewhere.",
+				Owners:  []string{"@org/foo"},
+			},
+			{
+				Source: SourceNoLint,
+				File:   "foo/foo.go",
+				Line:   6,
+				Owners: []string{"@org/foo"},
+			},
+			{
+				Source:  SourceSuppressionsFile,
+				File:    "gen/**",
+				Linters: []string{"doculint/spelling"},
+				Reason:  "Vendor spec.",
+				Owners:  []string{"@org/gen"},
+			},
+		},
+	})
+}
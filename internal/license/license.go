@@ -0,0 +1,255 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package license contains the necessary logic for the license linter. The license linter
+// compares the LICENSE file of the module each analyzed package belongs to with the
+// copyright required by config and with the headers actually present in each file,
+// reporting mismatches such as an Apache LICENSE file alongside "All Rights Reserved"
+// headers.
+//
+// Like the gomod linter, the LICENSE file is added to the file set of each pass so that
+// issues with the required copyright are reported at it, once per run.
+package license
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the license linter.
+const name = "license"
+
+// doc defines the help text for the license linter.
+const doc = `Compares the LICENSE file of the module with the copyright required via flags and
+with the license wording in the headers of each .go file. "All Rights Reserved" conflicts
+with an open source LICENSE file, and SPDX-License-Identifier lines must name the license
+in the LICENSE file.`
+
+// Proprietary is the license reported for LICENSE files that reserve all rights rather
+// than granting an open source license.
+const Proprietary = "proprietary"
+
+// licenseFiles are the names of the files, in order of preference, that contain the
+// license of a module.
+var licenseFiles = []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING"}
+
+// licenses are the open source licenses that can be detected from a LICENSE file, by SPDX
+// identifier and phrases found in their text. Licenses whose text contains the phrases of
+// another must come before it, e.g. the LGPL quotes the GPL.
+var licenses = []struct {
+	id      string
+	phrases []string
+}{
+	{"Apache-2.0", []string{"apache license", "version 2.0"}},
+	{"MIT", []string{"permission is hereby granted, free of charge"}},
+	{"BSD-3-Clause", []string{"redistribution and use in source and binary forms", "neither the name"}},
+	{"BSD-2-Clause", []string{"redistribution and use in source and binary forms"}},
+	{"MPL-2.0", []string{"mozilla public license", "2.0"}},
+	{"AGPL-3.0", []string{"gnu affero general public license", "version 3"}},
+	{"LGPL-3.0", []string{"gnu lesser general public license", "version 3"}},
+	{"GPL-3.0", []string{"gnu general public license", "version 3"}},
+	{"GPL-2.0", []string{"gnu general public license", "version 2"}},
+}
+
+// reSPDX matches an SPDX license identifier line, capturing the identifier.
+var reSPDX = regexp.MustCompile(`SPDX-License-Identifier:\s*([A-Za-z0-9.+-]+)`)
+
+// Analyzer exports the license analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      license,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_copyrightText, _copyrightPattern string) *analysis.Analyzer {
+	copyrightText = strings.TrimSpace(_copyrightText)
+	copyrightPattern = strings.TrimSpace(_copyrightPattern)
+	return &Analyzer
+}
+
+// Variable block to keep track of flags whose values are collected at runtime. See the
+// init function that immediately proceeds this block to see more.
+var (
+	// copyrightText is a variable that gets collected via flags. This variable contains the
+	// copyright string required by the copyright linter.
+	copyrightText string
+
+	// copyrightPattern is a variable that gets collected via flags. This variable contains
+	// the copyright regular expression required by the copyright linter.
+	copyrightPattern string
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.StringVar(&copyrightText, "copyrightText", "", "the copyright string required by the copyright linter")
+	Analyzer.Flags.StringVar(&copyrightPattern, "copyrightPattern", "", "the copyright regular expression required by the copyright linter")
+}
+
+// license is the function that gets passed to the Analyzer which runs the actual analysis
+// for the license linter on a set of files.
+func license(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) || len(_pass.Files) == 0 {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	goMod := common.FindGoMod(filepath.Dir(pass.Fset.PositionFor(pass.Files[0].Package, false).Filename))
+	if goMod == "" {
+		return nil, nil
+	}
+
+	path, content, err := readLicense(filepath.Dir(goMod))
+	if err != nil {
+		return nil, err
+	}
+
+	id := Detect(content)
+	if path != "" && id == "" {
+		// The license couldn't be recognized, there's nothing to compare against.
+		return nil, nil
+	}
+
+	// The copyright required by config is reported at the LICENSE file, or go.mod if there
+	// is none, since it applies to the whole module.
+	required := copyrightText
+	if copyrightPattern != "" {
+		// Drop the escapes of the pattern so that its wording can be compared.
+		required = strings.ReplaceAll(copyrightPattern, `\`, "")
+	}
+	if problem := Mismatch(id, path != "", required); problem != "" {
+		at := path
+		if at == "" {
+			at = goMod
+			if content, err = os.ReadFile(goMod); err != nil {
+				return nil, errors.Wrap(err, "read go.mod")
+			}
+		}
+
+		tf := pass.Fset.AddFile(at, -1, len(content))
+		tf.SetLinesForContent(content)
+		pass.Reportf(tf.Pos(0), "the copyright required by config %s", problem)
+	}
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		for _, group := range file.Comments {
+			if group.Pos() >= file.Package {
+				// Only the header, before the package keyword, is checked.
+				break
+			}
+
+			for _, comment := range group.List {
+				if problem := Mismatch(id, path != "", comment.Text); problem != "" {
+					pass.Reportf(comment.Slash, "header %s", problem)
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// readLicense returns the path and content of the license file in the given directory, or
+// an empty path if there is none.
+func readLicense(dir string) (string, []byte, error) {
+	for _, name := range licenseFiles {
+		path := filepath.Join(dir, name)
+
+		content, err := os.ReadFile(path)
+		if err == nil {
+			return path, content, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", nil, errors.Wrapf(err, "read \"%s\"", path)
+		}
+	}
+
+	return "", nil, nil
+}
+
+// Detect returns the SPDX identifier of the open source license in the given LICENSE file
+// content, Proprietary if it reserves all rights instead, or an empty string if the license
+// isn't recognized.
+func Detect(content []byte) string {
+	text := strings.Join(strings.Fields(strings.ToLower(string(content))), " ")
+	if text == "" {
+		return ""
+	}
+
+	for _, l := range licenses {
+		matches := true
+		for _, phrase := range l.phrases {
+			if !strings.Contains(text, phrase) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return l.id
+		}
+	}
+
+	if strings.Contains(text, "all rights reserved") {
+		return Proprietary
+	}
+
+	return ""
+}
+
+// Mismatch returns a description of how the given copyright or header text contradicts the
+// given license, as returned by Detect, or an empty string if it doesn't. hasFile denotes
+// whether or not the module has a LICENSE file at all.
+func Mismatch(id string, hasFile bool, text string) string {
+	var spdx string
+	if match := reSPDX.FindStringSubmatch(text); match != nil {
+		spdx = match[1]
+	}
+
+	switch {
+	case !hasFile:
+		if spdx != "" {
+			return fmt.Sprintf("declares license %s but the module has no LICENSE file", spdx)
+		}
+	case id == Proprietary:
+		if spdx != "" {
+			return fmt.Sprintf("declares license %s but the LICENSE file reserves all rights", spdx)
+		}
+	default:
+		if strings.Contains(strings.ToLower(text), "all rights reserved") {
+			return fmt.Sprintf("reserves all rights but the LICENSE file is %s", id)
+		}
+		if spdx != "" && !sameLicense(spdx, id) {
+			return fmt.Sprintf("declares license %s but the LICENSE file is %s", spdx, id)
+		}
+	}
+
+	return ""
+}
+
+// sameLicense reports whether or not the given SPDX identifier names the given detected
+// license, ignoring the "-only" and "-or-later" suffixes of the GNU licenses.
+func sameLicense(spdx, id string) bool {
+	spdx = strings.TrimSuffix(strings.TrimSuffix(spdx, "-only"), "-or-later")
+	return strings.EqualFold(spdx, id)
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package license
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestDetect(t *testing.T) {
+	tt := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "Detects Apache 2.0",
+			content:  "                                 Apache License\n                           Version 2.0, January 2004",
+			expected: "Apache-2.0",
+		},
+		{
+			name:     "Detects MIT",
+			content:  "MIT License\n\nPermission is hereby granted, free of charge, to any person obtaining a copy",
+			expected: "MIT",
+		},
+		{
+			name:     "Detects the LGPL rather than the GPL it quotes",
+			content:  "GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007\n... the GNU General Public License ...",
+			expected: "LGPL-3.0",
+		},
+		{
+			name:     "Detects proprietary licenses",
+			content:  "Copyright 2026 Outreach Corporation. All Rights Reserved.",
+			expected: Proprietary,
+		},
+		{
+			name:    "Does not recognize other licenses",
+			content: "Do whatever you want.",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, Detect([]byte(test.content)), test.expected)
+		})
+	}
+}
+
+func TestMismatch(t *testing.T) {
+	tt := []struct {
+		name     string
+		id       string
+		hasFile  bool
+		text     string
+		expected string
+	}{
+		{
+			name:     "Rejects all rights reserved with an open source license",
+			id:       "Apache-2.0",
+			hasFile:  true,
+			text:     "// Copyright 2026 Outreach Corporation. All Rights Reserved.",
+			expected: "reserves all rights but the LICENSE file is Apache-2.0",
+		},
+		{
+			name:     "Rejects a different SPDX identifier",
+			id:       "Apache-2.0",
+			hasFile:  true,
+			text:     "// SPDX-License-Identifier: MIT",
+			expected: "declares license MIT but the LICENSE file is Apache-2.0",
+		},
+		{
+			name:    "Accepts the same SPDX identifier",
+			id:      "GPL-3.0",
+			hasFile: true,
+			text:    "// SPDX-License-Identifier: GPL-3.0-or-later",
+		},
+		{
+			name:    "Accepts all rights reserved with a proprietary license",
+			id:      Proprietary,
+			hasFile: true,
+			text:    "// Copyright 2026 Outreach Corporation. All Rights Reserved.",
+		},
+		{
+			name:     "Rejects an SPDX identifier with a proprietary license",
+			id:       Proprietary,
+			hasFile:  true,
+			text:     "// SPDX-License-Identifier: Apache-2.0",
+			expected: "declares license Apache-2.0 but the LICENSE file reserves all rights",
+		},
+		{
+			name:     "Rejects an SPDX identifier without a LICENSE file",
+			text:     "# SPDX-License-Identifier: MIT",
+			expected: "declares license MIT but the module has no LICENSE file",
+		},
+		{
+			name: "Accepts all rights reserved without a LICENSE file",
+			text: "// Copyright 2026 Outreach Corporation. All Rights Reserved.",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, Mismatch(test.id, test.hasFile, test.text), test.expected)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements requesting links to find dead ones, caching the
+// results across runs in a file.
+
+package linklint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CacheTTL is how long the result of requesting a link is cached for before the link is
+// requested again.
+const CacheTTL = 24 * time.Hour
+
+// requestTimeout is how long a link has to respond before it's considered dead.
+const requestTimeout = 10 * time.Second
+
+// result is the cached result of requesting a link.
+type result struct {
+	// Status is the status code the link responded with, or zero if it didn't respond.
+	Status int `json:"status"`
+
+	// Error is the reason the link didn't respond, if it didn't.
+	Error string `json:"error,omitempty"`
+
+	// Checked is when the link was requested.
+	Checked time.Time `json:"checked"`
+}
+
+// dead returns the reason the link with this result is dead, if it is. Links behind
+// authentication or rate limits respond with errors even though they exist, so only a
+// missing page or a failing server counts as dead.
+func (r *result) dead() (string, bool) {
+	switch {
+	case r.Error != "":
+		return r.Error, true
+	case r.Status == http.StatusNotFound, r.Status == http.StatusGone, r.Status >= http.StatusInternalServerError:
+		return fmt.Sprintf("%d %s", r.Status, http.StatusText(r.Status)), true
+	default:
+		return "", false
+	}
+}
+
+// checker requests links and caches the results, both for the rest of the process and,
+// when given a file, across runs.
+type checker struct {
+	// mu protects the fields below, but isn't held while a link is requested so that
+	// packages can be linted concurrently.
+	mu sync.Mutex
+
+	// client is the client links are requested with.
+	client *http.Client
+
+	// now returns the current time, which is replaced in tests.
+	now func() time.Time
+
+	// results are the results of requesting links by link.
+	results map[string]result
+
+	// loaded denotes whether or not the results were loaded from the cache file.
+	loaded bool
+
+	// dirty denotes whether or not results were added since the cache file was last
+	// written.
+	dirty bool
+}
+
+// links is the process-wide checker, so that each link is only requested once however
+// many packages link to it.
+var links = checker{
+	client: &http.Client{Timeout: requestTimeout},
+	now:    time.Now,
+}
+
+// reset forgets the results of the links requested so far, so that they are loaded from
+// the cache file again.
+func (c *checker) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results, c.loaded, c.dirty = nil, false, false
+}
+
+// dead returns the reason the given link is dead, if it is, requesting it unless a result
+// younger than CacheTTL is cached. Results are loaded from the given cache file, if any,
+// the first time around.
+func (c *checker) dead(link, file string) (string, bool) {
+	c.mu.Lock()
+	c.load(file)
+	r, ok := c.results[link]
+	c.mu.Unlock()
+
+	if !ok || c.now().Sub(r.Checked) >= CacheTTL {
+		r = c.request(link)
+
+		c.mu.Lock()
+		c.results[link] = r
+		c.dirty = true
+		c.mu.Unlock()
+	}
+
+	return r.dead()
+}
+
+// load loads the results cached in the given file, if any and if they weren't loaded
+// already. A missing or malformed cache file is treated as empty, as the links are simply
+// requested again. The caller must hold c.mu.
+func (c *checker) load(file string) {
+	if c.loaded {
+		return
+	}
+	c.loaded = true
+	c.results = make(map[string]result)
+
+	if file == "" {
+		return
+	}
+
+	if data, err := os.ReadFile(file); err == nil {
+		if err := json.Unmarshal(data, &c.results); err != nil {
+			c.results = make(map[string]result)
+		}
+	}
+}
+
+// request requests the given link, with a HEAD request unless the server doesn't support
+// those, and returns the result.
+func (c *checker) request(link string) result {
+	r := result{Checked: c.now()}
+
+	status, err := c.do(http.MethodHead, link)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		status, err = c.do(http.MethodGet, link)
+	}
+
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	r.Status = status
+
+	return r
+}
+
+// do sends a request with the given method to the given link and returns the status code
+// of the response.
+func (c *checker) do(method, link string) (int, error) {
+	req, err := http.NewRequest(method, link, http.NoBody)
+	if err != nil {
+		return 0, errors.Wrap(err, "create request")
+	}
+	req.Header.Set("User-Agent", "lintroller-linklint")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "request link")
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
+
+// save writes the results to the given cache file if any were added since it was last
+// written. Nothing is written without a cache file.
+func (c *checker) save(file string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if file == "" || !c.dirty {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(c.results, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal link cache")
+	}
+
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		return errors.Wrapf(err, "write link cache \"%s\"", file)
+	}
+	c.dirty = false
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package linklint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckerDead(t *testing.T) {
+	requests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+
+		switch r.URL.Path {
+		case "/alive":
+		case "/head-not-allowed":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		case "/private":
+			w.WriteHeader(http.StatusForbidden)
+		case "/broken":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newChecker := func() *checker {
+		return &checker{client: server.Client(), now: func() time.Time { return now }}
+	}
+	cacheFile := filepath.Join(t.TempDir(), "links.json")
+
+	c := newChecker()
+	tt := []struct {
+		path   string
+		reason string
+	}{
+		{path: "/alive"},
+		{path: "/head-not-allowed"},
+		{path: "/private"},
+		{path: "/broken", reason: "502 Bad Gateway"},
+		{path: "/missing", reason: "404 Not Found"},
+	}
+	for _, test := range tt {
+		reason, dead := c.dead(server.URL+test.path, cacheFile)
+		assert.Equal(t, dead, test.reason != "", test.path)
+		assert.Equal(t, reason, test.reason, test.path)
+	}
+	assert.Equal(t, requests["/head-not-allowed"], 2)
+	assert.NilError(t, c.save(cacheFile))
+
+	// Results are cached for the rest of the process, and in the cache file for later runs.
+	c.dead(server.URL+"/missing", cacheFile)
+	newChecker().dead(server.URL+"/missing", cacheFile)
+	assert.Equal(t, requests["/missing"], 1)
+
+	// Results are requested again once they expire.
+	now = now.Add(CacheTTL)
+	reason, dead := newChecker().dead(server.URL+"/missing", cacheFile)
+	assert.Assert(t, dead)
+	assert.Equal(t, reason, "404 Not Found")
+	assert.Equal(t, requests["/missing"], 2)
+}
+
+func TestCheckerDeadUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	c := &checker{client: &http.Client{Timeout: time.Second}, now: time.Now}
+	reason, dead := c.dead(url, "")
+	assert.Assert(t, dead)
+	assert.Assert(t, reason != "")
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the linklint analyzer and the offline checks it runs
+// over the links in comments.
+
+// Package linklint contains the necessary logic for the linklint linter. The linklint
+// linter ensures that the http(s) links in comments, e.g. to runbooks in file headers,
+// don't point to deprecated domains and, when checking links online, aren't dead, since a
+// stale link is worse than no link at all.
+package linklint
+
+import (
+	"go/ast"
+	"go/token"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the linklint linter.
+const name = "linklint"
+
+// doc defines the help text for the linklint linter.
+const doc = `Ensures that the http(s) links in comments don't point to any of the
+-deprecatedDomains, e.g. a retired wiki, or any of their subdomains. With -online, each
+link is also requested and reported when it is dead, caching the results in -cacheFile so
+that links are only requested again once their result is a day old.`
+
+// IDs of the rules linklint reports issues under. Each issue carries the ID of its rule as
+// the category of its diagnostic.
+const (
+	// RuleDeprecatedDomain is the rule that links don't point to a deprecated domain.
+	RuleDeprecatedDomain = "deprecated-domain"
+
+	// RuleDeadLink is the rule that links resolve, when checking links online.
+	RuleDeadLink = "dead-link"
+)
+
+// Rules contains the ID of every rule linklint reports issues under.
+var Rules = []string{
+	RuleDeprecatedDomain,
+	RuleDeadLink,
+}
+
+// Analyzer exports the linklint analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      linklint,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(deprecatedDomains []string, _online bool, _cacheFile string) *analysis.Analyzer {
+	rawDeprecatedDomains = strings.Join(deprecatedDomains, ",")
+	online = _online
+	cacheFile = _cacheFile
+	links.reset()
+
+	return &Analyzer
+}
+
+// Variable block to keep track of flags whose values are collected at runtime. See the
+// init function that immediately proceeds this block to see more.
+var (
+	// rawDeprecatedDomains is a variable that gets collected via flags. This variable
+	// contains a comma-separated list of the domains links can't point to.
+	rawDeprecatedDomains string
+
+	// online is a variable that gets collected via flags. This variable contains a flag
+	// that denotes whether or not each link is requested to report dead links.
+	online bool
+
+	// cacheFile is a variable that gets collected via flags. This variable contains the
+	// path of the file the results of requesting links are cached in, if any.
+	cacheFile string
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.StringVar(&rawDeprecatedDomains, "deprecatedDomains", "",
+		"comma-separated list of the domains links in comments can't point to, including their subdomains")
+	Analyzer.Flags.BoolVar(&online, "online", false,
+		"a boolean flag that denotes whether or not to request each link in comments and report dead links")
+	Analyzer.Flags.StringVar(&cacheFile, "cacheFile", "", "the path of the file to cache the results of requesting links in")
+}
+
+// linklint is the function that gets passed to the Analyzer which runs the actual
+// analysis for the linklint linter on a set of files.
+func linklint(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	domains := strings.Split(rawDeprecatedDomains, ",")
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		for _, l := range commentLinks(file) {
+			if domain, ok := deprecatedDomain(l.url, domains); ok {
+				reporter.ReportRule(pass, RuleDeprecatedDomain, l.pos,
+					"link \"%s\" points to the deprecated domain %s, link to where its content lives now instead", l.url, domain)
+				continue
+			}
+
+			if online {
+				if reason, dead := links.dead(l.url, cacheFile); dead {
+					reporter.ReportRule(pass, RuleDeadLink, l.pos, "link \"%s\" is dead (%s), update or remove it", l.url, reason)
+				}
+			}
+		}
+	}
+
+	if online {
+		if err := links.save(cacheFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// link is a link found within a comment.
+type link struct {
+	// url is the link itself.
+	url string
+
+	// pos is the position of the link within its comment.
+	pos token.Pos
+}
+
+// reLink matches the http(s) links in the text of a comment, including any punctuation
+// that trails them, see trimLink.
+var reLink = regexp.MustCompile(`https?://[^\s<>"'` + "`" + `]+`)
+
+// commentLinks returns the links within the comments of the given file, in order.
+func commentLinks(file *ast.File) []link {
+	var links []link
+	for _, commentGroup := range file.Comments {
+		for _, comment := range commentGroup.List {
+			for _, match := range reLink.FindAllStringIndex(comment.Text, -1) {
+				if u := trimLink(comment.Text[match[0]:match[1]]); u != "" {
+					links = append(links, link{url: u, pos: comment.Slash + token.Pos(match[0])})
+				}
+			}
+		}
+	}
+
+	return links
+}
+
+// trimLink returns the given link without the punctuation that trails it in prose, e.g.
+// the period ending a sentence or a closing parenthesis without an opening one within the
+// link, or an empty string if it isn't a valid link once trimmed.
+func trimLink(s string) string {
+	for s != "" {
+		last := s[len(s)-1]
+		switch {
+		case strings.IndexByte(".,;:!?'\"*", last) >= 0:
+		case last == ')' && strings.Count(s, "(") < strings.Count(s, ")"):
+		case last == ']' && strings.Count(s, "[") < strings.Count(s, "]"):
+		default:
+			if u, err := url.Parse(s); err != nil || u.Host == "" {
+				return ""
+			}
+			return s
+		}
+		s = s[:len(s)-1]
+	}
+
+	return ""
+}
+
+// deprecatedDomain returns the domain of the given domains the given link points to, which
+// is the case if its host is the domain or one of its subdomains.
+func deprecatedDomain(link string, domains []string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", false
+	}
+	host := strings.ToLower(u.Hostname())
+
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain == "" {
+			continue
+		}
+
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return domain, true
+		}
+	}
+
+	return "", false
+}
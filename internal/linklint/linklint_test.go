@@ -0,0 +1,102 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package linklint
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/docs"
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	a := NewAnalyzerWithOptions([]string{"wiki.example.com"}, false, "")
+	t.Cleanup(func() { NewAnalyzerWithOptions(nil, false, "") })
+
+	linttest.Run(t, a, "linklint")
+}
+
+func TestCommentLinks(t *testing.T) {
+	src := `package foo
+
+// See https://example.com/a, and (https://example.com/b).
+/* Also <https://example.com/c?d=e>. */
+// Parens stay: https://en.wikipedia.org/wiki/Go_(programming_language).
+// Not a link: https://.
+var foo = "https://example.com/not-a-comment"
+`
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+	assert.NilError(t, err)
+
+	var urls []string
+	for _, l := range commentLinks(file) {
+		urls = append(urls, l.url)
+
+		// Links are reported where they are, rather than at the start of their comment.
+		offset := fset.Position(l.pos).Offset
+		assert.Equal(t, src[offset:offset+len(l.url)], l.url)
+	}
+
+	assert.DeepEqual(t, urls, []string{
+		"https://example.com/a",
+		"https://example.com/b",
+		"https://example.com/c?d=e",
+		"https://en.wikipedia.org/wiki/Go_(programming_language)",
+	})
+}
+
+func TestDeprecatedDomain(t *testing.T) {
+	tt := []struct {
+		name     string
+		link     string
+		expected string
+	}{
+		{
+			name:     "Matches the domain",
+			link:     "https://wiki.example.com/page",
+			expected: "wiki.example.com",
+		},
+		{
+			name:     "Matches subdomains",
+			link:     "http://eu.wiki.example.com:8080/page",
+			expected: "wiki.example.com",
+		},
+		{
+			name:     "Ignores case",
+			link:     "https://Wiki.Example.com",
+			expected: "wiki.example.com",
+		},
+		{
+			name: "Ignores other domains with the same suffix",
+			link: "https://notwiki.example.com/page",
+		},
+		{
+			name: "Ignores other domains",
+			link: "https://docs.example.com/page",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			domain, ok := deprecatedDomain(test.link, []string{"", " wiki.example.com"})
+			assert.Equal(t, ok, test.expected != "")
+			assert.Equal(t, domain, test.expected)
+		})
+	}
+}
+
+func TestRulesDocumented(t *testing.T) {
+	page, ok := docs.Lookup(Analyzer.Name)
+	assert.Assert(t, ok)
+
+	documented := make([]string, 0, len(page.Rules))
+	for _, r := range page.Rules {
+		documented = append(documented, r.ID)
+	}
+	assert.DeepEqual(t, documented, Rules)
+}
@@ -0,0 +1,15 @@
+// Package linklint links to documentation, some of it on a retired wiki. See
+// https://docs.example.com/runbooks/linklint.
+package linklint
+
+// Runbook: https://wiki.example.com/display/OPS/Runbook // want `link ".*/Runbook" points to the deprecated domain wiki.example.com`
+
+// legacy is documented at https://WIKI.example.com/x (see also https://example.com/y). // want `link ".*/x" points to the deprecated domain wiki.example.com`
+var legacy = 1
+
+//nolint-start:linklint // Why: The page is archived on purpose.
+
+// suppressed links to https://wiki.example.com/archived.
+var suppressed = 2
+
+//nolint-end
@@ -0,0 +1,4 @@
+package linklint_test
+
+// Tests aren't linted: https://wiki.example.com/tests.
+var tested = 1
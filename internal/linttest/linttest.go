@@ -0,0 +1,135 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the helpers shared by the tests of the linters.
+
+// Package linttest implements helpers for testing linters: Recorder, which stands in for
+// reporter.Pass when testing the checks of a linter on their own, TypeCheck, which gives
+// those checks the type information of a source, and Run, which runs a linter end to end
+// over packages in testdata the way the drivers do.
+package linttest
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/getoutreach/lintroller/pkg/linttest"
+	"golang.org/x/tools/go/analysis"
+)
+
+// IgnoredFile is the name of the files Run treats as being in an ignored path. Issues in
+// them are never reported, so they can't have "// want" comments.
+const IgnoredFile = linttest.IgnoredFile
+
+// Recorder records the messages of the issues reported to it. It implements
+// reporter.Reporter, so it can be given to the checks of a linter in place of the
+// reporter.Pass they are given when the linter runs.
+type Recorder struct {
+	// Messages are the messages of the issues reported so far, in order.
+	Messages []string
+
+	// Related are the messages of the related information of the issues reported so far,
+	// in order, see reporter.ReportRelated.
+	Related []string
+}
+
+// Reportf implements the reporter.Reporter interface.
+func (r *Recorder) Reportf(_ token.Pos, format string, args ...interface{}) {
+	r.Messages = append(r.Messages, fmt.Sprintf(format, args...))
+}
+
+// Report records the message of the given diagnostic along with the messages of its
+// related information, like the Report method of reporter.Pass reports them.
+func (r *Recorder) Report(d analysis.Diagnostic) {
+	r.Messages = append(r.Messages, d.Message)
+	for _, related := range d.Related {
+		r.Related = append(r.Related, related.Message)
+	}
+}
+
+// Run runs the given analyzer over the packages matching the given patterns within the
+// testdata/src directory of the calling test's package, and checks the issues it reports
+// against the "// want" comments of their files, see linttest.RunDir of the public
+// pkg/linttest package, which lintroller's own linters are tested with as well. Tests
+// calling Run can't run in parallel.
+func Run(t *testing.T, a *analysis.Analyzer, patterns ...string) {
+	t.Helper()
+
+	linttest.Run(t, a, patterns...)
+}
+
+// TypeCheck parses the given source as the only file of the package with the given import
+// path and type-checks it, failing t if either fails, so that the checks of a linter that
+// need type information can be tested on their own. The packages in stubs, keyed by import
+// path, are type-checked from the given source when imported, and every other package from
+// the source of the standard library.
+func TypeCheck(t *testing.T, path, src string, stubs map[string]string) (*ast.File, *types.Info) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse source: %v", err)
+	}
+
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+
+	imp := &stubImporter{
+		fset:     fset,
+		stubs:    stubs,
+		packages: make(map[string]*types.Package),
+		fallback: importer.ForCompiler(fset, "source", nil),
+	}
+	if _, err := (&types.Config{Importer: imp}).Check(path, fset, []*ast.File{file}, info); err != nil {
+		t.Fatalf("type-check source: %v", err)
+	}
+
+	return file, info
+}
+
+// stubImporter type-checks the packages it has the source of on demand, see TypeCheck.
+type stubImporter struct {
+	fset *token.FileSet
+
+	// stubs maps the import paths of the stubbed packages to their source.
+	stubs map[string]string
+
+	// packages contains the stubbed packages type-checked so far, by import path.
+	packages map[string]*types.Package
+
+	// fallback imports the packages that aren't stubbed.
+	fallback types.Importer
+}
+
+// Import implements the types.Importer interface.
+func (i *stubImporter) Import(path string) (*types.Package, error) {
+	src, ok := i.stubs[path]
+	if !ok {
+		return i.fallback.Import(path)
+	}
+
+	if pkg, ok := i.packages[path]; ok {
+		return pkg, nil
+	}
+
+	file, err := parser.ParseFile(i.fset, path+".go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := (&types.Config{Importer: i}).Check(path, i.fset, []*ast.File{file}, nil)
+	if err != nil {
+		return nil, err
+	}
+	i.packages[path] = pkg
+
+	return pkg, nil
+}
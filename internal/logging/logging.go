@@ -0,0 +1,156 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package logging contains the necessary logic for the logging linter. The logging linter
+// ensures that service code logs through gobox's structured logger rather than the
+// standard library's log package or by printing to stderr, which bypass the structured
+// log pipeline.
+package logging
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the logging linter.
+const name = "logging"
+
+// doc defines the help text for the logging linter.
+const doc = `Ensures that service code logs through github.com/getoutreach/gobox/pkg/log: the
+standard library's log package can not be imported, and fmt.Fprint, fmt.Fprintf, and
+fmt.Fprintln can not write to os.Stderr. Packages whose name or import path matches one
+of the -allowedPackages, e.g. "main" or "**/tools/**", are exempt.`
+
+// goboxLog is the import path of the logger that should be used instead.
+const goboxLog = "github.com/getoutreach/gobox/pkg/log"
+
+// Analyzer exports the logging analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      logging,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(allowedPackages []string) *analysis.Analyzer {
+	rawAllowedPackages = strings.Join(allowedPackages, ",")
+	return &Analyzer
+}
+
+// rawAllowedPackages is a variable that gets collected via flags. This variable contains
+// a comma-separated list of the package names and import path globs, see common.MatchGlob,
+// of the packages that may use other logging stacks.
+var rawAllowedPackages string
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.StringVar(&rawAllowedPackages, "allowedPackages", "",
+		"comma-separated list of package names and import path globs of packages that may use other logging stacks")
+}
+
+// fprintFuncs are the functions of the fmt package that write to the io.Writer given as
+// their first argument.
+var fprintFuncs = map[string]bool{
+	"Fprint":   true,
+	"Fprintf":  true,
+	"Fprintln": true,
+}
+
+// logging is the function that gets passed to the Analyzer which runs the actual
+// analysis for the logging linter on a set of files.
+func logging(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages and allowed packages.
+	if common.SkipPackage(_pass) || allowed(_pass.Pkg, strings.Split(rawAllowedPackages, ",")) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, pass.TypesInfo, file)
+	}
+
+	return nil, nil
+}
+
+// allowed reports whether or not the given package may use other logging stacks, which is
+// the case if its name or import path matches any of the given patterns.
+func allowed(pkg *types.Package, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if pattern == pkg.Name() || common.MatchGlob(pattern, pkg.Path()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkFile reports every import of the log package and every call printing to os.Stderr
+// with the fmt package within the given file.
+func checkFile(r reporter.Reporter, info *types.Info, file *ast.File) {
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err == nil && path == "log" {
+			r.Reportf(spec.Pos(), "package log is not structured, use %s instead", goboxLog)
+		}
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		fn, ok := info.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" || !fprintFuncs[fn.Name()] {
+			return true
+		}
+
+		if isStderr(info, call.Args[0]) {
+			r.Reportf(call.Pos(), "fmt.%s to os.Stderr bypasses structured logging, use %s instead", fn.Name(), goboxLog)
+		}
+
+		return true
+	})
+}
+
+// isStderr reports whether or not the given expression is os.Stderr.
+func isStderr(info *types.Info, expr ast.Expr) bool {
+	var ident *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return false
+	}
+
+	v, ok := info.Uses[ident].(*types.Var)
+	return ok && v.Pkg() != nil && v.Pkg().Path() == "os" && v.Name() == "Stderr"
+}
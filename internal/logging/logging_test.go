@@ -0,0 +1,96 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package logging
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "logging")
+}
+
+func TestCheckFile(t *testing.T) {
+	tt := []struct {
+		name     string
+		imports  string
+		body     string
+		expected []string
+	}{
+		{
+			name:     "Reports imports of the log package",
+			imports:  `"log"`,
+			body:     `log.Println("started")`,
+			expected: []string{"package log is not structured, use github.com/getoutreach/gobox/pkg/log instead"},
+		},
+		{
+			name:    "Reports printing to stderr",
+			imports: `"fmt"; "os"`,
+			body: `fmt.Fprintln(os.Stderr, "started")
+	stderr := os.Stderr
+	fmt.Fprintf(stderr, "started")`,
+			expected: []string{"fmt.Fprintln to os.Stderr bypasses structured logging, use github.com/getoutreach/gobox/pkg/log instead"},
+		},
+		{
+			name:     "Allows printing to other writers",
+			imports:  `"fmt"; "os"`,
+			body:     `fmt.Fprintln(os.Stdout, "started")`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := "package p\n\nimport (" + test.imports + ")\n\nfunc f() {\n\t" + test.body + "\n}\n"
+
+			file, info := linttest.TypeCheck(t, "p", src, nil)
+
+			var r linttest.Recorder
+			checkFile(&r, info, file)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	tt := []struct {
+		name     string
+		path     string
+		pkgName  string
+		patterns []string
+		expected bool
+	}{
+		{
+			name:     "Allows packages by name",
+			path:     "github.com/getoutreach/foo/cmd/foo",
+			pkgName:  "main",
+			patterns: []string{"", "main"},
+			expected: true,
+		},
+		{
+			name:     "Allows packages by import path glob",
+			path:     "github.com/getoutreach/foo/tools/gen",
+			pkgName:  "gen",
+			patterns: []string{"**/tools/**"},
+			expected: true,
+		},
+		{
+			name:     "Checks other packages",
+			path:     "github.com/getoutreach/foo/internal/accounts",
+			pkgName:  "accounts",
+			patterns: []string{"main", "**/tools/**"},
+			expected: false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, allowed(types.NewPackage(test.path, test.pkgName), test.patterns), test.expected)
+		})
+	}
+}
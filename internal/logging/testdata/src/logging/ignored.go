@@ -0,0 +1,10 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+)
+
+func reportIgnored() {
+	fmt.Fprintln(os.Stderr, "started")
+}
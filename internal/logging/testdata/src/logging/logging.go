@@ -0,0 +1,12 @@
+// Package logging prints to stderr.
+package logging
+
+import (
+	"fmt"
+	"os"
+)
+
+func report() {
+	fmt.Fprintln(os.Stderr, "started") // want `fmt.Fprintln to os.Stderr bypasses structured logging`
+	fmt.Fprintln(os.Stderr, "stopped") //nolint:logging // Why: suppressed issues aren't reported.
+}
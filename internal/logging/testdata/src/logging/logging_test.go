@@ -0,0 +1,10 @@
+package logging_test
+
+import (
+	"fmt"
+	"os"
+)
+
+func reportTest() {
+	fmt.Fprintln(os.Stderr, "started")
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package magicnumber contains the necessary logic for the magicnumber linter. The
+// magicnumber linter ensures that numeric literals are explained by a named constant
+// rather than appearing unexplained throughout the code.
+package magicnumber
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"net/http"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the magicnumber linter.
+const name = "magicnumber"
+
+// doc defines the help text for the magicnumber linter.
+const doc = `Ensures that numeric literals outside of constant declarations are extracted to a
+named constant that explains their meaning. The values 0, 1, and -1, the lengths of array
+types, and the -allowedValues are always allowed.
+
+When -allowPowersOfTwo is set, powers of two, e.g. 1024, are allowed. When
+-allowHTTPStatusCodes is set, HTTP status codes, e.g. 404, are allowed.`
+
+// DefaultAllowedValues are the values that are always allowed since their meaning is
+// obvious from the code around them.
+var DefaultAllowedValues = []string{"0", "1", "-1"}
+
+// Analyzer exports the magicnumber analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      magicnumber,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(allowedValues []string, _allowPowersOfTwo, _allowHTTPStatusCodes bool) *analysis.Analyzer {
+	rawAllowedValues = strings.Join(allowedValues, ",")
+	allowPowersOfTwo = _allowPowersOfTwo
+	allowHTTPStatusCodes = _allowHTTPStatusCodes
+	return &Analyzer
+}
+
+// Variables that get collected via flags.
+var (
+	// rawAllowedValues is a comma-separated list of the values, in addition to
+	// DefaultAllowedValues, that are allowed.
+	rawAllowedValues string
+
+	// allowPowersOfTwo denotes whether or not powers of two are allowed.
+	allowPowersOfTwo bool
+
+	// allowHTTPStatusCodes denotes whether or not HTTP status codes are allowed.
+	allowHTTPStatusCodes bool
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.StringVar(&rawAllowedValues, "allowedValues", "", "comma-separated list of numbers that are allowed")
+	Analyzer.Flags.BoolVar(&allowPowersOfTwo, "allowPowersOfTwo", false,
+		"a boolean flag that denotes whether or not powers of two are allowed")
+	Analyzer.Flags.BoolVar(&allowHTTPStatusCodes, "allowHTTPStatusCodes", false,
+		"a boolean flag that denotes whether or not HTTP status codes are allowed")
+}
+
+// allowance denotes which numbers are allowed.
+type allowance struct {
+	values          []constant.Value
+	powersOfTwo     bool
+	httpStatusCodes bool
+}
+
+// magicnumber is the function that gets passed to the Analyzer which runs the actual
+// analysis for the magicnumber linter on a set of files.
+func magicnumber(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	a := allowance{
+		powersOfTwo:     allowPowersOfTwo,
+		httpStatusCodes: allowHTTPStatusCodes,
+	}
+	for _, raw := range append(strings.Split(rawAllowedValues, ","), DefaultAllowedValues...) {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+
+		value, err := ParseValue(raw)
+		if err != nil {
+			return nil, err
+		}
+		a.values = append(a.values, value)
+	}
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, file, &a)
+	}
+
+	return nil, nil
+}
+
+// ParseValue parses the given number, e.g. "60", "-1", "0x10", or "0.5", as it would be
+// written in Go source.
+func ParseValue(raw string) (constant.Value, error) {
+	raw = strings.TrimSpace(raw)
+	negative := strings.HasPrefix(raw, "-")
+	lit := strings.TrimPrefix(raw, "-")
+
+	value := constant.MakeFromLiteral(lit, token.INT, 0)
+	if value.Kind() == constant.Unknown {
+		value = constant.MakeFromLiteral(lit, token.FLOAT, 0)
+	}
+	if value.Kind() == constant.Unknown {
+		return nil, fmt.Errorf("\"%s\" is not a number", raw)
+	}
+
+	if negative {
+		value = constant.UnaryOp(token.SUB, value, 0)
+	}
+	return value, nil
+}
+
+// checkFile reports every numeric literal within the given file that is neither part of a
+// constant declaration, the length of an array type, nor allowed.
+func checkFile(r reporter.Reporter, file *ast.File, a *allowance) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.GenDecl:
+			// Literals given to constants are already named.
+			return node.Tok != token.CONST
+		case *ast.ArrayType:
+			// The length of an array type, e.g. [16]byte, is part of the type.
+			if node.Len != nil {
+				ast.Inspect(node.Elt, func(n ast.Node) bool {
+					checkNode(r, n, a)
+					return true
+				})
+				return false
+			}
+		case *ast.UnaryExpr:
+			// Negative literals are checked as a whole, e.g. -1 rather than 1.
+			if lit, ok := node.X.(*ast.BasicLit); ok && node.Op == token.SUB && isNumber(lit) {
+				check(r, node, "-"+lit.Value, a)
+				return false
+			}
+		}
+
+		checkNode(r, n, a)
+		return true
+	})
+}
+
+// checkNode reports the given node if it is a numeric literal that isn't allowed.
+func checkNode(r reporter.Reporter, n ast.Node, a *allowance) {
+	if lit, ok := n.(*ast.BasicLit); ok && isNumber(lit) {
+		check(r, lit, lit.Value, a)
+	}
+}
+
+// check reports the given node, a number written as the given literal, if it isn't allowed.
+func check(r reporter.Reporter, n ast.Node, lit string, a *allowance) {
+	value, err := ParseValue(lit)
+	if err != nil || a.allowed(value) {
+		return
+	}
+
+	r.Reportf(n.Pos(), "magic number %s, extract it to a named constant that explains its meaning", lit)
+}
+
+// isNumber reports whether or not the given literal is an integer or floating-point number.
+func isNumber(lit *ast.BasicLit) bool {
+	return lit.Kind == token.INT || lit.Kind == token.FLOAT
+}
+
+// allowed reports whether or not the given value is allowed.
+func (a *allowance) allowed(value constant.Value) bool {
+	for _, allowed := range a.values {
+		if constant.Compare(value, token.EQL, allowed) {
+			return true
+		}
+	}
+
+	// Powers of two and HTTP status codes are integers.
+	integer := constant.ToInt(value)
+	if integer.Kind() != constant.Int {
+		return false
+	}
+
+	n, exact := constant.Int64Val(integer)
+	if !exact {
+		return false
+	}
+
+	if a.powersOfTwo && n > 0 && n&(n-1) == 0 {
+		return true
+	}
+
+	return a.httpStatusCodes && http.StatusText(int(n)) != ""
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package magicnumber
+
+import (
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "magicnumber")
+}
+
+func TestCheckFile(t *testing.T) {
+	src := `package foo
+
+const timeout = 30
+
+var buf [16]byte
+
+func f(n int) (int, float64) {
+	const retries = 3
+	if n > 1 || n == -1 || n == 0 {
+		return n * 60, 0.5
+	}
+	if n == 404 || n == 1024 || n == -7 {
+		return 0x10, 1.0
+	}
+	return retries + timeout, float64(len(buf))
+}
+`
+
+	tt := []struct {
+		name      string
+		allowance allowance
+		expected  []string
+	}{
+		{
+			name: "Reports unexplained numbers",
+			expected: []string{
+				"magic number 60, extract it to a named constant that explains its meaning",
+				"magic number 0.5, extract it to a named constant that explains its meaning",
+				"magic number 404, extract it to a named constant that explains its meaning",
+				"magic number 1024, extract it to a named constant that explains its meaning",
+				"magic number -7, extract it to a named constant that explains its meaning",
+				"magic number 0x10, extract it to a named constant that explains its meaning",
+			},
+		},
+		{
+			name: "Allows configured values, powers of two, and HTTP status codes",
+			allowance: allowance{
+				values:          []constant.Value{constant.MakeInt64(60), constant.MakeFloat64(0.5)},
+				powersOfTwo:     true,
+				httpStatusCodes: true,
+			},
+			expected: []string{
+				"magic number -7, extract it to a named constant that explains its meaning",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", src, 0)
+			assert.NilError(t, err)
+
+			for _, raw := range DefaultAllowedValues {
+				value, err := ParseValue(raw)
+				assert.NilError(t, err)
+				test.allowance.values = append(test.allowance.values, value)
+			}
+
+			var r linttest.Recorder
+			checkFile(&r, file, &test.allowance)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	value, err := ParseValue(" -0x10 ")
+	assert.NilError(t, err)
+	assert.Equal(t, value.String(), "-16")
+
+	value, err = ParseValue("2.5")
+	assert.NilError(t, err)
+	assert.Equal(t, value.String(), "2.5")
+
+	_, err = ParseValue("ten")
+	assert.ErrorContains(t, err, "\"ten\" is not a number")
+}
@@ -0,0 +1,3 @@
+package magicnumber
+
+func ignored(n int) int { return n * 60 }
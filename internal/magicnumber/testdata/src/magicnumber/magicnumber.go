@@ -0,0 +1,12 @@
+// Package magicnumber uses unexplained numbers.
+package magicnumber
+
+const retries = 3
+
+func timeout(n int) int {
+	if n > retries {
+		return n * 60 // want `magic number 60, extract it to a named constant`
+	}
+
+	return n * 3600 //nolint:magicnumber // Why: suppressed issues aren't reported.
+}
@@ -0,0 +1,3 @@
+package magicnumber_test
+
+func tested(n int) int { return n * 60 }
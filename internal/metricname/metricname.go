@@ -0,0 +1,224 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package metricname contains the necessary logic for the metricname linter. The metricname
+// linter ensures that the names given to metrics follow a naming convention and live under
+// a registered prefix, which keeps the metric namespace from sprawling.
+package metricname
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the metricname linter.
+const name = "metricname"
+
+// doc defines the help text for the metricname linter.
+const doc = `Ensures that the constant metric names given to the -functions, e.g.
+"github.com/getoutreach/gobox/pkg/metrics.NewCounter" or
+"(github.com/getoutreach/gobox/pkg/metrics.Client).Count", match the -pattern and, if
+any -prefixes are given, start with one of them. The metric name is the first argument of
+type string.`
+
+// DefaultPattern is the regular expression metric names must match when no pattern is
+// given: lowercase words, made up of letters and digits, separated by underscores.
+const DefaultPattern = `^[a-z][a-z0-9]*(_[a-z0-9]+)*$`
+
+// Analyzer exports the metricname analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      metricname,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(functions []string, _pattern string, prefixes []string) *analysis.Analyzer {
+	rawFunctions = strings.Join(functions, ",")
+	pattern = _pattern
+	rawPrefixes = strings.Join(prefixes, ",")
+	return &Analyzer
+}
+
+// Variable block to keep track of flags whose values are collected at runtime. See the
+// init function that immediately proceeds this block to see more.
+var (
+	// rawFunctions is a variable that gets collected via flags. This variable contains a
+	// comma-separated list of the full names, see types.Func.FullName, of the functions and
+	// methods that take a metric name.
+	rawFunctions string
+
+	// pattern is a variable that gets collected via flags. This variable contains the
+	// regular expression metric names must match.
+	pattern string
+
+	// rawPrefixes is a variable that gets collected via flags. This variable contains a
+	// comma-separated list of the registered prefixes one of which metric names must start
+	// with.
+	rawPrefixes string
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.StringVar(&rawFunctions, "functions", "",
+		"comma-separated list of the full names of the functions and methods that take a metric name")
+	Analyzer.Flags.StringVar(&pattern, "pattern", DefaultPattern, "the regular expression metric names must match")
+	Analyzer.Flags.StringVar(&rawPrefixes, "prefixes", "",
+		"comma-separated list of the registered prefixes one of which metric names must start with")
+}
+
+// metricname is the function that gets passed to the Analyzer which runs the actual
+// analysis for the metricname linter on a set of files.
+func metricname(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	functions := split(rawFunctions)
+	if len(functions) == 0 {
+		return nil, nil
+	}
+
+	expr := pattern
+	if strings.TrimSpace(expr) == "" {
+		expr = DefaultPattern
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile metric name pattern")
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, pass.TypesInfo, file, functions, re, split(rawPrefixes))
+	}
+
+	return nil, nil
+}
+
+// checkFile reports every constant metric name given to one of the functions within the
+// given file that doesn't match re or doesn't start with one of the prefixes, if any.
+func checkFile(r reporter.Reporter, info *types.Info, file *ast.File, functions []string, re *regexp.Regexp,
+	prefixes []string) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !takesMetricName(info, call.Fun, functions) {
+			return true
+		}
+
+		arg := nameArg(info, call)
+		if arg == nil {
+			return true
+		}
+
+		// Names that aren't constant can't be checked.
+		tv := info.Types[arg]
+		if tv.Value == nil || tv.Value.Kind() != constant.String {
+			return true
+		}
+		metric := constant.StringVal(tv.Value)
+
+		if !re.MatchString(metric) {
+			r.Reportf(arg.Pos(), "metric name \"%s\" must match the pattern %s", metric, re.String())
+		}
+
+		if len(prefixes) > 0 && !hasAnyPrefix(metric, prefixes) {
+			r.Reportf(arg.Pos(), "metric name \"%s\" must start with one of the registered prefixes: %s",
+				metric, strings.Join(prefixes, ", "))
+		}
+
+		return true
+	})
+}
+
+// takesMetricName reports whether or not the given expression refers to one of the given
+// functions, by full name. Pointer receivers are ignored, so "(example.com/m.Client).Count"
+// matches both value and pointer receivers.
+func takesMetricName(info *types.Info, fun ast.Expr, functions []string) bool {
+	var ident *ast.Ident
+	switch f := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		ident = f
+	case *ast.SelectorExpr:
+		ident = f.Sel
+	default:
+		return false
+	}
+
+	fn, ok := info.Uses[ident].(*types.Func)
+	if !ok {
+		return false
+	}
+
+	fullName := strings.Replace(fn.FullName(), "(*", "(", 1)
+	for _, function := range functions {
+		if strings.Replace(function, "(*", "(", 1) == fullName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nameArg returns the first argument of type string of the given call, which is taken to
+// be the metric name, or nil if there is none.
+func nameArg(info *types.Info, call *ast.CallExpr) ast.Expr {
+	for _, arg := range call.Args {
+		t := info.TypeOf(arg)
+		if t == nil {
+			continue
+		}
+
+		if basic, ok := t.Underlying().(*types.Basic); ok && basic.Info()&types.IsString != 0 {
+			return arg
+		}
+	}
+
+	return nil
+}
+
+// hasAnyPrefix reports whether or not the given metric name starts with any of the given
+// prefixes.
+func hasAnyPrefix(metric string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(metric, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// split returns the non-empty elements of the given comma-separated list, with surrounding
+// space removed.
+func split(list string) []string {
+	var elements []string
+	for _, element := range strings.Split(list, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+
+	return elements
+}
@@ -0,0 +1,24 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package metricname
+
+import (
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+)
+
+// functions are the functions whose metric names are checked in the fixtures.
+var functions = []string{"example.com/metrics.NewCounter", "(example.com/metrics.Client).Count"}
+
+func TestAnalyzer(t *testing.T) {
+	t.Cleanup(func() { NewAnalyzerWithOptions(nil, DefaultPattern, nil) })
+
+	linttest.Run(t, NewAnalyzerWithOptions(functions, DefaultPattern, nil), "metricname")
+}
+
+func TestAnalyzerPrefixes(t *testing.T) {
+	t.Cleanup(func() { NewAnalyzerWithOptions(nil, DefaultPattern, nil) })
+
+	linttest.Run(t, NewAnalyzerWithOptions(functions, DefaultPattern, []string{"accounts_", "billing_"}), "prefixes")
+}
@@ -0,0 +1,5 @@
+package metricname
+
+import "example.com/metrics"
+
+var skipped = metrics.NewCounter("AccountsSkipped")
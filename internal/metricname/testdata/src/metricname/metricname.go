@@ -0,0 +1,23 @@
+// Package metricname names metrics unconventionally.
+package metricname
+
+import "example.com/metrics"
+
+const prefix = "accounts"
+
+var (
+	synced = metrics.NewCounter("AccountsSynced") // want `metric name "AccountsSynced" must match the pattern`
+	failed = metrics.NewCounter("AccountsFailed") //nolint:metricname // Why: suppressed issues aren't reported.
+	total  = metrics.NewCounter("accounts_synced_total")
+
+	// Functions that aren't configured, and names that aren't constant, aren't checked.
+	gauge   = metrics.NewGauge("Synced")
+	dynamic = metrics.NewCounter(name())
+)
+
+func name() string { return "Dynamic" }
+
+func count(c *metrics.Client) {
+	c.Count("accounts.failed", 1) // want `metric name "accounts.failed" must match the pattern`
+	c.Count(prefix+"_retried", 1)
+}
@@ -0,0 +1,5 @@
+package metricname_test
+
+import "example.com/metrics"
+
+var tested = metrics.NewCounter("AccountsTested")
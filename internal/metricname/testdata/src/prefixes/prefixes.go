@@ -0,0 +1,10 @@
+// Package prefixes names metrics without a registered prefix.
+package prefixes
+
+import "example.com/metrics"
+
+var (
+	synced = metrics.NewCounter("accounts_synced_total")
+	billed = metrics.NewCounter("billing_invoices_total")
+	other  = metrics.NewCounter("synced_total") // want `metric name "synced_total" must start with one of the registered prefixes: accounts_, billing_`
+)
@@ -0,0 +1,318 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package newnolint implements the -forbid-new-nolint mode, which rejects the nolint
+// directives added since a git revision for a set of linters unless an approved entry is
+// added to the suppressions file along with each of them, see config.ForbidNewNoLint. This
+// keeps the number of suppressions from growing without anyone signing off on it.
+package newnolint
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"math"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/config"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/pkg/errors"
+)
+
+// Options configures Check.
+type Options struct {
+	// Dir is the directory git is ran in, usually the root of the module, which the globs
+	// of the suppressions file are matched relative to. Defaults to the working directory.
+	Dir string
+
+	// Base is the git revision, e.g. "origin/main", whose merge base with the working tree
+	// new directives and suppressions file entries are added since.
+	Base string
+
+	// Linters are the names of the linters new directives are rejected for.
+	Linters []string
+
+	// SuppressionsFile is the path of the suppressions file.
+	SuppressionsFile string
+
+	// Suppressions are the entries of the suppressions file in the working tree.
+	Suppressions []config.Suppression
+}
+
+// Violation is a nolint directive added since the base revision for a linter whose new
+// directives are rejected, without an approved suppressions file entry covering it.
+type Violation struct {
+	// File is the path of the file the directive is in, relative to Options.Dir.
+	File string
+
+	// Line is the line the directive is on.
+	Line int
+
+	// Linter is the linter, or rule of a linter, as listed by the directive.
+	Linter string
+}
+
+// String returns the violation in the form of a diagnostic.
+func (v *Violation) String() string {
+	return fmt.Sprintf("%s:%d: new nolint directive for %s has no approved entry in the suppressions file",
+		v.File, v.Line, v.Linter)
+}
+
+// lineRange is an inclusive range of lines added to a file.
+type lineRange struct {
+	start, end int
+}
+
+// Check returns every nolint directive in the Go files of the working tree added since
+// the merge base of the given base revision for one of the given linters, that doesn't
+// have an approved entry, see config.Suppression.IsApproved, covering its file, linter,
+// and line added to the suppressions file since then. Untracked files are new in their
+// entirety. Ignored paths are skipped, see common.IsIgnoredPath.
+func Check(opts *Options) ([]Violation, error) {
+	dir, err := filepath.Abs(opts.Dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve directory")
+	}
+
+	root, err := git(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, errors.Wrap(err, "find root of git repository")
+	}
+
+	mergeBase, err := git(dir, "merge-base", opts.Base, "HEAD")
+	if err != nil {
+		return nil, errors.Wrapf(err, "find merge base of \"%s\"", opts.Base)
+	}
+
+	diff, err := git(dir, "diff", "--no-color", "--no-ext-diff", "-U0", mergeBase, "--", "*.go")
+	if err != nil {
+		return nil, errors.Wrapf(err, "diff against \"%s\"", opts.Base)
+	}
+	added := addedLines(diff)
+
+	untracked, err := git(dir, "ls-files", "--others", "--exclude-standard", "--full-name", "--", "*.go")
+	if err != nil {
+		return nil, errors.Wrap(err, "list untracked files")
+	}
+	for _, name := range strings.Split(untracked, "\n") {
+		if name = strings.TrimSpace(name); name != "" {
+			added[name] = []lineRange{{start: 1, end: math.MaxInt}}
+		}
+	}
+
+	entries, err := addedEntries(dir, mergeBase, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	forbidden := make(map[string]bool, len(opts.Linters))
+	for _, linter := range opts.Linters {
+		forbidden[linter] = true
+	}
+
+	names := make([]string, 0, len(added))
+	for name := range added {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var violations []Violation
+	fset := token.NewFileSet()
+	for _, name := range names {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if common.IsIgnoredPath(path) {
+			continue
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		rel = filepath.ToSlash(rel)
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s", rel)
+		}
+
+		for _, commentGroup := range file.Comments {
+			for _, comment := range commentGroup.List {
+				// Naked directives aren't honored, and the end of a range suppresses nothing.
+				directive, ok := reporter.ParseDirective(comment.Text)
+				if !ok || directive.Naked || directive.End {
+					continue
+				}
+
+				line := fset.PositionFor(comment.Pos(), false).Line
+				if !contains(added[name], line) {
+					continue
+				}
+
+				for _, linter := range directive.Linters {
+					linter = strings.TrimSpace(linter)
+					linterName, _, _ := strings.Cut(linter, "/")
+					if !forbidden[linterName] || approved(entries, rel, line, linter) {
+						continue
+					}
+
+					violations = append(violations, Violation{File: rel, Line: line, Linter: linter})
+				}
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// addedEntries returns the approved entries of the suppressions file of the given options
+// that weren't in it as of the given merge base.
+func addedEntries(dir, mergeBase string, opts *Options) ([]config.Suppression, error) {
+	var base []config.Suppression
+
+	// The suppressions file may not have existed as of the merge base, in which case every
+	// entry is new.
+	path, err := filepath.Abs(opts.SuppressionsFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve suppressions file")
+	}
+	if tracked, err := git(dir, "ls-tree", "--name-only", "--full-name", mergeBase, "--", path); err == nil && tracked != "" {
+		content, err := git(dir, "show", mergeBase+":"+tracked)
+		if err != nil {
+			return nil, errors.Wrap(err, "read suppressions file as of the merge base")
+		}
+
+		if base, err = config.DecodeSuppressions(strings.NewReader(content)); err != nil {
+			return nil, errors.Wrap(err, "decode suppressions file as of the merge base")
+		}
+	}
+
+	existing := make(map[config.Suppression]int, len(base))
+	for i := range base {
+		existing[base[i]]++
+	}
+
+	var entries []config.Suppression
+	for i := range opts.Suppressions {
+		if existing[opts.Suppressions[i]] > 0 {
+			existing[opts.Suppressions[i]]--
+			continue
+		}
+
+		if opts.Suppressions[i].IsApproved() {
+			entries = append(entries, opts.Suppressions[i])
+		}
+	}
+
+	return entries, nil
+}
+
+// approved reports whether or not one of the given entries covers a directive for the
+// given linter, or rule of a linter, on the given line of the file with the given path. An
+// entry covers the line of the directive, the line after it that the directive applies to,
+// or every line.
+func approved(entries []config.Suppression, file string, line int, linter string) bool {
+	name, _, _ := strings.Cut(linter, "/")
+	for i := range entries {
+		e := &entries[i]
+
+		if e.Rule != name && e.Rule != linter {
+			continue
+		}
+
+		if e.Line != 0 && e.Line != line && e.Line != line+1 {
+			continue
+		}
+
+		if common.MatchGlob(e.File, file) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addedLines returns the lines added to each file by the given diff, generated without
+// context lines, keyed by the path of the file relative to the root of the repository.
+func addedLines(diff string) map[string][]lineRange {
+	added := make(map[string][]lineRange)
+
+	var file string
+	scanner := bufio.NewScanner(strings.NewReader(diff))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "+++ "):
+			// Deleted files have no lines that were added.
+			file = ""
+			if name := strings.TrimPrefix(line, "+++ "); strings.HasPrefix(name, "b/") {
+				file = strings.TrimPrefix(name, "b/")
+			}
+		case strings.HasPrefix(line, "@@ ") && file != "":
+			// Hunk headers are of the form "@@ -start,count +start,count @@", where the
+			// count is left out when it is one.
+			fields := strings.Fields(line)
+			if len(fields) < 3 || !strings.HasPrefix(fields[2], "+") {
+				continue
+			}
+
+			startText, countText, hasCount := strings.Cut(strings.TrimPrefix(fields[2], "+"), ",")
+			start, err := strconv.Atoi(startText)
+			if err != nil {
+				continue
+			}
+
+			count := 1
+			if hasCount {
+				if count, err = strconv.Atoi(countText); err != nil {
+					continue
+				}
+			}
+
+			if count > 0 {
+				added[file] = append(added[file], lineRange{start: start, end: start + count - 1})
+			}
+		}
+	}
+
+	return added
+}
+
+// contains reports whether or not one of the given ranges contains the given line.
+func contains(ranges []lineRange, line int) bool {
+	for _, r := range ranges {
+		if r.start <= line && line <= r.end {
+			return true
+		}
+	}
+
+	return false
+}
+
+// git runs git with the given arguments in the given directory and returns its output
+// without surrounding whitespace.
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", errors.Wrap(err, message)
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package newnolint
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"gotest.tools/v3/assert"
+)
+
+func TestAddedLines(t *testing.T) {
+	diff := `diff --git a/foo.go b/foo.go
+index 1111111..2222222 100644
+--- a/foo.go
++++ b/foo.go
+@@ -3,0 +4,2 @@ package foo
++// first
++// second
+@@ -10 +12 @@ func Foo() {
+-	return 1
++	return 2
+@@ -20,3 +22,0 @@ func Bar() {
+-	a
+-	b
+-	c
+diff --git a/gone.go b/gone.go
+deleted file mode 100644
+--- a/gone.go
++++ /dev/null
+@@ -1,2 +0,0 @@
+-package foo
+-
+`
+
+	// The ranges are compared as pairs since their fields are unexported.
+	got := make(map[string][][2]int)
+	for file, ranges := range addedLines(diff) {
+		for _, r := range ranges {
+			got[file] = append(got[file], [2]int{r.start, r.end})
+		}
+	}
+	assert.DeepEqual(t, got, map[string][][2]int{
+		"foo.go": {{4, 5}, {12, 12}},
+	})
+}
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+
+	git := func(args ...string) {
+		t.Helper()
+
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		assert.NilError(t, err, string(out))
+	}
+
+	write := func(name, content string) {
+		t.Helper()
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	git("init", "-q")
+	write("foo.go", "package foo\n\nconst a = 1 //nolint:magicnumber // Why: existing\n")
+	write(config.DefaultSuppressionsFile, "suppressions: []\n")
+	git("add", ".")
+	git("commit", "-q", "-m", "base")
+
+	write("foo.go", "package foo\n\nconst a = 1 //nolint:magicnumber // Why: existing\n\n"+
+		"const b = 2 //nolint:magicnumber // Why: approved\n\n"+
+		"const c = 3 //nolint:magicnumber,gochecknoglobals // Why: unapproved\n\n"+
+		"//nolint:doculint/spelling // Why: unapproved rule\n"+
+		"const d = 4\n")
+	write("bar.go", "package foo\n\nconst e = 5 //nolint:magicnumber // Why: untracked\n")
+
+	suppressions := []config.Suppression{
+		{
+			File: "foo.go", Line: 5, Rule: "magicnumber", Owner: "@team", Reason: "Approved.",
+			ApprovedBy: "@lead", ApprovedOn: "2026-03-02",
+		},
+		{
+			// Entries without an approval don't count.
+			File: "bar.go", Rule: "magicnumber", Owner: "@team", Reason: "Unapproved.",
+		},
+	}
+
+	violations, err := Check(&Options{
+		Dir:              dir,
+		Base:             "HEAD",
+		Linters:          []string{"magicnumber", "doculint"},
+		SuppressionsFile: filepath.Join(dir, config.DefaultSuppressionsFile),
+		Suppressions:     suppressions,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, violations, []Violation{
+		{File: "bar.go", Line: 3, Linter: "magicnumber"},
+		{File: "foo.go", Line: 7, Linter: "magicnumber"},
+		{File: "foo.go", Line: 9, Linter: "doculint/spelling"},
+	})
+
+	// Nothing is new once everything is committed.
+	write(config.DefaultSuppressionsFile, "suppressions:\n  - file: \"*.go\"\n    rule: magicnumber\n"+
+		"    owner: \"@team\"\n    reason: Old.\n    approvedBy: \"@lead\"\n    approvedOn: 2026-01-01\n")
+	git("add", ".")
+	git("commit", "-q", "-m", "approve everything")
+
+	violations, err = Check(&Options{
+		Dir:              dir,
+		Base:             "HEAD",
+		Linters:          []string{"magicnumber"},
+		SuppressionsFile: filepath.Join(dir, config.DefaultSuppressionsFile),
+		Suppressions: []config.Suppression{{
+			File: "*.go", Rule: "magicnumber", Owner: "@team", Reason: "Old.",
+			ApprovedBy: "@lead", ApprovedOn: "2026-01-01",
+		}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(violations), 0)
+
+	// Entries that were already in the suppressions file don't approve new directives.
+	write("foo.go", "package foo\n\nconst f = 6 //nolint:magicnumber // Why: new\n")
+	violations, err = Check(&Options{
+		Dir:              dir,
+		Base:             "HEAD",
+		Linters:          []string{"magicnumber"},
+		SuppressionsFile: filepath.Join(dir, config.DefaultSuppressionsFile),
+		Suppressions: []config.Suppression{{
+			File: "*.go", Rule: "magicnumber", Owner: "@team", Reason: "Old.",
+			ApprovedBy: "@lead", ApprovedOn: "2026-01-01",
+		}},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, violations, []Violation{{File: "foo.go", Line: 3, Linter: "magicnumber"}})
+}
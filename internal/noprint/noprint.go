@@ -0,0 +1,118 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package noprint contains the necessary logic for the noprint linter. The noprint linter
+// ensures that library packages don't print to stdout, which corrupts the output of the
+// command line tools that use them.
+package noprint
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the noprint linter.
+const name = "noprint"
+
+// doc defines the help text for the noprint linter.
+const doc = `Ensures that packages other than main don't print to stdout: fmt.Print, fmt.Printf,
+and fmt.Println can not be called, and fmt.Fprint, fmt.Fprintf, and fmt.Fprintln can not
+write to os.Stdout. Output should be written to an io.Writer given by the caller instead.`
+
+// Analyzer exports the noprint analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      noprint,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// printFuncs are the functions of the fmt package that print to stdout.
+var printFuncs = map[string]bool{
+	"Print":   true,
+	"Printf":  true,
+	"Println": true,
+}
+
+// fprintFuncs are the functions of the fmt package that write to the io.Writer given as
+// their first argument.
+var fprintFuncs = map[string]bool{
+	"Fprint":   true,
+	"Fprintf":  true,
+	"Fprintln": true,
+}
+
+// noprint is the function that gets passed to the Analyzer which runs the actual
+// analysis for the noprint linter on a set of files.
+func noprint(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages and the main package, which is allowed to print.
+	if common.SkipPackage(_pass) || _pass.Pkg.Name() == common.PackageMain {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, pass.TypesInfo, file)
+	}
+
+	return nil, nil
+}
+
+// checkFile reports every call within the given file that prints to stdout with the fmt
+// package.
+func checkFile(r reporter.Reporter, info *types.Info, file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		fn, ok := info.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+			return true
+		}
+
+		switch {
+		case printFuncs[fn.Name()]:
+			r.Reportf(call.Pos(), "fmt.%s prints to stdout, write to an io.Writer given by the caller instead", fn.Name())
+		case fprintFuncs[fn.Name()] && len(call.Args) > 0 && isStdout(info, call.Args[0]):
+			r.Reportf(call.Pos(), "fmt.%s to os.Stdout prints to stdout, write to an io.Writer given by the caller instead",
+				fn.Name())
+		}
+
+		return true
+	})
+}
+
+// isStdout reports whether or not the given expression is os.Stdout.
+func isStdout(info *types.Info, expr ast.Expr) bool {
+	var ident *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return false
+	}
+
+	v, ok := info.Uses[ident].(*types.Var)
+	return ok && v.Pkg() != nil && v.Pkg().Path() == "os" && v.Name() == "Stdout"
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package noprint
+
+import (
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "noprint", "noprintmain")
+}
+
+func TestCheckFile(t *testing.T) {
+	tt := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name:     "Reports printing",
+			body:     `fmt.Println("started")`,
+			expected: []string{"fmt.Println prints to stdout, write to an io.Writer given by the caller instead"},
+		},
+		{
+			name:     "Reports printing to stdout",
+			body:     `fmt.Fprintf(os.Stdout, "started")`,
+			expected: []string{"fmt.Fprintf to os.Stdout prints to stdout, write to an io.Writer given by the caller instead"},
+		},
+		{
+			name:     "Allows writing to the given writer",
+			body:     `fmt.Fprintln(w, "started")`,
+			expected: nil,
+		},
+		{
+			name:     "Allows formatting",
+			body:     `_ = fmt.Sprintf("%d", 1)`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := "package p\n\nimport (\"fmt\"; \"io\"; \"os\")\n\nvar _ = os.Stdout\n\nfunc f(w io.Writer) {\n\t" + test.body + "\n}\n"
+
+			file, info := linttest.TypeCheck(t, "p", src, nil)
+
+			var r linttest.Recorder
+			checkFile(&r, info, file)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
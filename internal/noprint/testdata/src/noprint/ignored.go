@@ -0,0 +1,7 @@
+package noprint
+
+import "fmt"
+
+func reportIgnored() {
+	fmt.Println("started")
+}
@@ -0,0 +1,9 @@
+// Package noprint prints to stdout.
+package noprint
+
+import "fmt"
+
+func report() {
+	fmt.Println("started") // want `fmt.Println prints to stdout`
+	fmt.Println("stopped") //nolint:noprint // Why: suppressed issues aren't reported.
+}
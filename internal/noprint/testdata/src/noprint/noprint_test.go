@@ -0,0 +1,7 @@
+package noprint_test
+
+import "fmt"
+
+func reportTest() {
+	fmt.Println("started")
+}
@@ -0,0 +1,8 @@
+// Command noprintmain prints to stdout, which main packages are allowed to.
+package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("started")
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package receiver contains the necessary logic for the receiver linter. The receiver
+// linter ensures that method receivers are named consistently: every method of a type
+// uses the same short receiver name, which is never this or self.
+package receiver
+
+import (
+	"go/ast"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the receiver linter.
+const name = "receiver"
+
+// doc defines the help text for the receiver linter.
+const doc = `Ensures that method receivers are named consistently: every method of a type
+must use the same receiver name, which can have at most -maxLength characters and can not
+be this or self. The name used by most methods of a type is the one the others must use.`
+
+// DefaultMaxLength is the maximum number of characters of a receiver name when no maximum
+// is given.
+const DefaultMaxLength = 3
+
+// bannedNames are the receiver names borrowed from other languages that are never allowed.
+var bannedNames = map[string]bool{
+	"this": true,
+	"self": true,
+}
+
+// Analyzer exports the receiver analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      receiver,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_maxLength int) *analysis.Analyzer {
+	maxLength = _maxLength
+	return &Analyzer
+}
+
+// maxLength is a variable that gets collected via flags. This variable contains the
+// maximum number of characters of a receiver name.
+var maxLength int
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.IntVar(&maxLength, "maxLength", DefaultMaxLength, "the maximum number of characters of a receiver name")
+}
+
+// receiver is the function that gets passed to the Analyzer which runs the actual
+// analysis for the receiver linter on a set of files.
+func receiver(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	limit := maxLength
+	if limit <= 0 {
+		limit = DefaultMaxLength
+	}
+
+	var files []*ast.File
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		files = append(files, file)
+	}
+
+	checkFiles(pass, files, limit)
+
+	return nil, nil
+}
+
+// method is a method declaration with a named receiver.
+type method struct {
+	decl     *ast.FuncDecl
+	receiver *ast.Ident
+}
+
+// checkFiles reports the receiver of every method declared within the given files of a
+// single package that is named this or self, is longer than maxLength characters, or
+// differs from the receiver name used by most methods of the same type.
+func checkFiles(r reporter.Reporter, files []*ast.File, maxLength int) {
+	// Keep the types in the order their first method was declared so that reports are
+	// deterministic.
+	var typeNames []string
+	methods := make(map[string][]method)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 || len(fn.Recv.List[0].Names) == 0 {
+				continue
+			}
+
+			recv := fn.Recv.List[0].Names[0]
+			if recv.Name == "_" {
+				continue
+			}
+
+			typeName := receiverType(fn.Recv.List[0].Type)
+			if _, ok := methods[typeName]; !ok {
+				typeNames = append(typeNames, typeName)
+			}
+			methods[typeName] = append(methods[typeName], method{decl: fn, receiver: recv})
+		}
+	}
+
+	for _, typeName := range typeNames {
+		usual := usualName(methods[typeName])
+
+		for _, m := range methods[typeName] {
+			recv := m.receiver.Name
+
+			switch {
+			case bannedNames[recv]:
+				r.Reportf(m.receiver.Pos(), "receiver %s of method %s.%s can not be named %s, use a short name "+
+					"derived from the type instead, e.g. %s", recv, typeName, m.decl.Name.Name, recv, suggestion(typeName))
+			case utf8.RuneCountInString(recv) > maxLength:
+				r.Reportf(m.receiver.Pos(), "receiver %s of method %s.%s is longer than %d characters, "+
+					"use a short name derived from the type instead, e.g. %s",
+					recv, typeName, m.decl.Name.Name, maxLength, suggestion(typeName))
+			case recv != usual:
+				r.Reportf(m.receiver.Pos(), "receiver %s of method %s.%s differs from %s, the receiver name of "+
+					"the other methods of %s", recv, typeName, m.decl.Name.Name, usual, typeName)
+			}
+		}
+	}
+}
+
+// usualName returns the receiver name used by most of the given methods, the earliest
+// declared one among those used equally often.
+func usualName(methods []method) string {
+	counts := make(map[string]int)
+	var usual string
+	for _, m := range methods {
+		counts[m.receiver.Name]++
+		if counts[m.receiver.Name] > counts[usual] {
+			usual = m.receiver.Name
+		}
+	}
+
+	return usual
+}
+
+// receiverType returns the name of the type of the given receiver, which is either a named
+// type or a pointer to one, with type parameters if it is generic.
+func receiverType(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
+
+// suggestion returns the receiver name suggested for the given type, the lowercase first
+// letter of its name.
+func suggestion(typeName string) string {
+	r, _ := utf8.DecodeRuneInString(strings.TrimLeft(typeName, "_"))
+	if r == utf8.RuneError {
+		return "r"
+	}
+
+	return string(unicode.ToLower(r))
+}
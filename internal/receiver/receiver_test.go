@@ -0,0 +1,105 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package receiver
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "receiver")
+}
+
+func TestCheckFiles(t *testing.T) {
+	tt := []struct {
+		name     string
+		srcs     []string
+		expected []string
+	}{
+		{
+			name: "Allows consistent short names",
+			srcs: []string{`package foo
+
+type Client struct{}
+
+func (c *Client) Get()  {}
+func (c Client) List()  {}
+func (*Client) Close()  {}
+func (_ *Client) Reset() {}
+
+type List[T any] []T
+
+func (l List[T]) Len() int { return len(l) }
+`},
+			expected: nil,
+		},
+		{
+			name: "Reports this and self",
+			srcs: []string{`package foo
+
+type Client struct{}
+
+func (this *Client) Get() {}
+func (self *Client) List() {}
+`},
+			expected: []string{
+				"receiver this of method Client.Get can not be named this, use a short name derived from the type instead, e.g. c",
+				"receiver self of method Client.List can not be named self, use a short name derived from the type instead, e.g. c",
+			},
+		},
+		{
+			name: "Reports long names",
+			srcs: []string{`package foo
+
+type Client struct{}
+
+func (client *Client) Get() {}
+`},
+			expected: []string{
+				"receiver client of method Client.Get is longer than 3 characters, use a short name derived from " +
+					"the type instead, e.g. c",
+			},
+		},
+		{
+			name: "Reports names differing from the most common one across files",
+			srcs: []string{`package foo
+
+type Store struct{}
+
+func (s *Store) Get() {}
+func (st *Store) Put() {}
+`, `package foo
+
+func (s *Store) Delete() {}
+`},
+			expected: []string{
+				"receiver st of method Store.Put differs from s, the receiver name of the other methods of Store",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+
+			var files []*ast.File
+			for i, src := range test.srcs {
+				file, err := parser.ParseFile(fset, fmt.Sprintf("foo%d.go", i), src, 0)
+				assert.NilError(t, err)
+				files = append(files, file)
+			}
+
+			var r linttest.Recorder
+			checkFiles(&r, files, DefaultMaxLength)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
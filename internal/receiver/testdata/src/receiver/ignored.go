@@ -0,0 +1,3 @@
+package receiver
+
+func (client *Client) Reset() {}
@@ -0,0 +1,13 @@
+// Package receiver names receivers inconsistently.
+package receiver
+
+// Client has methods with receivers named differently.
+type Client struct{}
+
+func (c *Client) Get() {}
+
+func (c *Client) Put() {}
+
+func (this *Client) List() {} // want `receiver this of method Client.List can not be named this`
+
+func (self *Client) Close() {} //nolint:receiver // Why: suppressed issues aren't reported.
@@ -0,0 +1,5 @@
+package receiver_test
+
+type fake struct{}
+
+func (self *fake) value() {}
@@ -0,0 +1,137 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package reflectunsafe contains the necessary logic for the reflectunsafe linter. The
+// reflectunsafe linter ensures that the reflect and unsafe packages, which bypass the
+// type system, are only used by an allowed set of packages so that every other use of
+// them is surfaced in review with a nolint directive explaining why it is needed.
+package reflectunsafe
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the reflectunsafe linter.
+const name = "reflectunsafe"
+
+// doc defines the help text for the reflectunsafe linter.
+const doc = `Ensures that the reflect and unsafe packages are only imported and used by the
+packages whose name or import path matches one of the -allowedPackages, e.g.
+"**/internal/codec/**". Everywhere else, each import and use of them needs a nolint
+directive with a reason:
+
+	//nolint:reflectunsafe // Why: <reason>`
+
+// riskyPackages are the import paths of the packages this linter restricts.
+var riskyPackages = map[string]bool{
+	"reflect": true,
+	"unsafe":  true,
+}
+
+// Analyzer exports the reflectunsafe analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      reflectunsafe,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(allowedPackages []string) *analysis.Analyzer {
+	rawAllowedPackages = strings.Join(allowedPackages, ",")
+	return &Analyzer
+}
+
+// rawAllowedPackages is a variable that gets collected via flags. This variable contains
+// a comma-separated list of the package names and import path globs, see common.MatchGlob,
+// of the packages that may use reflect and unsafe.
+var rawAllowedPackages string
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.StringVar(&rawAllowedPackages, "allowedPackages", "",
+		"comma-separated list of package names and import path globs of packages that may use reflect and unsafe")
+}
+
+// reflectunsafe is the function that gets passed to the Analyzer which runs the actual
+// analysis for the reflectunsafe linter on a set of files.
+func reflectunsafe(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages and allowed packages.
+	if common.SkipPackage(_pass) || allowed(_pass.Pkg, strings.Split(rawAllowedPackages, ",")) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, pass.TypesInfo, file)
+	}
+
+	return nil, nil
+}
+
+// allowed reports whether or not the given package may use reflect and unsafe, which is
+// the case if its name or import path matches any of the given patterns.
+func allowed(pkg *types.Package, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if pattern == pkg.Name() || common.MatchGlob(pattern, pkg.Path()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkFile reports every import of reflect and unsafe, and every use of an identifier
+// they declare, within the given file.
+func checkFile(r reporter.Reporter, info *types.Info, file *ast.File) {
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err == nil && riskyPackages[path] {
+			r.Reportf(spec.Pos(), "package %s can only be imported by the allowed packages, "+
+				"explain why it is needed with \"//nolint:%s // Why: <reason>\"", path, name)
+		}
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		ident, ok := ast.Unparen(sel.X).(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		pkgName, ok := info.Uses[ident].(*types.PkgName)
+		if !ok || !riskyPackages[pkgName.Imported().Path()] {
+			return true
+		}
+
+		r.Reportf(sel.Pos(), "%s.%s can only be used by the allowed packages, "+
+			"explain why it is needed with \"//nolint:%s // Why: <reason>\"", pkgName.Imported().Path(), sel.Sel.Name, name)
+
+		return true
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package reflectunsafe
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	a := NewAnalyzerWithOptions([]string{"reflectallowed"})
+	t.Cleanup(func() { NewAnalyzerWithOptions(nil) })
+
+	linttest.Run(t, a, "reflectunsafe", "reflectallowed")
+}
+
+func TestCheckFile(t *testing.T) {
+	tt := []struct {
+		name     string
+		imports  string
+		body     string
+		expected []string
+	}{
+		{
+			name:    "Reports imports and uses of reflect",
+			imports: `"reflect"`,
+			body:    `_ = reflect.ValueOf(1).Kind() == reflect.Int`,
+			expected: []string{
+				"package reflect can only be imported by the allowed packages, " +
+					"explain why it is needed with \"//nolint:reflectunsafe // Why: <reason>\"",
+				"reflect.ValueOf can only be used by the allowed packages, " +
+					"explain why it is needed with \"//nolint:reflectunsafe // Why: <reason>\"",
+				"reflect.Int can only be used by the allowed packages, " +
+					"explain why it is needed with \"//nolint:reflectunsafe // Why: <reason>\"",
+			},
+		},
+		{
+			name:    "Reports renamed imports of unsafe",
+			imports: `u "unsafe"`,
+			body:    `var x int; _ = u.Pointer(&x)`,
+			expected: []string{
+				"package unsafe can only be imported by the allowed packages, " +
+					"explain why it is needed with \"//nolint:reflectunsafe // Why: <reason>\"",
+				"unsafe.Pointer can only be used by the allowed packages, " +
+					"explain why it is needed with \"//nolint:reflectunsafe // Why: <reason>\"",
+			},
+		},
+		{
+			name:     "Allows other packages",
+			imports:  `"strings"`,
+			body:     `_ = strings.ToLower("A")`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			src := "package p\n\nimport (" + test.imports + ")\n\nfunc f() {\n\t" + test.body + "\n}\n"
+
+			file, info := linttest.TypeCheck(t, "p", src, nil)
+
+			var r linttest.Recorder
+			checkFile(&r, info, file)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	pkg := types.NewPackage("github.com/getoutreach/foo/internal/codec/json", "json")
+
+	assert.Assert(t, allowed(pkg, []string{"", "**/internal/codec/**"}))
+	assert.Assert(t, allowed(pkg, []string{"json"}))
+	assert.Assert(t, !allowed(pkg, []string{"**/internal/store/**"}))
+}
@@ -0,0 +1,6 @@
+// Package reflectallowed uses reflect, which it is allowed to.
+package reflectallowed
+
+import "reflect"
+
+var typ = reflect.TypeOf(0)
@@ -0,0 +1,5 @@
+package reflectunsafe
+
+import "reflect"
+
+var ignored = reflect.TypeOf("")
@@ -0,0 +1,10 @@
+// Package reflectunsafe uses reflect without being allowed to.
+package reflectunsafe
+
+import "reflect" // want `package reflect can only be imported by the allowed packages`
+
+var typ = reflect.TypeOf(0) // want `reflect.TypeOf can only be used by the allowed packages`
+
+func kind() bool {
+	return reflect.ValueOf(1).Kind() == reflect.Int //nolint:reflectunsafe // Why: suppressed issues aren't reported.
+}
@@ -0,0 +1,5 @@
+package reflectunsafe_test
+
+import "reflect"
+
+var tested = reflect.TypeOf("")
@@ -0,0 +1,57 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the documentation links appended to each diagnostic.
+
+package reporter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getoutreach/lintroller/docs"
+)
+
+// DefaultDocsBaseURL is the base URL that rule documentation links are built from when
+// no other base URL has been configured.
+const DefaultDocsBaseURL = "https://github.com/getoutreach/lintroller/blob/main/docs/rules"
+
+// docsBaseURL is the base URL that rule documentation links are built from. An empty
+// value disables documentation links entirely.
+var docsBaseURL = DefaultDocsBaseURL
+
+// SetDocsBaseURL overrides the base URL that rule documentation links are built from,
+// e.g. to point at an internal wiki. Passing an empty string disables documentation
+// links.
+func SetDocsBaseURL(base string) {
+	docsBaseURL = strings.TrimSuffix(strings.TrimSpace(base), "/")
+}
+
+// DocsURL returns the documentation URL for the given rule of the given linter, or an empty
+// string if documentation links are disabled or the linter isn't documented, see
+// docs.Lookup. Rules listed on the page of their linter link to its "Rules" section, other
+// issues to the page itself. Pages generated by lintroller docs generate have the same
+// names and sections as the embedded ones, so base URLs can point at either.
+func DocsURL(linter, rule string) string {
+	if docsBaseURL == "" {
+		return ""
+	}
+
+	page, ok := docs.Lookup(linter)
+	if !ok {
+		return ""
+	}
+
+	if _, ok := page.Rule(rule); ok {
+		return fmt.Sprintf("%s/%s.md#rules", docsBaseURL, linter)
+	}
+	return fmt.Sprintf("%s/%s.md", docsBaseURL, linter)
+}
+
+// annotate appends the linter name and, if enabled, the documentation URL for the
+// given rule of the linter to a diagnostic message.
+func annotate(linter, rule, message string) string {
+	if url := DocsURL(linter, rule); url != "" {
+		return fmt.Sprintf("%s (%s, see %s)", message, linter, url)
+	}
+	return fmt.Sprintf("%s (%s)", message, linter)
+}
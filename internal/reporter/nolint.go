@@ -0,0 +1,269 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the analyzer that indexes the nolint directives of a
+// package once for every linter that wraps its pass with NewPass.
+
+package reporter
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"golang.org/x/tools/go/analysis"
+)
+
+// NoLintIndex maps the targets of nolint directives, e.g. the name of a linter, to the
+// directives in a package that reference them. See NoLintAll and forLinter for the targets
+// that apply to more than a single linter or to a single rule.
+type NoLintIndex map[string][]noLint
+
+// NoLintAll is the target of nolint directives that apply to every lintroller linter, e.g.
+// //nolint:lintroller.
+const NoLintAll = "lintroller"
+
+// forLinter returns the nolint directives that apply to every issue of the given linter,
+// which are those referencing it by name, as NoLintAll, or as every rule of it, e.g.
+// //nolint:doculint/*, followed by the directives that reference individual rules of it by
+// rule, e.g. //nolint:doculint/spelling.
+func (index NoLintIndex) forLinter(linter string) ([]noLint, map[string][]noLint) {
+	noLints := index[linter]
+	if len(index[NoLintAll]) > 0 || len(index[linter+"/*"]) > 0 {
+		noLints = append(append(append([]noLint(nil), noLints...), index[NoLintAll]...), index[linter+"/*"]...)
+	}
+
+	var rules map[string][]noLint
+	for target := range index {
+		rule, ok := strings.CutPrefix(target, linter+"/")
+		if !ok || rule == "*" {
+			continue
+		}
+
+		if rules == nil {
+			rules = make(map[string][]noLint)
+		}
+		rules[rule] = index[target]
+	}
+
+	return noLints, rules
+}
+
+// NoLintAnalyzer is the analyzer that scans the comments of a package for nolint
+// directives. Linters list it in their Requires so that NewPass can look up their
+// directives in its result rather than every linter scanning every comment itself.
+var NoLintAnalyzer = analysis.Analyzer{
+	Name:       "nolintindex",
+	Doc:        "Indexes the nolint directives of a package for the lintroller linters.",
+	Run:        indexNoLints,
+	Requires:   []*analysis.Analyzer{&common.FilesAnalyzer},
+	ResultType: reflect.TypeOf(NoLintIndex(nil)),
+}
+
+// indexNoLints is the function that gets passed to NoLintAnalyzer which builds the
+// NoLintIndex of a package, out of the files that may contain nolint directives.
+func indexNoLints(pass *analysis.Pass) (interface{}, error) {
+	var files []*ast.File
+	for _, file := range common.FilesWithExcluded(pass) {
+		if common.HasMarker(pass, file, common.MarkerNoLint) {
+			files = append(files, file)
+		}
+	}
+
+	return newNoLintIndex(pass.Fset, files), nil
+}
+
+// newNoLintIndex returns the NoLintIndex of the given files. A directive within a call that
+// spans several lines, e.g. on the line of its closing parenthesis, also applies to the
+// first line of the call, where issues with the call as a whole are reported. A
+// //nolint-start directive applies to every line up to the //nolint-end directive that
+// closes it, or up to the end of its file if there is none.
+func newNoLintIndex(fset *token.FileSet, files []*ast.File) NoLintIndex {
+	index := make(NoLintIndex)
+
+	for _, file := range files {
+		lastLine := fset.File(file.Pos()).LineCount()
+
+		// open contains the entries of the index made for each range directive that hasn't
+		// been closed yet, innermost last.
+		var open [][]indexEntry
+
+		var spans []lineSpan
+		for _, commentGroup := range file.Comments {
+			for _, comment := range commentGroup.List {
+				directive, ok := ParseDirective(comment.Text)
+				if !ok || directive.Naked {
+					continue
+				}
+
+				position := fset.PositionFor(comment.Pos(), false)
+
+				if directive.End {
+					if len(open) > 0 {
+						for _, entry := range open[len(open)-1] {
+							index[entry.linter][entry.i].end = position.Line
+						}
+						open = open[:len(open)-1]
+					}
+					continue
+				}
+
+				lines := []int{position.Line}
+				if !directive.Start {
+					if spans == nil {
+						spans = callSpans(fset, file)
+					}
+
+					for _, span := range spans {
+						if span.start < position.Line && position.Line <= span.end {
+							lines = append(lines, span.start)
+						}
+					}
+				}
+
+				var entries []indexEntry
+				linters := directive.Linters
+				for i, linter := range linters {
+					if contains(linters[:i], linter) {
+						// Don't index the same directive twice for a linter listed twice.
+						continue
+					}
+
+					for j, line := range lines {
+						n := noLint{filename: position.Filename, line: line, callStart: j > 0}
+						if directive.Start {
+							n.end = lastLine
+						}
+
+						entries = append(entries, indexEntry{linter: linter, i: len(index[linter])})
+						index[linter] = append(index[linter], n)
+					}
+				}
+
+				if directive.Start {
+					open = append(open, entries)
+				}
+			}
+		}
+	}
+
+	return index
+}
+
+// indexEntry locates a directive within a NoLintIndex.
+type indexEntry struct {
+	linter string
+	i      int
+}
+
+// lineSpan is the range of lines, inclusive, a node spans.
+type lineSpan struct {
+	start int
+	end   int
+}
+
+// callSpans returns the line spans of the calls in the given file that span several lines.
+// It never returns nil so that it doesn't need to be called again for the same file.
+func callSpans(fset *token.FileSet, file *ast.File) []lineSpan {
+	spans := []lineSpan{}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		start, end := fset.PositionFor(call.Pos(), false).Line, fset.PositionFor(call.End(), false).Line
+		if start < end {
+			spans = append(spans, lineSpan{start: start, end: end})
+		}
+
+		return true
+	})
+
+	return spans
+}
+
+// Directive is a nolint directive parsed from a comment, e.g.:
+//
+//	//nolint:why,doculint // Why: reasoning
+//
+// or a range directive, which applies to every line between the two comments:
+//
+//	//nolint-start:magicnumber // Why: reasoning
+//	//nolint-end
+type Directive struct {
+	// Linters are the linters the directive applies to, e.g. why and doculint.
+	Linters []string
+
+	// Naked denotes a directive that doesn't list any linters, e.g. //nolint, which
+	// lintroller doesn't honor, see the why linter.
+	Naked bool
+
+	// Reason is the reason following "// Why:", or empty if there is none.
+	Reason string
+
+	// Start denotes a //nolint-start directive, which applies to every line up to the
+	// //nolint-end directive that closes it rather than just its own line and the next.
+	Start bool
+
+	// End denotes a //nolint-end directive, which closes the innermost //nolint-start
+	// directive before it. It doesn't list any linters.
+	End bool
+}
+
+// ParseDirective parses the nolint directive in the given comment text, including its
+// slashes. The returned bool is false if the comment isn't a nolint directive.
+func ParseDirective(comment string) (Directive, bool) {
+	text := strings.TrimSpace(strings.TrimPrefix(comment, "//"))
+
+	// whySlashesIdx finds the next set of slashes if the nolint directive is in the form
+	// of:
+	//	nolint: why,doculint // Why: reasoning
+	// If these slashes exist we use the index to trim them and all text following it off
+	// of the string, effectively producing:
+	//	nolint: why,doculint
+	var reason string
+	if whySlashesIdx := strings.Index(text, "//"); whySlashesIdx != -1 {
+		rest := strings.TrimSpace(text[whySlashesIdx+len("//"):])
+		if strings.HasPrefix(rest, "Why:") {
+			reason = strings.TrimSpace(strings.TrimPrefix(rest, "Why:"))
+		}
+		text = strings.TrimSpace(text[:whySlashesIdx])
+	}
+
+	if text == noLintEndDirective || strings.HasPrefix(text, noLintEndDirective+":") {
+		return Directive{End: true, Reason: reason}, true
+	}
+
+	prefix, start := noLintDirective, false
+	if strings.HasPrefix(text, strings.TrimSuffix(noLintStartDirective, ":")) {
+		prefix, start = noLintStartDirective, true
+	}
+
+	if text == strings.TrimSuffix(prefix, ":") {
+		return Directive{Naked: true, Reason: reason, Start: start}, true
+	}
+
+	if !strings.HasPrefix(text, prefix) {
+		return Directive{}, false
+	}
+
+	return Directive{
+		Linters: strings.Split(strings.TrimSpace(strings.TrimPrefix(text, prefix)), ","),
+		Reason:  reason,
+		Start:   start,
+	}, true
+}
+
+// contains reports whether or not the given slice contains the given string.
+func contains(slice []string, s string) bool {
+	for i := range slice {
+		if slice[i] == s {
+			return true
+		}
+	}
+
+	return false
+}
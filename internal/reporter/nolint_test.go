@@ -0,0 +1,298 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package reporter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+func TestNewNoLintIndex(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", `package foo
+
+//nolint:doculint,todo // Why: reasoning
+func foo() {}
+
+func bar() {} //nolint:todo,todo
+
+// nolint is not a directive without a colon.
+func baz() {}
+`, parser.ParseComments)
+	assert.NilError(t, err)
+
+	index := newNoLintIndex(fset, []*ast.File{file})
+	assert.Assert(t, reflect.DeepEqual(index, NoLintIndex{
+		"doculint": {{filename: "foo.go", line: 3}},
+		"todo":     {{filename: "foo.go", line: 3}, {filename: "foo.go", line: 6}},
+	}), "%v", index)
+
+	// NewPass uses the index from NoLintAnalyzer when it is available.
+	pass := NewPass("why", &analysis.Pass{
+		Fset:     fset,
+		Files:    []*ast.File{file},
+		ResultOf: map[*analysis.Analyzer]interface{}{&NoLintAnalyzer: NoLintIndex{"why": {{filename: "foo.go", line: 9}}}},
+	})
+	assert.Assert(t, reflect.DeepEqual(pass.noLints, []noLint{{filename: "foo.go", line: 9}}), "%v", pass.noLints)
+
+	// Otherwise it indexes the files itself.
+	pass = NewPass("doculint", &analysis.Pass{Fset: fset, Files: []*ast.File{file}})
+	assert.Assert(t, reflect.DeepEqual(pass.noLints, []noLint{{filename: "foo.go", line: 3}}), "%v", pass.noLints)
+}
+
+func TestNewNoLintIndexMultiLineCalls(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", `package foo
+
+func foo() error {
+	return wrap(
+		wrap(nil,
+			"inner"), //nolint:errorlint // Why: reasoning
+		"outer",
+	) //nolint:todo // Why: reasoning
+}
+
+func wrap(err error, msg string) error { return err }
+`, parser.ParseComments)
+	assert.NilError(t, err)
+
+	// Directives within a call also apply to the first line of every call they are within.
+	index := newNoLintIndex(fset, []*ast.File{file})
+	assert.Assert(t, reflect.DeepEqual(index, NoLintIndex{
+		"errorlint": {
+			{filename: "foo.go", line: 6}, {filename: "foo.go", line: 4, callStart: true}, {filename: "foo.go", line: 5, callStart: true},
+		},
+		"todo": {{filename: "foo.go", line: 8}, {filename: "foo.go", line: 4, callStart: true}},
+	}), "%v", index)
+
+	pass := NewPass("todo", &analysis.Pass{Fset: fset, Files: []*ast.File{file}})
+	for line, suppressed := range map[int]bool{3: false, 4: true, 7: false, 8: true, 11: false} {
+		position := token.Position{Filename: "foo.go", Line: line}
+
+		var matched bool
+		for i := range pass.noLints {
+			matched = matched || pass.noLints[i].Matches(position)
+		}
+		assert.Equal(t, matched, suppressed, "line %d", line)
+	}
+}
+
+func TestNewNoLintIndexRanges(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", `package foo
+
+//nolint-start:magicnumber // Why: reasoning
+var a = 1
+
+//nolint-start:todo // Why: reasoning
+var b = 2
+
+//nolint-end
+var c = 3
+
+//nolint-end
+var d = 4
+
+//nolint-start:todo // Why: reasoning
+var e = 5
+`, parser.ParseComments)
+	assert.NilError(t, err)
+
+	// Ends close the innermost range, and ranges that aren't closed last until the end of
+	// the file.
+	index := newNoLintIndex(fset, []*ast.File{file})
+	assert.Assert(t, reflect.DeepEqual(index, NoLintIndex{
+		"magicnumber": {{filename: "foo.go", line: 3, end: 12}},
+		"todo":        {{filename: "foo.go", line: 6, end: 9}, {filename: "foo.go", line: 15, end: 16}},
+	}), "%v", index)
+
+	pass := NewPass("magicnumber", &analysis.Pass{Fset: fset, Files: []*ast.File{file}})
+	for line, suppressed := range map[int]bool{2: false, 3: true, 10: true, 12: true, 13: false} {
+		position := token.Position{Filename: "foo.go", Line: line}
+
+		var matched bool
+		for i := range pass.noLints {
+			matched = matched || pass.noLints[i].Matches(position)
+		}
+		assert.Equal(t, matched, suppressed, "line %d", line)
+	}
+}
+
+func TestNoLintIndexForLinter(t *testing.T) {
+	index := NoLintIndex{
+		"doculint":          {{filename: "foo.go", line: 1}},
+		"lintroller":        {{filename: "foo.go", line: 2}},
+		"doculint/*":        {{filename: "foo.go", line: 3}},
+		"doculint/spelling": {{filename: "foo.go", line: 4}},
+		"todo/*":            {{filename: "foo.go", line: 5}},
+	}
+
+	noLints, rules := index.forLinter("doculint")
+	assert.Assert(t, reflect.DeepEqual(noLints, []noLint{
+		{filename: "foo.go", line: 1}, {filename: "foo.go", line: 2}, {filename: "foo.go", line: 3},
+	}), "%v", noLints)
+	assert.Assert(t, reflect.DeepEqual(rules, map[string][]noLint{"spelling": {{filename: "foo.go", line: 4}}}), "%v", rules)
+
+	noLints, rules = index.forLinter("why")
+	assert.Assert(t, reflect.DeepEqual(noLints, []noLint{{filename: "foo.go", line: 2}}), "%v", noLints)
+	assert.Assert(t, rules == nil, "%v", rules)
+}
+
+func TestPassReportHonorsRuleDirectives(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "foo.go", `package foo
+
+var a = 1 //nolint:doculint/spelling // Why: reasoning
+
+var b = 2 //nolint:lintroller // Why: reasoning
+`, parser.ParseComments)
+	assert.NilError(t, err)
+
+	var reported []string
+	pass := NewPass("doculint", &analysis.Pass{
+		Fset:  fset,
+		Files: []*ast.File{file},
+		Report: func(d analysis.Diagnostic) {
+			reported = append(reported, fmt.Sprintf("%d %s", fset.Position(d.Pos).Line, d.Category))
+		},
+	})
+
+	SetRawReports(true)
+	defer SetRawReports(false)
+
+	for _, rule := range []string{"spelling", "missing-comment"} {
+		for _, decl := range file.Decls {
+			pass.Report(analysis.Diagnostic{Pos: decl.Pos(), Category: rule, Message: "nolint " + t.Name()})
+		}
+	}
+
+	assert.DeepEqual(t, reported, []string{"3 missing-comment"})
+}
+
+func TestEscalations(t *testing.T) {
+	fset := token.NewFileSet()
+	dense, err := parser.ParseFile(fset, "dense.go", `package foo
+
+var a = 1 //nolint:todo // Why: reasoning
+
+var b = 2 //nolint:todo/ticket,lintroller // Why: reasoning
+
+var c = wrap(nil,
+	"c") //nolint:todo // Why: reasoning
+
+var d = 4 //nolint:doculint // Why: reasoning
+`, parser.ParseComments)
+	assert.NilError(t, err)
+
+	sparse, err := parser.ParseFile(fset, "sparse.go", `package foo
+
+var e = 5 //nolint:todo // Why: reasoning
+`, parser.ParseComments)
+	assert.NilError(t, err)
+
+	SetEscalations(map[string]int{"todo": 2, "doculint": 1})
+	defer SetEscalations(nil)
+
+	// Directives for several rules, or within calls that span several lines, are only
+	// counted once, and the directives of other linters aren't counted at all.
+	for _, linter := range []string{"todo", "doculint", "why"} {
+		NewPass(linter, &analysis.Pass{Fset: fset, Files: []*ast.File{dense, sparse}})
+	}
+
+	assert.Equal(t, IsEscalated("todo", "dense.go"), true)
+	assert.Equal(t, IsEscalated("todo", "sparse.go"), false)
+	assert.Equal(t, IsEscalated("doculint", "dense.go"), true)
+	assert.Equal(t, IsEscalated("why", "dense.go"), false)
+
+	SetWarnings([]string{"todo"})
+	defer SetWarnings(nil)
+
+	var reported []string
+	pass := NewPass("todo", &analysis.Pass{
+		Fset:  fset,
+		Files: []*ast.File{dense, sparse},
+		Report: func(d analysis.Diagnostic) {
+			reported = append(reported, fset.Position(d.Pos).Filename)
+		},
+	})
+
+	// Warnings in escalated files are reported as errors, the others are printed.
+	pass.Report(analysis.Diagnostic{Pos: dense.Name.Pos(), Message: "escalated " + t.Name()})
+	pass.Report(analysis.Diagnostic{Pos: sparse.Name.Pos(), Message: "not escalated " + t.Name()})
+	assert.DeepEqual(t, reported, []string{"dense.go"})
+}
+
+func TestParseDirective(t *testing.T) {
+	tt := []struct {
+		name     string
+		comment  string
+		expected Directive
+		ok       bool
+	}{
+		{
+			name:     "Parses linters and reason",
+			comment:  "//nolint:doculint,todo // Why: reasoning",
+			expected: Directive{Linters: []string{"doculint", "todo"}, Reason: "reasoning"},
+			ok:       true,
+		},
+		{
+			name:     "Parses directives without a reason",
+			comment:  "// nolint:errcheck",
+			expected: Directive{Linters: []string{"errcheck"}},
+			ok:       true,
+		},
+		{
+			name:     "Parses naked directives",
+			comment:  "//nolint // Why: everything",
+			expected: Directive{Naked: true, Reason: "everything"},
+			ok:       true,
+		},
+		{
+			name:     "Ignores trailing comments that aren't reasons",
+			comment:  "//nolint:todo // TODO(foo): remove",
+			expected: Directive{Linters: []string{"todo"}},
+			ok:       true,
+		},
+		{
+			name:     "Parses range starts",
+			comment:  "//nolint-start:magicnumber,todo // Why: legacy table",
+			expected: Directive{Linters: []string{"magicnumber", "todo"}, Reason: "legacy table", Start: true},
+			ok:       true,
+		},
+		{
+			name:     "Parses naked range starts",
+			comment:  "//nolint-start",
+			expected: Directive{Naked: true, Start: true},
+			ok:       true,
+		},
+		{
+			name:     "Parses range ends",
+			comment:  "//nolint-end",
+			expected: Directive{End: true},
+			ok:       true,
+		},
+		{
+			name:    "Ignores comments starting with a directive",
+			comment: "//nolint-endless",
+		},
+		{
+			name:    "Ignores other comments",
+			comment: "// nolint is not a directive without a colon.",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			directive, ok := ParseDirective(test.comment)
+			assert.Equal(t, ok, test.ok)
+			assert.DeepEqual(t, directive, test.expected)
+		})
+	}
+}
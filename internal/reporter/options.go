@@ -4,6 +4,11 @@
 
 package reporter
 
+import (
+	"sync"
+	"sync/atomic"
+)
+
 // PassOption is the functional argument type for Pass.
 type PassOption func(*Pass)
 
@@ -14,3 +19,214 @@ func Warn() PassOption {
 		p.warn = true
 	}
 }
+
+// warnings is the process-wide set of linters whose issues are warnings, see SetWarnings.
+var warnings = struct {
+	mu      sync.RWMutex
+	linters map[string]bool
+}{}
+
+// SetWarnings sets the linters whose issues are reported as warnings, as if Warn was given
+// to each of their passes, rather than errors that fail the run.
+func SetWarnings(linters []string) {
+	warnings.mu.Lock()
+	defer warnings.mu.Unlock()
+
+	warnings.linters = make(map[string]bool, len(linters))
+	for _, linter := range linters {
+		warnings.linters[linter] = true
+	}
+}
+
+// IsWarning returns true if the issues of the given linter are reported as warnings, see
+// SetWarnings.
+func IsWarning(linter string) bool {
+	warnings.mu.RLock()
+	defer warnings.mu.RUnlock()
+
+	return warnings.linters[linter]
+}
+
+// ruleWarnings is the process-wide set of rules, by linter and by ID, whose issues are
+// warnings, see SetRuleWarnings and SetWarningRules.
+var ruleWarnings = struct {
+	mu    sync.RWMutex
+	rules map[string]map[string]bool
+	ids   map[string]bool
+}{}
+
+// SetRuleWarnings sets the rules of the given linter, the categories of its diagnostics,
+// whose issues are reported as warnings rather than errors, for linters whose rules each
+// have a severity of their own.
+func SetRuleWarnings(linter string, rules []string) {
+	ruleWarnings.mu.Lock()
+	defer ruleWarnings.mu.Unlock()
+
+	if ruleWarnings.rules == nil {
+		ruleWarnings.rules = make(map[string]map[string]bool)
+	}
+
+	ruleWarnings.rules[linter] = make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		ruleWarnings.rules[linter][rule] = true
+	}
+}
+
+// SetWarningRules sets the IDs of the rules, e.g. "doculint/spelling", whose issues are
+// reported as warnings rather than errors, in addition to the rules set by each linter with
+// SetRuleWarnings.
+func SetWarningRules(ids []string) {
+	ruleWarnings.mu.Lock()
+	defer ruleWarnings.mu.Unlock()
+
+	ruleWarnings.ids = make(map[string]bool, len(ids))
+	for _, id := range ids {
+		ruleWarnings.ids[id] = true
+	}
+}
+
+// IsRuleWarning returns true if the issues of the given rule of the given linter are
+// reported as warnings, see SetRuleWarnings and SetWarningRules.
+func IsRuleWarning(linter, rule string) bool {
+	if rule == "" {
+		return false
+	}
+
+	ruleWarnings.mu.RLock()
+	defer ruleWarnings.mu.RUnlock()
+
+	return ruleWarnings.rules[linter][rule] || ruleWarnings.ids[linter+"/"+rule]
+}
+
+// errorRules is the process-wide set of rule IDs whose issues are errors, see SetErrorRules.
+var errorRules = struct {
+	mu  sync.RWMutex
+	ids map[string]bool
+}{}
+
+// SetErrorRules sets the IDs of the rules, e.g. "doculint/spelling", whose issues are
+// reported as errors even when the other issues of their linter are warnings, see
+// SetWarnings. This takes precedence over SetRuleWarnings and SetWarningRules.
+func SetErrorRules(ids []string) {
+	errorRules.mu.Lock()
+	defer errorRules.mu.Unlock()
+
+	errorRules.ids = make(map[string]bool, len(ids))
+	for _, id := range ids {
+		errorRules.ids[id] = true
+	}
+}
+
+// IsRuleError returns true if the issues of the given rule of the given linter are reported
+// as errors regardless of the severity of the linter, see SetErrorRules.
+func IsRuleError(linter, rule string) bool {
+	if rule == "" {
+		return false
+	}
+
+	errorRules.mu.RLock()
+	defer errorRules.mu.RUnlock()
+
+	return errorRules.ids[linter+"/"+rule]
+}
+
+// escalations is the process-wide state of the escalation of warnings in files that have
+// many nolint directives, see SetEscalations.
+var escalations = escalationState{}
+
+// escalationState contains the thresholds of the linters whose warnings are escalated, and
+// the files, by linter, whose warnings are.
+type escalationState struct {
+	mu         sync.RWMutex
+	thresholds map[string]int
+	files      map[string]map[string]bool
+}
+
+// SetEscalations sets the number of nolint directives for each of the given linters a file
+// may contain before the warnings of the linter in that file are reported as errors, so that
+// files that suppress most of their issues rather than fixing them can't pass unnoticed.
+// Warnings of linters not given are never escalated.
+func SetEscalations(thresholds map[string]int) {
+	escalations.mu.Lock()
+	defer escalations.mu.Unlock()
+
+	escalations.thresholds = make(map[string]int, len(thresholds))
+	for linter, threshold := range thresholds {
+		escalations.thresholds[linter] = threshold
+	}
+	escalations.files = make(map[string]map[string]bool)
+}
+
+// IsEscalated returns true if the warnings of the given linter in the file with the given
+// name are reported as errors because the file contains more nolint directives for the
+// linter than its threshold, see SetEscalations.
+func IsEscalated(linter, filename string) bool {
+	escalations.mu.RLock()
+	defer escalations.mu.RUnlock()
+
+	return escalations.files[linter][filename]
+}
+
+// record counts the given nolint directives of the given linter by file, and escalates the
+// warnings of the linter in the files where they exceed its threshold. Directives that
+// apply to several rules, or to several lines, are only counted once.
+func (e *escalationState) record(linter string, noLints []noLint, ruleNoLints map[string][]noLint) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	threshold, ok := e.thresholds[linter]
+	if !ok {
+		return
+	}
+
+	directives := make(map[noLint]bool)
+	count := func(noLints []noLint) {
+		for _, n := range noLints {
+			if !n.callStart {
+				directives[noLint{filename: n.filename, line: n.line}] = true
+			}
+		}
+	}
+
+	count(noLints)
+	for _, noLints := range ruleNoLints {
+		count(noLints)
+	}
+
+	counts := make(map[string]int)
+	for n := range directives {
+		counts[n.filename]++
+	}
+
+	for filename, n := range counts {
+		if n <= threshold {
+			continue
+		}
+
+		if e.files[linter] == nil {
+			e.files[linter] = make(map[string]bool)
+		}
+		e.files[linter][filename] = true
+	}
+}
+
+// emitted is the record of the diagnostics emitted during the current run, see SetDedupe.
+var emitted atomic.Pointer[Dedupe]
+
+// SetDedupe sets the record of the diagnostics emitted during the current run, against
+// which identical diagnostics are dropped. Drivers set a new one, see NewDedupe, at the
+// start of every run and unset it at the end. Without one, no diagnostic is dropped.
+func SetDedupe(d *Dedupe) {
+	emitted.Store(d)
+}
+
+// rawReports denotes whether or not issues are reported as they are, see SetRawReports.
+var rawReports atomic.Bool
+
+// SetRawReports sets whether or not every issue, warnings included, is reported through
+// analysis.Pass.Report as it is, rather than annotated with its linter and documentation
+// link, with warnings printed separately. A driver writing issues in a format of its own
+// sets this, telling warnings apart with IsWarning.
+func SetRawReports(raw bool) {
+	rawReports.Store(raw)
+}
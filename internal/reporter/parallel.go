@@ -0,0 +1,98 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the concurrent processing of the files of a package,
+// reporting the issues found in each through a buffer so that they're emitted in order.
+
+package reporter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// fileWorkers is the number of files of a package processed concurrently, see
+// SetFileWorkers.
+var fileWorkers atomic.Int64
+
+// SetFileWorkers sets the number of files of a package that Pass.ForEachFile processes
+// concurrently. Zero, the default, uses the number of CPUs available, and one processes the
+// files sequentially.
+func SetFileWorkers(n int) {
+	fileWorkers.Store(int64(n))
+}
+
+// Buffer collects the issues reported while a single file is processed by
+// Pass.ForEachFile. It implements Reporter, as well as Report for diagnostics that carry
+// more than a message, so it can be given to the same helper functions Pass is.
+type Buffer struct {
+	diagnostics []analysis.Diagnostic
+}
+
+// Reportf records an issue at the given position, see Pass.Reportf.
+func (b *Buffer) Reportf(pos token.Pos, format string, args ...interface{}) {
+	b.Report(analysis.Diagnostic{
+		Pos:     pos,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Report records the given diagnostic, see Pass.Report.
+func (b *Buffer) Report(d analysis.Diagnostic) {
+	b.diagnostics = append(b.diagnostics, d)
+}
+
+// ForEachFile calls fn for each of the given files, concurrently across a bounded number of
+// workers, see SetFileWorkers. Each call gets a Buffer of its own to report issues through,
+// which are reported through the receiver once every file has been processed, in the order
+// of the files, so the output doesn't depend on how the files were scheduled. fn must not
+// report through the receiver itself, nor touch state shared with other calls without
+// synchronizing.
+func (p *Pass) ForEachFile(files []*ast.File, fn func(r *Buffer, file *ast.File)) {
+	buffers := make([]Buffer, len(files))
+
+	workers := int(fileWorkers.Load())
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(files) {
+		workers = len(files)
+	}
+
+	if workers <= 1 {
+		for i := range files {
+			fn(&buffers[i], files[i])
+		}
+	} else {
+		next := make(chan int)
+
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for i := range next {
+					fn(&buffers[i], files[i])
+				}
+			}()
+		}
+
+		for i := range files {
+			next <- i
+		}
+		close(next)
+		wg.Wait()
+	}
+
+	for i := range buffers {
+		for _, d := range buffers[i].diagnostics {
+			p.Report(d)
+		}
+	}
+}
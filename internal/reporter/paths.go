@@ -0,0 +1,64 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements rewriting the paths of files in reported issues, e.g.
+// from the paths of a build sandbox to paths relative to the root of the repository.
+
+package reporter
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// PathRewrite replaces a prefix of the paths of files in reported issues.
+type PathRewrite struct {
+	// From is the prefix replaced, e.g. "/sandbox/execroot/__main__/".
+	From string
+
+	// To replaces From, e.g. an empty string to strip it.
+	To string
+}
+
+// reportPaths is the process-wide configuration of the paths of files in reported issues,
+// see SetReportPaths.
+var reportPaths = struct {
+	mu       sync.RWMutex
+	root     string
+	rewrites []PathRewrite
+}{}
+
+// SetReportPaths sets how the paths of files are written in reported issues, in every
+// format and in the summary: rewritten by the first of the given rewrites whose From
+// prefixes them, or else made relative to the given root directory, e.g. the root of the
+// module, if it isn't empty. Passing an empty root and no rewrites leaves every format to
+// write paths as it does by default.
+func SetReportPaths(root string, rewrites []PathRewrite) {
+	reportPaths.mu.Lock()
+	defer reportPaths.mu.Unlock()
+
+	reportPaths.root = root
+	reportPaths.rewrites = append([]PathRewrite(nil), rewrites...)
+}
+
+// ReportPath returns the path the given file is written as in reported issues, see
+// SetReportPaths, and whether or not it was rewritten. Files that no rewrite applies to,
+// and that are outside of the root, are written as they are by default.
+func ReportPath(filename string) (string, bool) {
+	reportPaths.mu.RLock()
+	defer reportPaths.mu.RUnlock()
+
+	for _, rewrite := range reportPaths.rewrites {
+		if rest, ok := strings.CutPrefix(filename, rewrite.From); ok {
+			return rewrite.To + rest, true
+		}
+	}
+
+	if reportPaths.root != "" && filepath.IsAbs(filename) {
+		if rel, err := filepath.Rel(reportPaths.root, filename); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), true
+		}
+	}
+
+	return filename, false
+}
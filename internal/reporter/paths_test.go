@@ -0,0 +1,72 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package reporter
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestReportPath(t *testing.T) {
+	tt := []struct {
+		name              string
+		root              string
+		rewrites          []PathRewrite
+		filename          string
+		expected          string
+		expectedRewritten bool
+	}{
+		{
+			name:              "Leaves paths as they are by default",
+			filename:          "/src/svc/internal/foo/foo.go",
+			expected:          "/src/svc/internal/foo/foo.go",
+			expectedRewritten: false,
+		},
+		{
+			name:              "Makes paths relative to the root",
+			root:              "/src/svc",
+			filename:          "/src/svc/internal/foo/foo.go",
+			expected:          "internal/foo/foo.go",
+			expectedRewritten: true,
+		},
+		{
+			name:              "Leaves paths outside of the root as they are",
+			root:              "/src/svc",
+			filename:          "/src/other/foo.go",
+			expected:          "/src/other/foo.go",
+			expectedRewritten: false,
+		},
+		{
+			name: "Rewrites prefixes before making paths relative",
+			root: "/sandbox/execroot/__main__",
+			rewrites: []PathRewrite{
+				{From: "/sandbox/execroot/__main__/svc/", To: "services/svc/"},
+			},
+			filename:          "/sandbox/execroot/__main__/svc/internal/foo/foo.go",
+			expected:          "services/svc/internal/foo/foo.go",
+			expectedRewritten: true,
+		},
+		{
+			name: "Applies the first matching rewrite",
+			rewrites: []PathRewrite{
+				{From: "/sandbox/", To: ""},
+				{From: "/sandbox/execroot/", To: "root/"},
+			},
+			filename:          "/sandbox/execroot/foo.go",
+			expected:          "execroot/foo.go",
+			expectedRewritten: true,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetReportPaths(test.root, test.rewrites)
+			t.Cleanup(func() { SetReportPaths("", nil) })
+
+			path, rewritten := ReportPath(test.filename)
+			assert.Equal(t, path, test.expected)
+			assert.Equal(t, rewritten, test.expectedRewritten)
+		})
+	}
+}
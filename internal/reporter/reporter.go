@@ -13,8 +13,9 @@ package reporter
 import (
 	"fmt"
 	"go/token"
-	"strings"
+	"sync"
 
+	"github.com/getoutreach/lintroller/internal/common"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -22,12 +23,57 @@ import (
 // Pass.
 const noLintDirective = "nolint:"
 
+// noLintStartDirective is the string that is looked for to gather where a range of lines to
+// skip starts, see Directive.
+const noLintStartDirective = "nolint-start:"
+
+// noLintEndDirective is the string that is looked for to gather where a range of lines to
+// skip ends, see Directive.
+const noLintEndDirective = "nolint-end"
+
 // Reporter is a convenience interface that allows Pass to be provided to helper functions
 // in linters who need to be able to use Reportf.
 type Reporter interface {
 	Reportf(pos token.Pos, format string, args ...interface{})
 }
 
+// ReportRule reports an issue under the rule with the given ID, e.g. "spelling", through r.
+// The rule is attached as the category of the diagnostic when r supports reporting
+// diagnostics, as Pass does, so that each rule of a linter can have a severity of its own,
+// see SetWarningRules and SetErrorRules.
+func ReportRule(r Reporter, rule string, pos token.Pos, format string, args ...interface{}) {
+	if dr, ok := r.(interface{ Report(analysis.Diagnostic) }); ok {
+		dr.Report(analysis.Diagnostic{
+			Pos:      pos,
+			Category: rule,
+			Message:  fmt.Sprintf(format, args...),
+		})
+		return
+	}
+
+	r.Reportf(pos, format, args...)
+}
+
+// ReportRelated reports an issue under the rule with the given ID through r the same way
+// ReportRule does, along with related information: the secondary positions the issue
+// concerns, e.g. the type of an enum-like constant block reported at the block, each with
+// a message of its own. The related information is dropped when r doesn't support
+// reporting diagnostics. Linters that aren't made up of rules give an empty rule.
+func ReportRelated(r Reporter, rule string, pos token.Pos, related []analysis.RelatedInformation, format string,
+	args ...interface{}) {
+	if dr, ok := r.(interface{ Report(analysis.Diagnostic) }); ok {
+		dr.Report(analysis.Diagnostic{
+			Pos:      pos,
+			Category: rule,
+			Message:  fmt.Sprintf(format, args...),
+			Related:  related,
+		})
+		return
+	}
+
+	r.Reportf(pos, format, args...)
+}
+
 // noLint is a struct that depicts a filename/line tandem that shouldn't be linted against
 // for the current linter.
 //
@@ -39,15 +85,70 @@ type Reporter interface {
 type noLint struct {
 	filename string
 	line     int
+
+	// end is the last line of a range directive, see Directive.Start, or zero for a
+	// directive that only applies to its own line and the next.
+	end int
+
+	// callStart is true for the copy of a directive within a call that spans several lines
+	// made for the first line of the call, so that the directive is only counted once.
+	callStart bool
 }
 
 // Matches is a convenience function that matches the receiver with a token.Position.
 //
 // The reason we match on both noLint.line == position.Line and the line after noLint.line
 // (noLint.line+1) is to allow users to specify their nolint directives on the exact same
-// line that the linter is complaining about, as well as the one before it.
+// line that the linter is complaining about, as well as the one before it. Range
+// directives match every line from their own up to their end.
 func (n *noLint) Matches(position token.Position) bool {
-	return n.filename == position.Filename && (n.line == position.Line || n.line+1 == position.Line)
+	if n.filename != position.Filename {
+		return false
+	}
+
+	if n.end > 0 {
+		return n.line <= position.Line && position.Line <= n.end
+	}
+
+	return n.line == position.Line || n.line+1 == position.Line
+}
+
+// Dedupe keeps track of the diagnostics that have already been emitted during a run.
+//
+// The same diagnostic can be produced more than once in a single run, e.g. when a file
+// belongs to both a package and its test variant, or when an analyzer is registered with
+// the driver more than once. Every diagnostic flows through Pass.Reportf, so this is the
+// one place where duplicates can be reliably dropped. Drivers create a Dedupe for every
+// run, see SetDedupe, so that a later run in the same process reports them again.
+type Dedupe struct {
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+// NewDedupe returns a Dedupe that hasn't seen any diagnostic yet.
+func NewDedupe() *Dedupe {
+	return &Dedupe{seen: make(map[string]struct{})}
+}
+
+// firstOccurrence returns true the first time it is called with a given linter, position,
+// and message combination and false every time after that. A nil Dedupe returns true for
+// every combination.
+func (d *Dedupe) firstOccurrence(linter string, position token.Position, message string) bool {
+	if d == nil {
+		return true
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", linter, position.String(), message)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.seen[key]; exists {
+		return false
+	}
+	d.seen[key] = struct{}{}
+
+	return true
 }
 
 // Pass is a wrapper around *analysis.Pass that accounts for nolint directives as well as any
@@ -58,6 +159,10 @@ type Pass struct {
 	noLints []noLint
 	linter  string
 
+	// ruleNoLints are the nolint directives that reference individual rules of the linter,
+	// e.g. //nolint:doculint/spelling, by rule.
+	ruleNoLints map[string][]noLint
+
 	*analysis.Pass
 
 	// True if this should treat linter issues as warnings.
@@ -70,44 +175,21 @@ func NewPass(linter string, pass *analysis.Pass, opts ...PassOption) *Pass {
 	p := Pass{
 		Pass:   pass,
 		linter: linter,
+		warn:   IsWarning(linter),
 	}
 
 	for i := range opts {
 		opts[i](&p)
 	}
 
-	for _, file := range p.Files {
-		for _, commentGroup := range file.Comments {
-			for _, comment := range commentGroup.List {
-				text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
-
-				// whySlashesIdx finds the next set of slashes if the nolint directive is in the form
-				// of:
-				//	nolint: why,doculint // Why: reasoning
-				// If these slashes exist we use the index to trim them and all text following it off
-				// of the string, effectively producing:
-				//	nolint: why,doculint
-				if whySlashesIdx := strings.Index(text, "//"); whySlashesIdx != -1 {
-					text = strings.TrimSpace(text[:whySlashesIdx])
-				}
-
-				if strings.HasPrefix(text, noLintDirective) {
-					linters := strings.Split(strings.TrimSpace(strings.TrimPrefix(text, noLintDirective)), ",")
-
-					for i := range linters {
-						if linters[i] == linter {
-							position := pass.Fset.PositionFor(comment.Pos(), false)
-							p.noLints = append(p.noLints, noLint{
-								filename: position.Filename,
-								line:     position.Line,
-							})
-							break
-						}
-					}
-				}
-			}
-		}
+	// Use the index built by NoLintAnalyzer when the linter requires it, otherwise the
+	// directives have to be indexed for just this pass.
+	index, ok := pass.ResultOf[&NoLintAnalyzer].(NoLintIndex)
+	if !ok {
+		index = newNoLintIndex(pass.Fset, common.FilesWithExcluded(pass))
 	}
+	p.noLints, p.ruleNoLints = index.forLinter(linter)
+	escalations.record(linter, p.noLints, p.ruleNoLints)
 
 	return &p
 }
@@ -115,15 +197,123 @@ func NewPass(linter string, pass *analysis.Pass, opts ...PassOption) *Pass {
 // Reportf is a wrapper around *analysis.Pass.Reportf that respects nolint directives and any other
 // functionality provided by the functional options when Pass was formed with its factory function.
 func (p *Pass) Reportf(pos token.Pos, format string, args ...interface{}) {
-	for i := range p.noLints {
-		if p.noLints[i].Matches(p.Pass.Fset.PositionFor(pos, false)) {
-			return
+	p.Report(analysis.Diagnostic{
+		Pos:     pos,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Report is a wrapper around *analysis.Pass.Report that respects nolint directives the same
+// way Reportf does, for diagnostics that carry more than a message, e.g. suggested fixes.
+func (p *Pass) Report(d analysis.Diagnostic) {
+	position := p.Pass.Fset.PositionFor(d.Pos, false)
+
+	// Issues are never reported in ignored paths, this also covers positions in files that
+	// linters don't check with common.SkipFile, e.g. non-Go files.
+	if common.IsIgnoredPath(position.Filename) {
+		return
+	}
+
+	// Issues outside of the files being linted, e.g. only the files changed on a branch,
+	// aren't reported either.
+	if !common.InScope(position.Filename) {
+		return
+	}
+
+	for _, noLints := range [][]noLint{p.noLints, p.ruleNoLints[d.Category]} {
+		for i := range noLints {
+			if noLints[i].Matches(position) {
+				stats.recordSuppressed(p.linter)
+				return
+			}
 		}
 	}
 
-	if p.warn {
-		fmt.Printf("%s: %s (%s) [WARNING]", p.Fset.PositionFor(pos, false).String(), fmt.Sprintf(format, args...), p.linter)
+	if suppressed(p.linter, d.Category, position) {
+		stats.recordSuppressed(p.linter)
+		return
+	}
+
+	// Identical diagnostics at the same position are only ever emitted once per run.
+	if !emitted.Load().firstOccurrence(p.linter, position, d.Message) {
 		return
 	}
-	p.Pass.Reportf(pos, format+" (%s)", append(args, p.linter)...)
+	warn := (p.warn || IsRuleWarning(p.linter, d.Category)) && !IsRuleError(p.linter, d.Category) &&
+		!IsEscalated(p.linter, position.Filename)
+	l := levelOf(position.Filename, warn)
+	stats.recordReported(p.linter, d.Category, position.Filename, l)
+
+	if rawReports.Load() {
+		p.Pass.Report(d)
+		return
+	}
+
+	if l != levelError {
+		printNonError(position, l, annotate(p.linter, d.Category, d.Message))
+		return
+	}
+
+	d.Message = annotate(p.linter, d.Category, d.Message)
+	p.Pass.Report(d)
+}
+
+// Record records an issue reported by the given linter at a position that doesn't belong to
+// any analysis.Pass, e.g. in a non-Go file, the same way Pass.Report does. It returns the
+// message annotated with the linter and whether or not the issue should be emitted, which
+// it shouldn't if it is in an ignored path, is out of scope, is suppressed, or has already
+// been emitted during this run. Issues of linters whose issues are warnings, see SetWarnings, and
+// issues in third-party code are written out right away and not emitted, unless reports are
+// raw, see SetRawReports, in which case the message is returned as it is.
+func Record(linter string, position token.Position, message string) (string, bool) {
+	if common.IsIgnoredPath(position.Filename) || !common.InScope(position.Filename) {
+		return "", false
+	}
+
+	if suppressed(linter, "", position) {
+		stats.recordSuppressed(linter)
+		return "", false
+	}
+
+	if !emitted.Load().firstOccurrence(linter, position, message) {
+		return "", false
+	}
+
+	l := levelOf(position.Filename, IsWarning(linter) && !IsEscalated(linter, position.Filename))
+	stats.recordReported(linter, "", position.Filename, l)
+
+	if rawReports.Load() {
+		return message, true
+	}
+
+	if l != levelError {
+		printNonError(position, l, annotate(linter, "", message))
+		return "", false
+	}
+
+	return annotate(linter, "", message), true
+}
+
+// levelOf returns the level of an issue in the file with the given name, which is a
+// warning if warn is true. Issues in third-party code are always reported at info
+// severity, see common.SetThirdPartyPaths.
+func levelOf(filename string, warn bool) level {
+	switch {
+	case common.IsThirdPartyPath(filename):
+		return levelInfo
+	case warn:
+		return levelWarning
+	default:
+		return levelError
+	}
+}
+
+// printNonError prints the given annotated message of an issue at the given position and
+// level, other than levelError, to stdout, so that it doesn't fail the run.
+func printNonError(position token.Position, l level, message string) {
+	label := "WARNING"
+	if l == levelInfo {
+		label = "INFO"
+	}
+
+	fmt.Printf("%s: %s [%s]\n", position.String(), message, label)
 }
@@ -0,0 +1,234 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package reporter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+func TestDedupeFirstOccurrence(t *testing.T) {
+	d := NewDedupe()
+	position := token.Position{Filename: "foo.go", Line: 10, Column: 2}
+
+	tt := []struct {
+		name     string
+		linter   string
+		position token.Position
+		message  string
+		expected bool
+	}{
+		{
+			name:     "Emits the first occurrence",
+			linter:   "doculint",
+			position: position,
+			message:  "function \"foo\" has no comment associated with it",
+			expected: true,
+		},
+		{
+			name:     "Drops an identical diagnostic",
+			linter:   "doculint",
+			position: position,
+			message:  "function \"foo\" has no comment associated with it",
+			expected: false,
+		},
+		{
+			name:     "Emits the same message from a different linter",
+			linter:   "todo",
+			position: position,
+			message:  "function \"foo\" has no comment associated with it",
+			expected: true,
+		},
+		{
+			name:     "Emits the same message at a different position",
+			linter:   "doculint",
+			position: token.Position{Filename: "foo.go", Line: 11, Column: 2},
+			message:  "function \"foo\" has no comment associated with it",
+			expected: true,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, d.firstOccurrence(test.linter, test.position, test.message), test.expected)
+		})
+	}
+
+	// Without a Dedupe, nothing is dropped.
+	var none *Dedupe
+	assert.Equal(t, none.firstOccurrence("doculint", position, "function \"foo\" has no comment associated with it"), true)
+}
+
+func TestAnnotate(t *testing.T) {
+	defer SetDocsBaseURL(DefaultDocsBaseURL)
+
+	tt := []struct {
+		name     string
+		base     string
+		linter   string
+		rule     string
+		expected string
+	}{
+		{
+			name:     "Appends the linter and documentation URL",
+			base:     "https://wiki.example.com/lint/",
+			linter:   "todo",
+			expected: "bad thing (todo, see https://wiki.example.com/lint/todo.md)",
+		},
+		{
+			name:     "Links documented rules to the rules of the linter",
+			base:     DefaultDocsBaseURL,
+			linter:   "doculint",
+			rule:     "spelling",
+			expected: "bad thing (doculint, see " + DefaultDocsBaseURL + "/doculint.md#rules)",
+		},
+		{
+			name:     "Links undocumented rules to the page of the linter",
+			base:     DefaultDocsBaseURL,
+			linter:   "doculint",
+			rule:     "unknown",
+			expected: "bad thing (doculint, see " + DefaultDocsBaseURL + "/doculint.md)",
+		},
+		{
+			name:     "Appends only the linter when it isn't documented",
+			base:     DefaultDocsBaseURL,
+			linter:   "custom",
+			expected: "bad thing (custom)",
+		},
+		{
+			name:     "Appends only the linter when documentation links are disabled",
+			base:     "",
+			linter:   "todo",
+			expected: "bad thing (todo)",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			SetDocsBaseURL(test.base)
+			assert.Equal(t, annotate(test.linter, test.rule, "bad thing"), test.expected)
+		})
+	}
+}
+
+func TestIsRuleWarning(t *testing.T) {
+	SetRuleWarnings("commentrules", []string{"safety"})
+	defer SetRuleWarnings("commentrules", nil)
+
+	assert.Equal(t, IsRuleWarning("commentrules", "safety"), true)
+	assert.Equal(t, IsRuleWarning("commentrules", "ticket"), false)
+	assert.Equal(t, IsRuleWarning("commentrules", ""), false)
+	assert.Equal(t, IsRuleWarning("doculint", "safety"), false)
+
+	SetWarningRules([]string{"doculint/spelling"})
+	defer SetWarningRules(nil)
+
+	assert.Equal(t, IsRuleWarning("doculint", "spelling"), true)
+	assert.Equal(t, IsRuleWarning("doculint", "safety"), false)
+	assert.Equal(t, IsRuleWarning("commentrules", "spelling"), false)
+}
+
+func TestIsRuleError(t *testing.T) {
+	SetErrorRules([]string{"errorlint/wrap-message"})
+	defer SetErrorRules(nil)
+
+	assert.Equal(t, IsRuleError("errorlint", "wrap-message"), true)
+	assert.Equal(t, IsRuleError("errorlint", "span-name"), false)
+	assert.Equal(t, IsRuleError("errorlint", ""), false)
+}
+
+func TestLevelOf(t *testing.T) {
+	common.SetThirdPartyPaths([]string{"internal/copied/**"})
+	defer common.SetThirdPartyPaths(nil)
+
+	assert.Equal(t, levelOf("internal/foo/foo.go", false), levelError)
+	assert.Equal(t, levelOf("internal/foo/foo.go", true), levelWarning)
+	assert.Equal(t, levelOf("internal/copied/lru/lru.go", false), levelInfo)
+	assert.Equal(t, levelOf("internal/copied/lru/lru.go", true), levelInfo)
+}
+
+func TestSummaryWrite(t *testing.T) {
+	s := Summary{
+		Linters: []LinterSummary{
+			{Linter: "doculint", Errors: 2, Warnings: 1, Suppressed: 3},
+			{Linter: "license", Info: 4},
+		},
+	}
+
+	var buf strings.Builder
+	assert.NilError(t, s.Write(&buf))
+	assert.Equal(t, buf.String(), "LINTER    ERRORS  WARNINGS  INFO  SUPPRESSED\n"+
+		"doculint  2       1         0     3\n"+
+		"license   0       0         4     0\n"+
+		"total     2       1         4     3\n")
+}
+
+func TestSummarizeOwners(t *testing.T) {
+	files := map[string]int{"api/foo.go": 3, "api/web/bar.go": 2, "baz.go": 1}
+
+	assert.Assert(t, summarizeOwners(files) == nil)
+
+	SetOwners(func(filename string) []string {
+		switch {
+		case strings.HasPrefix(filename, "api/web/"):
+			return []string{"@org/api", "@org/web"}
+		case strings.HasPrefix(filename, "api/"):
+			return []string{"@org/api"}
+		}
+		return nil
+	})
+	defer SetOwners(nil)
+
+	// Issues in files with several owners count towards each of them.
+	assert.DeepEqual(t, summarizeOwners(files), []OwnerSummary{
+		{Owner: "@org/api", Issues: 5},
+		{Owner: "@org/web", Issues: 2},
+		{Owner: Unowned, Issues: 1},
+	})
+}
+
+func TestForEachFile(t *testing.T) {
+	fset := token.NewFileSet()
+
+	var files []*ast.File
+	for i := 0; i < 20; i++ {
+		file, err := parser.ParseFile(fset, fmt.Sprintf("foo%d.go", i), "package foo\n", 0)
+		assert.NilError(t, err)
+		files = append(files, file)
+	}
+
+	for _, workers := range []int{0, 1, 4} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			SetFileWorkers(workers)
+			defer SetFileWorkers(0)
+
+			var reported []string
+			pass := NewPass("todo", &analysis.Pass{
+				Fset:  fset,
+				Files: files,
+				Report: func(d analysis.Diagnostic) {
+					reported = append(reported, fset.Position(d.Pos).Filename)
+				},
+			})
+
+			pass.ForEachFile(files, func(r *Buffer, file *ast.File) {
+				r.Reportf(file.Name.Pos(), "file %s", t.Name())
+			})
+
+			// Issues are reported in the order of the files, however the files are scheduled.
+			var expected []string
+			for i := range files {
+				expected = append(expected, fmt.Sprintf("foo%d.go", i))
+			}
+			assert.DeepEqual(t, reported, expected)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the statistics gathered from every Pass during a
+// run and the end-of-run summary built from them.
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+)
+
+// statistics keeps track of what happened to every issue reported through Pass during
+// the current run.
+type statistics struct {
+	mu sync.Mutex
+
+	// linters maps linter names to the statistics gathered for them.
+	linters map[string]*LinterSummary
+
+	// files maps filenames to the number of issues reported (and not suppressed) in them.
+	files map[string]int
+}
+
+// stats is the process-wide record of statistics gathered from every Pass.
+var stats = statistics{
+	linters: make(map[string]*LinterSummary),
+	files:   make(map[string]int),
+}
+
+// linter returns the summary for the given linter, creating it if it doesn't exist
+// yet. The caller must hold s.mu.
+func (s *statistics) linter(name string) *LinterSummary {
+	ls, ok := s.linters[name]
+	if !ok {
+		ls = &LinterSummary{Linter: name}
+		s.linters[name] = ls
+	}
+	return ls
+}
+
+// recordSuppressed records that an issue reported by the given linter was suppressed
+// by a nolint directive.
+func (s *statistics) recordSuppressed(linter string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.linter(linter).Suppressed++
+}
+
+// level is the severity an issue is reported at.
+type level int
+
+// Levels an issue can be reported at.
+const (
+	// levelError denotes an issue that fails the run.
+	levelError level = iota
+
+	// levelWarning denotes an issue that is reported but doesn't fail the run.
+	levelWarning
+
+	// levelInfo denotes an issue in third-party code, see common.SetThirdPartyPaths, that is
+	// reported but doesn't fail the run, regardless of its linter.
+	levelInfo
+)
+
+// recordReported records that an issue was reported by the given linter, under the given
+// rule if the linter has rules, in the given file, at the given level.
+func (s *statistics) recordReported(linter, rule, filename string, l level) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ls := s.linter(linter)
+	switch l {
+	case levelError:
+		ls.Errors++
+	case levelWarning:
+		ls.Warnings++
+	case levelInfo:
+		ls.Info++
+	}
+
+	if rule != "" {
+		if ls.Rules == nil {
+			ls.Rules = make(map[string]int)
+		}
+		ls.Rules[rule]++
+	}
+
+	if filename != "" {
+		s.files[filename]++
+	}
+}
+
+// LinterSummary contains the statistics gathered for a single linter during a run.
+type LinterSummary struct {
+	// Linter is the name of the linter these statistics belong to.
+	Linter string `json:"linter"`
+
+	// Errors is the number of issues reported as errors.
+	Errors int `json:"errors"`
+
+	// Warnings is the number of issues downgraded to warnings.
+	Warnings int `json:"warnings"`
+
+	// Info is the number of issues in third-party code, reported at info severity.
+	Info int `json:"info"`
+
+	// Suppressed is the number of issues suppressed by nolint directives.
+	Suppressed int `json:"suppressed"`
+
+	// Rules maps the rules of the linter, for linters made up of several rules, to the
+	// number of issues, of every severity, reported under them.
+	Rules map[string]int `json:"rules,omitempty"`
+}
+
+// FileSummary contains the number of issues reported in a single file during a run.
+type FileSummary struct {
+	// Filename is the path of the file, rewritten as configured with SetReportPaths.
+	Filename string `json:"filename"`
+
+	// Issues is the number of issues, of every severity, reported in the file.
+	Issues int `json:"issues"`
+}
+
+// Unowned is the owner the issues in files without owners are grouped under, see
+// SetOwners.
+const Unowned = "(unowned)"
+
+// owners is the process-wide lookup of the owners of files, see SetOwners.
+var owners = struct {
+	mu     sync.RWMutex
+	lookup func(filename string) []string
+}{}
+
+// SetOwners sets the function that returns the owning teams of the file with the given
+// name, e.g. according to a CODEOWNERS file, by which the summary groups the issues
+// reported. Passing nil leaves issues ungrouped.
+func SetOwners(lookup func(filename string) []string) {
+	owners.mu.Lock()
+	defer owners.mu.Unlock()
+
+	owners.lookup = lookup
+}
+
+// OwnerSummary contains the number of issues reported in the files owned by a single team
+// during a run.
+type OwnerSummary struct {
+	// Owner is the owning team, e.g. "@org/team", or Unowned.
+	Owner string `json:"owner"`
+
+	// Issues is the number of issues, of every severity, reported in the files the team
+	// owns. Issues in files with several owners count towards each of them.
+	Issues int `json:"issues"`
+}
+
+// Summary is a snapshot of the statistics gathered from every Pass during a run.
+type Summary struct {
+	// Linters contains the statistics for each linter that reported at least one issue,
+	// sorted by linter name.
+	Linters []LinterSummary `json:"linters"`
+
+	// Files contains the files with the most issues, sorted by descending issue count.
+	Files []FileSummary `json:"files"`
+
+	// Owners contains the issues grouped by the owners of their files, sorted by
+	// descending issue count, if owners are set, see SetOwners.
+	Owners []OwnerSummary `json:"owners,omitempty"`
+}
+
+// Summarize returns a snapshot of the statistics gathered so far during this run. At
+// most maxFiles files are included in Summary.Files.
+func Summarize(maxFiles int) Summary {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	s := Summary{Linters: []LinterSummary{}, Files: []FileSummary{}}
+	for _, ls := range stats.linters {
+		summary := *ls
+		if ls.Rules != nil {
+			summary.Rules = make(map[string]int, len(ls.Rules))
+			for rule, n := range ls.Rules {
+				summary.Rules[rule] = n
+			}
+		}
+		s.Linters = append(s.Linters, summary)
+	}
+	sort.Slice(s.Linters, func(i, j int) bool {
+		return s.Linters[i].Linter < s.Linters[j].Linter
+	})
+
+	s.Owners = summarizeOwners(stats.files)
+
+	for filename, issues := range stats.files {
+		filename, _ = ReportPath(filename)
+		s.Files = append(s.Files, FileSummary{Filename: filename, Issues: issues})
+	}
+	sort.Slice(s.Files, func(i, j int) bool {
+		if s.Files[i].Issues != s.Files[j].Issues {
+			return s.Files[i].Issues > s.Files[j].Issues
+		}
+		return s.Files[i].Filename < s.Files[j].Filename
+	})
+	if len(s.Files) > maxFiles {
+		s.Files = s.Files[:maxFiles]
+	}
+
+	return s
+}
+
+// summarizeOwners returns the issues reported in the given files, by filename, grouped by
+// the owners of the files, or nil if owners aren't set, see SetOwners.
+func summarizeOwners(files map[string]int) []OwnerSummary {
+	owners.mu.RLock()
+	defer owners.mu.RUnlock()
+
+	if owners.lookup == nil {
+		return nil
+	}
+
+	issues := make(map[string]int)
+	for filename, n := range files {
+		fileOwners := owners.lookup(filename)
+		if len(fileOwners) == 0 {
+			fileOwners = []string{Unowned}
+		}
+
+		for _, owner := range fileOwners {
+			issues[owner] += n
+		}
+	}
+
+	summaries := make([]OwnerSummary, 0, len(issues))
+	for owner, n := range issues {
+		summaries = append(summaries, OwnerSummary{Owner: owner, Issues: n})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Issues != summaries[j].Issues {
+			return summaries[i].Issues > summaries[j].Issues
+		}
+		return summaries[i].Owner < summaries[j].Owner
+	})
+
+	return summaries
+}
+
+// Write writes the summary in a human readable form to w.
+func (s *Summary) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "LINTER\tERRORS\tWARNINGS\tINFO\tSUPPRESSED")
+
+	var total LinterSummary
+	for i := range s.Linters {
+		ls := &s.Linters[i]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", ls.Linter, ls.Errors, ls.Warnings, ls.Info, ls.Suppressed)
+
+		total.Errors += ls.Errors
+		total.Warnings += ls.Warnings
+		total.Info += ls.Info
+		total.Suppressed += ls.Suppressed
+	}
+	fmt.Fprintf(tw, "total\t%d\t%d\t%d\t%d\n", total.Errors, total.Warnings, total.Info, total.Suppressed)
+
+	if len(s.Files) > 0 {
+		fmt.Fprintln(tw, "\nFILE\tISSUES")
+		for i := range s.Files {
+			fmt.Fprintf(tw, "%s\t%d\n", s.Files[i].Filename, s.Files[i].Issues)
+		}
+	}
+
+	if len(s.Owners) > 0 {
+		fmt.Fprintln(tw, "\nOWNER\tISSUES")
+		for i := range s.Owners {
+			fmt.Fprintf(tw, "%s\t%d\n", s.Owners[i].Owner, s.Owners[i].Issues)
+		}
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements suppressions kept outside of the source code, which
+// are honored alongside nolint directives.
+
+package reporter
+
+import (
+	"go/token"
+	"sync"
+
+	"github.com/getoutreach/lintroller/internal/common"
+)
+
+// Suppression suppresses the issues of a linter, or of a single rule of it, in the files
+// matching a path glob, like a nolint directive would. Suppressions are for the files in
+// which a directive is awkward, e.g. generated files without a generated header or
+// vendored snippets.
+type Suppression struct {
+	// File is the path glob, relative to the working directory, of the files whose issues
+	// are suppressed, see common.MatchGlob.
+	File string
+
+	// Line is the line whose issues are suppressed, or zero to suppress the issues on
+	// every line.
+	Line int
+
+	// Rule is either the name of the linter whose issues are suppressed, e.g. "doculint",
+	// or the name of a linter followed by a slash and one of its rules, e.g.
+	// "doculint/spelling".
+	Rule string
+}
+
+// suppressions is the process-wide list of suppressions, see SetSuppressions.
+var suppressions = struct {
+	mu   sync.RWMutex
+	list []Suppression
+}{}
+
+// SetSuppressions sets the suppressions honored alongside nolint directives.
+func SetSuppressions(list []Suppression) {
+	suppressions.mu.Lock()
+	defer suppressions.mu.Unlock()
+
+	suppressions.list = append([]Suppression(nil), list...)
+}
+
+// suppressed returns true if an issue reported by the given linter, for the given rule if
+// it has rules, at the given position is suppressed by any of the suppressions.
+func suppressed(linter, rule string, position token.Position) bool {
+	suppressions.mu.RLock()
+	defer suppressions.mu.RUnlock()
+
+	if len(suppressions.list) == 0 {
+		return false
+	}
+
+	filename := common.RelativePath(position.Filename)
+	for i := range suppressions.list {
+		s := &suppressions.list[i]
+
+		if s.Rule != linter && (rule == "" || s.Rule != linter+"/"+rule) {
+			continue
+		}
+
+		if s.Line != 0 && s.Line != position.Line {
+			continue
+		}
+
+		if common.MatchGlob(s.File, filename) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package reporter
+
+import (
+	"go/token"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSuppressed(t *testing.T) {
+	SetSuppressions([]Suppression{
+		{File: "internal/gen/**", Rule: "doculint"},
+		{File: "internal/foo/foo.go", Line: 12, Rule: "doculint/spelling"},
+	})
+	t.Cleanup(func() { SetSuppressions(nil) })
+
+	tt := []struct {
+		name     string
+		linter   string
+		rule     string
+		position token.Position
+		expected bool
+	}{
+		{
+			name:     "Suppresses every issue of a linter in matching files",
+			linter:   "doculint",
+			rule:     "missing-comment",
+			position: token.Position{Filename: "internal/gen/api/api.go", Line: 3},
+			expected: true,
+		},
+		{
+			name:     "Keeps the issues of other linters",
+			linter:   "todo",
+			position: token.Position{Filename: "internal/gen/api/api.go", Line: 3},
+			expected: false,
+		},
+		{
+			name:     "Suppresses a rule on a line",
+			linter:   "doculint",
+			rule:     "spelling",
+			position: token.Position{Filename: "internal/foo/foo.go", Line: 12},
+			expected: true,
+		},
+		{
+			name:     "Keeps the issues of the rule on other lines",
+			linter:   "doculint",
+			rule:     "spelling",
+			position: token.Position{Filename: "internal/foo/foo.go", Line: 13},
+			expected: false,
+		},
+		{
+			name:     "Keeps the issues of other rules",
+			linter:   "doculint",
+			rule:     "line-width",
+			position: token.Position{Filename: "internal/foo/foo.go", Line: 12},
+			expected: false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, suppressed(test.linter, test.rule, test.position), test.expected)
+		})
+	}
+}
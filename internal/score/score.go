@@ -0,0 +1,187 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package score implements the quality score of packages and of the repository as a whole,
+// a single number from 0 to 100 that can be trended over time rather than raw counts of
+// findings. Every finding costs the weight of the rule that reported it, and the score of
+// a package is its line count relative to its line count plus the cost of its findings
+// per LinesPerFinding lines: a package without findings scores 100, and one with findings
+// costing one per 100 lines scores 50.
+package score
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"text/tabwriter"
+)
+
+// DefaultWeight is the weight of rules without a weight of their own.
+const DefaultWeight = 1.0
+
+// LinesPerFinding is the number of lines a finding costing one halves the score of.
+const LinesPerFinding = 100
+
+// Finding is a single issue reported within a package.
+type Finding struct {
+	// Linter is the name of the linter that reported the issue.
+	Linter string
+
+	// Rule is the rule of the linter that reported the issue, if it has rules.
+	Rule string
+}
+
+// Scorer gathers the findings of every package during a run and scores them. It is safe
+// for concurrent use.
+type Scorer struct {
+	mu sync.Mutex
+
+	// weights maps rule IDs, e.g. "doculint/spelling", and linter names to their weights.
+	weights map[string]float64
+
+	// defaultWeight is the weight of rules without a weight in weights.
+	defaultWeight float64
+
+	// packages contains the score of every package added so far.
+	packages []Package
+}
+
+// NewScorer returns a Scorer weighing findings with the given weights, keyed by rule ID,
+// e.g. "doculint/spelling", or by linter name for every rule of the linter. Findings of
+// rules without a weight cost the given default weight, or DefaultWeight if it is zero.
+func NewScorer(weights map[string]float64, defaultWeight float64) *Scorer {
+	if defaultWeight == 0 {
+		defaultWeight = DefaultWeight
+	}
+
+	return &Scorer{
+		weights:       weights,
+		defaultWeight: defaultWeight,
+	}
+}
+
+// weight returns the weight of the rule that reported the given finding.
+func (s *Scorer) weight(f *Finding) float64 {
+	if f.Rule != "" {
+		if w, ok := s.weights[f.Linter+"/"+f.Rule]; ok {
+			return w
+		}
+	}
+
+	if w, ok := s.weights[f.Linter]; ok {
+		return w
+	}
+
+	return s.defaultWeight
+}
+
+// Add scores the package with the given import path, made up of the given number of lines,
+// with the given findings reported within it.
+func (s *Scorer) Add(pkg string, lines int, findings []Finding) {
+	var cost float64
+	for i := range findings {
+		cost += s.weight(&findings[i])
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.packages = append(s.packages, Package{
+		Package:  pkg,
+		Lines:    lines,
+		Findings: len(findings),
+		Cost:     round(cost),
+		Score:    Score(lines, cost),
+	})
+}
+
+// Report returns the scores of every package added so far and of the repository as a
+// whole, which is scored as if it was a single package.
+func (s *Scorer) Report() *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := Report{
+		Packages: append([]Package{}, s.packages...),
+	}
+
+	var lines int
+	var cost float64
+	for i := range r.Packages {
+		lines += r.Packages[i].Lines
+		cost += r.Packages[i].Cost
+	}
+	r.Score = Score(lines, cost)
+
+	// Packages are listed worst first, since those are the ones worth looking at.
+	sort.Slice(r.Packages, func(i, j int) bool {
+		if r.Packages[i].Score != r.Packages[j].Score {
+			return r.Packages[i].Score < r.Packages[j].Score
+		}
+		return r.Packages[i].Package < r.Packages[j].Package
+	})
+
+	return &r
+}
+
+// Score returns the score, from 0 to 100 rounded to one decimal, of code made up of the
+// given number of lines whose findings cost the given total weight.
+func Score(lines int, cost float64) float64 {
+	if cost <= 0 {
+		return 100
+	}
+
+	return round(100 * float64(lines) / (float64(lines) + LinesPerFinding*cost))
+}
+
+// round rounds the given number to one decimal.
+func round(f float64) float64 {
+	return math.Round(f*10) / 10
+}
+
+// Package is the score of a single package.
+type Package struct {
+	// Package is the import path of the package.
+	Package string `json:"package"`
+
+	// Lines is the number of lines of the package.
+	Lines int `json:"lines"`
+
+	// Findings is the number of issues reported within the package.
+	Findings int `json:"findings"`
+
+	// Cost is the total weight of the issues reported within the package.
+	Cost float64 `json:"cost"`
+
+	// Score is the score of the package, from 0 to 100.
+	Score float64 `json:"score"`
+}
+
+// Report contains the scores of a run.
+type Report struct {
+	// Score is the score of the repository as a whole, from 0 to 100.
+	Score float64 `json:"score"`
+
+	// Packages contains the score of every package, sorted by ascending score.
+	Packages []Package `json:"packages"`
+}
+
+// Write writes the report in a human readable form to w, listing at most maxPackages
+// packages.
+func (r *Report) Write(w io.Writer, maxPackages int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "PACKAGE\tSCORE\tFINDINGS\tLINES")
+	for i := range r.Packages {
+		if i == maxPackages {
+			break
+		}
+		fmt.Fprintf(tw, "%s\t%.1f\t%d\t%d\n", r.Packages[i].Package, r.Packages[i].Score, r.Packages[i].Findings, r.Packages[i].Lines)
+	}
+	fmt.Fprintf(tw, "repository\t%.1f\n", r.Score)
+
+	return tw.Flush()
+}
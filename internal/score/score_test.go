@@ -0,0 +1,49 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package score
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestScore(t *testing.T) {
+	assert.Equal(t, Score(100, 0), 100.0)
+	assert.Equal(t, Score(100, 1), 50.0)
+	assert.Equal(t, Score(300, 1), 75.0)
+	assert.Equal(t, Score(0, 2), 0.0)
+}
+
+func TestScorer(t *testing.T) {
+	s := NewScorer(map[string]float64{
+		"doculint":          0.5,
+		"doculint/spelling": 0.1,
+		"todo":              0,
+	}, 2)
+
+	s.Add("example.com/mod/b", 400, []Finding{
+		{Linter: "doculint", Rule: "spelling"},
+		{Linter: "doculint", Rule: "funlen"},
+		{Linter: "todo"},
+		{Linter: "why"},
+	})
+	s.Add("example.com/mod/a", 200, nil)
+
+	r := s.Report()
+	assert.DeepEqual(t, r, &Report{
+		Score: 69.8,
+		Packages: []Package{
+			{Package: "example.com/mod/b", Lines: 400, Findings: 4, Cost: 2.6, Score: 60.6},
+			{Package: "example.com/mod/a", Lines: 200, Score: 100},
+		},
+	})
+
+	var buf bytes.Buffer
+	assert.NilError(t, r.Write(&buf, 1))
+	assert.Equal(t, buf.String(), `PACKAGE            SCORE  FINDINGS  LINES
+example.com/mod/b  60.6   4         400
+repository         69.8
+`)
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package signature contains the necessary logic for the signature linter. The signature
+// linter ensures that function signatures stay small, so that functions taking or
+// returning many values group them in a struct instead.
+package signature
+
+import (
+	"go/ast"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the signature linter.
+const name = "signature"
+
+// doc defines the help text for the signature linter.
+const doc = `Ensures that functions and methods have at most -maxParams parameters and
+-maxResults results. Functions taking more should take an options struct instead, and
+functions returning more should return a struct.
+
+When -exportedOnly is set, only exported functions and methods are checked. When
+-exemptConstructors is set, constructors, functions whose name starts with New or new,
+e.g. NewClient, are not checked.`
+
+// Default limits used when none are given.
+const (
+	// DefaultMaxParams is the maximum number of parameters of a function.
+	DefaultMaxParams = 5
+
+	// DefaultMaxResults is the maximum number of results of a function.
+	DefaultMaxResults = 3
+)
+
+// Analyzer exports the signature analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      signature,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_maxParams, _maxResults int, _exportedOnly, _exemptConstructors bool) *analysis.Analyzer {
+	maxParams = _maxParams
+	maxResults = _maxResults
+	exportedOnly = _exportedOnly
+	exemptConstructors = _exemptConstructors
+	return &Analyzer
+}
+
+// Variables that get collected via flags.
+var (
+	// maxParams is the maximum number of parameters of a function.
+	maxParams int
+
+	// maxResults is the maximum number of results of a function.
+	maxResults int
+
+	// exportedOnly denotes whether or not only exported functions are checked.
+	exportedOnly bool
+
+	// exemptConstructors denotes whether or not constructors are exempt.
+	exemptConstructors bool
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.IntVar(&maxParams, "maxParams", DefaultMaxParams, "the maximum number of parameters of a function")
+	Analyzer.Flags.IntVar(&maxResults, "maxResults", DefaultMaxResults, "the maximum number of results of a function")
+	Analyzer.Flags.BoolVar(&exportedOnly, "exportedOnly", false,
+		"a boolean flag that denotes whether or not only exported functions and methods are checked")
+	Analyzer.Flags.BoolVar(&exemptConstructors, "exemptConstructors", false,
+		"a boolean flag that denotes whether or not constructors, functions whose name starts with New, are exempt")
+}
+
+// limits are the options of a single run of the signature linter.
+type limits struct {
+	maxParams          int
+	maxResults         int
+	exportedOnly       bool
+	exemptConstructors bool
+}
+
+// signature is the function that gets passed to the Analyzer which runs the actual
+// analysis for the signature linter on a set of files.
+func signature(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages.
+	if common.SkipPackage(_pass) {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	l := limits{
+		maxParams:          maxParams,
+		maxResults:         maxResults,
+		exportedOnly:       exportedOnly,
+		exemptConstructors: exemptConstructors,
+	}
+	if l.maxParams <= 0 {
+		l.maxParams = DefaultMaxParams
+	}
+	if l.maxResults <= 0 {
+		l.maxResults = DefaultMaxResults
+	}
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, file, &l)
+	}
+
+	return nil, nil
+}
+
+// checkFile reports every function and method declared within the given file with more
+// parameters or results than the given limits allow.
+func checkFile(r reporter.Reporter, file *ast.File, l *limits) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		if l.exportedOnly && !fn.Name.IsExported() {
+			continue
+		}
+
+		if l.exemptConstructors && fn.Recv == nil && isConstructor(fn.Name.Name) {
+			continue
+		}
+
+		fnName := fn.Name.Name
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			fnName = receiverType(fn.Recv.List[0].Type) + "." + fnName
+		}
+
+		if n := count(fn.Type.Params); n > l.maxParams {
+			r.Reportf(fn.Name.Pos(), "function %s has %d parameters, more than the maximum of %d, "+
+				"take an options struct instead", fnName, n, l.maxParams)
+		}
+
+		if n := count(fn.Type.Results); n > l.maxResults {
+			r.Reportf(fn.Name.Pos(), "function %s has %d results, more than the maximum of %d, "+
+				"return a struct instead", fnName, n, l.maxResults)
+		}
+	}
+}
+
+// count returns the number of parameters or results in the given field list, counting
+// every name of fields that declare several at once, e.g. "a, b int".
+func count(fields *ast.FieldList) int {
+	if fields == nil {
+		return 0
+	}
+
+	var n int
+	for _, field := range fields.List {
+		if len(field.Names) == 0 {
+			n++
+			continue
+		}
+		n += len(field.Names)
+	}
+
+	return n
+}
+
+// isConstructor reports whether or not the function of the given name is a constructor,
+// which is to say its name is New or new, optionally followed by what it constructs, e.g.
+// NewClient or newStore.
+func isConstructor(fnName string) bool {
+	for _, prefix := range []string{"New", "new"} {
+		if !strings.HasPrefix(fnName, prefix) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(fnName, prefix)
+		if r, _ := utf8.DecodeRuneInString(rest); rest == "" || unicode.IsUpper(r) || r == '_' {
+			return true
+		}
+	}
+
+	return false
+}
+
+// receiverType returns the name of the type of the given receiver, which is either a named
+// type or a pointer to one, with type parameters if it is generic.
+func receiverType(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
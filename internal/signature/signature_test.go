@@ -0,0 +1,80 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package signature
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "signature")
+}
+
+func TestCheckFile(t *testing.T) {
+	src := `package foo
+
+func small(a, b int) (int, error) { return 0, nil }
+
+func wide(a, b, c int, d string) {}
+
+func Many() (a, b, c int, err error) { return }
+
+type Client struct{}
+
+func (c *Client) Wide(a, b, c int, d ...string) {}
+
+func NewClient(a, b, c, d int) *Client { return nil }
+
+func Newsletter(a, b, c, d int) {}
+`
+
+	tt := []struct {
+		name     string
+		limits   limits
+		expected []string
+	}{
+		{
+			name:   "Reports wide signatures",
+			limits: limits{maxParams: 3, maxResults: 3},
+			expected: []string{
+				"function wide has 4 parameters, more than the maximum of 3, take an options struct instead",
+				"function Many has 4 results, more than the maximum of 3, return a struct instead",
+				"function Client.Wide has 4 parameters, more than the maximum of 3, take an options struct instead",
+				"function NewClient has 4 parameters, more than the maximum of 3, take an options struct instead",
+				"function Newsletter has 4 parameters, more than the maximum of 3, take an options struct instead",
+			},
+		},
+		{
+			name:   "Only reports exported functions",
+			limits: limits{maxParams: 3, maxResults: 3, exportedOnly: true, exemptConstructors: true},
+			expected: []string{
+				"function Many has 4 results, more than the maximum of 3, return a struct instead",
+				"function Client.Wide has 4 parameters, more than the maximum of 3, take an options struct instead",
+				"function Newsletter has 4 parameters, more than the maximum of 3, take an options struct instead",
+			},
+		},
+		{
+			name:     "Allows signatures within the limits",
+			limits:   limits{maxParams: 4, maxResults: 4},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "foo.go", src, 0)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			checkFile(&r, file, &test.limits)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package signature
+
+func ignored(a, b, c, d, e, f int) {}
@@ -0,0 +1,6 @@
+// Package signature declares functions with too many parameters.
+package signature
+
+func wide(a, b, c, d, e, f int) {} // want `function wide has 6 parameters, more than the maximum of 5`
+
+func wider(a, b, c, d, e, f, g int) {} //nolint:signature // Why: suppressed issues aren't reported.
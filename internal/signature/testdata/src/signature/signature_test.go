@@ -0,0 +1,3 @@
+package signature_test
+
+func tested(a, b, c, d, e, f int) {}
@@ -0,0 +1,5 @@
+package main
+
+import "strings"
+
+var _ = strings.NewReader("")
@@ -0,0 +1,12 @@
+// Command thinmain imports packages main packages can not import.
+package main
+
+import (
+	"strings" // want `package main can not import strings`
+
+	"bytes" //nolint:thinmain // Why: suppressed issues aren't reported.
+)
+
+func main() {
+	_, _ = bytes.NewBuffer(nil), strings.NewReader("")
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package thinmain contains the necessary logic for the thinmain linter. The thinmain
+// linter ensures that main packages stay thin: they should only contain func main and
+// the wiring it needs, leaving the implementation to packages of their own.
+package thinmain
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// name defines the name of the thinmain linter.
+const name = "thinmain"
+
+// doc defines the help text for the thinmain linter.
+const doc = `Ensures that main packages stay thin: each file of a main package can have at most
+-maxLines lines, and main packages can not import packages whose import path matches one
+of the -deniedImports globs, e.g. "github.com/getoutreach/*/internal/store". Business
+logic should live in its own packages, called by func main.`
+
+// DefaultMaxLines is the maximum number of lines of a file in a main package when no
+// maximum is given.
+const DefaultMaxLines = 150
+
+// Analyzer exports the thinmain analyzer (linter).
+var Analyzer = analysis.Analyzer{
+	Name:     name,
+	Doc:      doc,
+	Run:      thinmain,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_maxLines int, deniedImports []string) *analysis.Analyzer {
+	maxLines = _maxLines
+	rawDeniedImports = strings.Join(deniedImports, ",")
+	return &Analyzer
+}
+
+// Variables that get collected via flags.
+var (
+	// maxLines is the maximum number of lines of a file in a main package.
+	maxLines int
+
+	// rawDeniedImports is a comma-separated list of the import path globs, see
+	// common.MatchGlob, of the packages main packages can not import.
+	rawDeniedImports string
+)
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.IntVar(&maxLines, "maxLines", DefaultMaxLines,
+		"the maximum number of lines of a file in a main package")
+	Analyzer.Flags.StringVar(&rawDeniedImports, "deniedImports", "",
+		"comma-separated list of import path globs of packages main packages can not import")
+}
+
+// thinmain is the function that gets passed to the Analyzer which runs the actual
+// analysis for the thinmain linter on a set of files.
+func thinmain(_pass *analysis.Pass) (interface{}, error) {
+	// Ignore test packages and every package other than main.
+	if common.SkipPackage(_pass) || _pass.Pkg.Name() != common.PackageMain {
+		return nil, nil
+	}
+
+	// Wrap _pass with reporter.Pass to take nolint directives into account.
+	pass := reporter.NewPass(name, _pass)
+
+	limit := maxLines
+	if limit <= 0 {
+		limit = DefaultMaxLines
+	}
+
+	for _, file := range pass.Files {
+		// Ignore generated files, test files, and files in ignored paths.
+		if common.SkipFile(pass.Pass, file) {
+			continue
+		}
+
+		checkFile(pass, pass.Fset, file, limit, strings.Split(rawDeniedImports, ","))
+	}
+
+	return nil, nil
+}
+
+// checkFile reports the given file of a main package if it has more than maxLines lines,
+// as well as each of its imports matching any of the deniedImports globs.
+func checkFile(r reporter.Reporter, fset *token.FileSet, file *ast.File, maxLines int, deniedImports []string) {
+	if tokFile := fset.File(file.Package); tokFile != nil && tokFile.LineCount() > maxLines {
+		r.Reportf(file.Package, "file of package main has %d lines, more than the maximum of %d, move the "+
+			"implementation to its own package and call it from func main", tokFile.LineCount(), maxLines)
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		if denied(path, deniedImports) {
+			r.Reportf(spec.Pos(), "package main can not import %s, move the logic that needs it to its own package "+
+				"and call it from func main", path)
+		}
+	}
+}
+
+// denied reports whether or not the given import path matches any of the given globs.
+func denied(path string, deniedImports []string) bool {
+	for _, pattern := range deniedImports {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" && common.MatchGlob(pattern, path) {
+			return true
+		}
+	}
+
+	return false
+}
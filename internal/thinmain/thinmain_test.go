@@ -0,0 +1,80 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package thinmain
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/linttest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzer(t *testing.T) {
+	a := NewAnalyzerWithOptions(DefaultMaxLines, []string{"bytes", "strings"})
+	t.Cleanup(func() { NewAnalyzerWithOptions(DefaultMaxLines, nil) })
+
+	linttest.Run(t, a, "thinmain")
+}
+
+func TestCheckFile(t *testing.T) {
+	tt := []struct {
+		name          string
+		src           string
+		maxLines      int
+		deniedImports []string
+		expected      []string
+	}{
+		{
+			name:     "Allows thin files",
+			src:      "package main\n\nimport \"example.com/mod/internal/server\"\n\nfunc main() { server.Run() }\n",
+			maxLines: 10,
+			expected: nil,
+		},
+		{
+			name:     "Reports long files",
+			src:      "package main\n\nfunc main() {\n" + strings.Repeat("\tprintln()\n", 8) + "}\n",
+			maxLines: 10,
+			expected: []string{
+				"file of package main has 12 lines, more than the maximum of 10, move the implementation to its own " +
+					"package and call it from func main",
+			},
+		},
+		{
+			name: "Reports denied imports",
+			src: `package main
+
+import (
+	"example.com/mod/internal/server"
+	"example.com/mod/internal/store"
+	_ "example.com/other/internal/store"
+)
+
+func main() {}
+`,
+			maxLines:      100,
+			deniedImports: []string{" example.com/*/internal/store", ""},
+			expected: []string{
+				"package main can not import example.com/mod/internal/store, move the logic that needs it to its own " +
+					"package and call it from func main",
+				"package main can not import example.com/other/internal/store, move the logic that needs it to its own " +
+					"package and call it from func main",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "main.go", test.src, 0)
+			assert.NilError(t, err)
+
+			var r linttest.Recorder
+			checkFile(&r, fset, file, test.maxLines, test.deniedImports)
+
+			assert.DeepEqual(t, r.Messages, test.expected)
+		})
+	}
+}
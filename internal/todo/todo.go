@@ -25,9 +25,10 @@ const doc = "Ensures that each TODO comment defined in the codebase conforms to
 
 // Analyzer exports the todo analyzer (linter).
 var Analyzer = analysis.Analyzer{
-	Name: name,
-	Doc:  doc,
-	Run:  todo,
+	Name:     name,
+	Doc:      doc,
+	Run:      todo,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
 }
 
 // reTodo is the regular expression that matches the required TODO format by this
@@ -39,29 +40,30 @@ var reTodo = regexp.MustCompile(`^TODO(\([\w-]+\))?(\[[a-zA-Z\d-]+\])?: .+$`)
 // analysis for the todo linter on a set of files.
 func todo(_pass *analysis.Pass) (interface{}, error) {
 	// Ignore test packages.
-	if common.IsTestPackage(_pass) {
+	if common.SkipPackage(_pass) {
 		return nil, nil
 	}
 
 	// Wrap _pass with reporter.Pass to take nolint directives into account.
 	pass := reporter.NewPass(name, _pass)
 
-	for _, file := range pass.Files {
-		// Ignore generated files and test files.
-		if common.IsGenerated(file) || common.IsTestFile(pass.Pass, file) {
-			continue
+	pass.ForEachFile(common.FilesWithExcluded(pass.Pass), func(r *reporter.Buffer, file *ast.File) {
+		// Ignore generated files, test files, files in ignored paths, and files that have
+		// no TODO marker in them.
+		if common.SkipFile(pass.Pass, file) || !common.HasMarker(pass.Pass, file, common.MarkerTodo) {
+			return
 		}
 
 		for _, commentGroup := range file.Comments {
 			for _, comment := range commentGroup.List {
 				if !matchTodo(comment) {
-					pass.Reportf(comment.Pos(),
+					r.Reportf(comment.Pos(),
 						"TODO comment must start the line, have a github username and / or a Jira ticket, and be followed by a colon and space: "+
 							"`TODO(<gh-user>)[<jira-ticket>]: `")
 				}
 			}
 		}
-	}
+	})
 
 	return nil, nil
 }
@@ -0,0 +1,79 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package tracing sets up the OpenTelemetry tracer provider lint runs emit spans to. The
+// exporter is configured through the standard OpenTelemetry environment variables, so
+// tracing is off unless OTEL_TRACES_EXPORTER is set.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceName is the service.name of the resource spans are emitted by, unless overridden
+// by OTEL_SERVICE_NAME or OTEL_RESOURCE_ATTRIBUTES.
+const ServiceName = "lintroller"
+
+// Exporters that can be selected with OTEL_TRACES_EXPORTER.
+const (
+	// ExporterNone disables tracing, the default.
+	ExporterNone = "none"
+
+	// ExporterOTLP exports spans with OTLP over gRPC, configured by the
+	// OTEL_EXPORTER_OTLP_* environment variables, e.g. OTEL_EXPORTER_OTLP_ENDPOINT.
+	ExporterOTLP = "otlp"
+)
+
+// EnvExporter is the environment variable the exporter is selected with.
+const EnvExporter = "OTEL_TRACES_EXPORTER"
+
+// Tracer returns the tracer lintroller emits spans with. Spans are dropped unless Init
+// set up an exporter.
+func Tracer() trace.Tracer {
+	return otel.Tracer("github.com/getoutreach/lintroller")
+}
+
+// Init sets up the global tracer provider with the exporter selected by
+// OTEL_TRACES_EXPORTER. The returned function flushes the spans that are yet to be
+// exported and needs to be called before the process exits.
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	switch exporter := os.Getenv(EnvExporter); exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+	default:
+		return nil, fmt.Errorf("%s \"%s\" is not one of \"%s\" or \"%s\"", EnvExporter, exporter, ExporterOTLP, ExporterNone)
+	}
+
+	exp, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "create otlp exporter")
+	}
+
+	// Attributes from the environment are detected last so that they take precedence.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", ServiceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create resource")
+	}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exp), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+
+	return func(ctx context.Context) error {
+		return errors.Wrap(tp.Shutdown(ctx), "shutdown tracer provider")
+	}, nil
+}
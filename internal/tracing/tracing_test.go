@@ -0,0 +1,47 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestInit(t *testing.T) {
+	tt := []struct {
+		name          string
+		exporter      string
+		expectedError string
+	}{
+		{
+			name:     "Disables tracing by default",
+			exporter: "",
+		},
+		{
+			name:     "Disables tracing",
+			exporter: ExporterNone,
+		},
+		{
+			name:          "Rejects unknown exporters",
+			exporter:      "zipkin",
+			expectedError: "OTEL_TRACES_EXPORTER \"zipkin\" is not one of \"otlp\" or \"none\"",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(EnvExporter, test.exporter)
+
+			shutdown, err := Init(context.Background())
+			if test.expectedError != "" {
+				assert.Error(t, err, test.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.NilError(t, shutdown(context.Background()))
+		})
+	}
+}
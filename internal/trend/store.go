@@ -0,0 +1,243 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the stores records are kept in, either a local
+// directory or an S3 prefix.
+
+package trend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// s3Scheme is the scheme of the locations of stores kept in S3.
+const s3Scheme = "s3://"
+
+// reID matches valid record IDs, which are used as file names.
+var reID = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Store keeps the records of runs.
+type Store interface {
+	// Put stores the given record, replacing any record with the same ID.
+	Put(r *Record) error
+
+	// Get returns the record with the given ID.
+	Get(id string) (*Record, error)
+
+	// IDs returns the IDs of every stored record.
+	IDs() ([]string, error)
+}
+
+// OpenStore returns the store at the given location, either "s3://<bucket>/<prefix>" for
+// a store kept in S3 using the aws CLI, or the path of a local directory.
+func OpenStore(location string) (Store, error) {
+	if location == "" {
+		return nil, errors.New("store location is empty")
+	}
+
+	if strings.HasPrefix(location, s3Scheme) {
+		bucket := strings.TrimPrefix(location, s3Scheme)
+		if bucket == "" || strings.HasPrefix(bucket, "/") {
+			return nil, fmt.Errorf("store location %q has no bucket", location)
+		}
+		return &s3Store{location: strings.TrimSuffix(location, "/"), command: aws}, nil
+	}
+
+	return &dirStore{dir: location}, nil
+}
+
+// ValidateID returns an error if the given record ID can't be stored.
+func ValidateID(id string) error {
+	if !reID.MatchString(id) || id == "." || id == ".." {
+		return fmt.Errorf("record ID %q must only contain letters, digits, dots, dashes, and underscores", id)
+	}
+
+	return nil
+}
+
+// Latest returns the stored records sorted from the most recent to the least recent, at
+// most n of them unless n is not positive.
+func Latest(s Store, n int) ([]*Record, error) {
+	ids, err := s.IDs()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, 0, len(ids))
+	for _, id := range ids {
+		r, err := s.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.After(records[j].Time)
+	})
+	if n > 0 && len(records) > n {
+		records = records[:n]
+	}
+
+	return records, nil
+}
+
+// decode decodes the record with the given ID from the given JSON.
+func decode(id string, data []byte) (*Record, error) {
+	var r Record
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, errors.Wrapf(err, "decode record %q", id)
+	}
+
+	return &r, nil
+}
+
+// dirStore is a Store keeping each record as a JSON file in a local directory.
+type dirStore struct {
+	dir string
+}
+
+// Put implements the Store interface.
+func (s *dirStore) Put(r *Record) error {
+	if err := ValidateID(r.ID); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "encode record %q", r.ID)
+	}
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return errors.Wrap(err, "create store directory")
+	}
+
+	return errors.Wrapf(os.WriteFile(filepath.Join(s.dir, r.ID+".json"), data, 0o600), "write record %q", r.ID)
+}
+
+// Get implements the Store interface.
+func (s *dirStore) Get(id string) (*Record, error) {
+	if err := ValidateID(id); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.dir, id+".json"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read record %q", id)
+	}
+
+	return decode(id, data)
+}
+
+// IDs implements the Store interface.
+func (s *dirStore) IDs() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "list store directory")
+	}
+
+	var ids []string
+	for _, e := range entries {
+		if id, ok := strings.CutSuffix(e.Name(), ".json"); ok && !e.IsDir() {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// s3Store is a Store keeping each record as a JSON object under a prefix in S3, accessed
+// with the aws CLI so that its credentials and configuration apply.
+type s3Store struct {
+	location string
+
+	// command runs the aws CLI with the given arguments and standard input, returning its
+	// standard output.
+	command func(stdin io.Reader, args ...string) ([]byte, error)
+}
+
+// Put implements the Store interface.
+func (s *s3Store) Put(r *Record) error {
+	if err := ValidateID(r.ID); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "encode record %q", r.ID)
+	}
+
+	_, err = s.command(bytes.NewReader(data), "s3", "cp", "-", s.location+"/"+r.ID+".json")
+	return errors.Wrapf(err, "upload record %q", r.ID)
+}
+
+// Get implements the Store interface.
+func (s *s3Store) Get(id string) (*Record, error) {
+	if err := ValidateID(id); err != nil {
+		return nil, err
+	}
+
+	data, err := s.command(nil, "s3", "cp", s.location+"/"+id+".json", "-")
+	if err != nil {
+		return nil, errors.Wrapf(err, "download record %q", id)
+	}
+
+	return decode(id, data)
+}
+
+// IDs implements the Store interface.
+func (s *s3Store) IDs() ([]string, error) {
+	out, err := s.command(nil, "s3", "ls", s.location+"/")
+	if err != nil {
+		// The aws CLI exits with 1 when listing a prefix without any objects.
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "list records")
+	}
+
+	// Each object is listed as "<date> <time> <size> <key>".
+	var ids []string
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			continue
+		}
+		if id, ok := strings.CutSuffix(fields[3], ".json"); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// aws runs the aws CLI with the given arguments and standard input, returning its standard
+// output. Its standard error is included in the returned error.
+func aws(stdin io.Reader, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("aws", args...)
+	cmd.Stdin = stdin
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "aws %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements the records of runs and their comparison.
+
+// Package trend implements the history of runs, so that CI can fail on regressions
+// compared to a baseline run, e.g. "no new doculint issues", rather than on absolute
+// totals. Each run is stored as a Record in a Store and two records are compared with
+// Compare.
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"github.com/pkg/errors"
+)
+
+// Record is the summary of a single run.
+type Record struct {
+	// ID identifies the run, e.g. the commit it was ran on.
+	ID string `json:"id"`
+
+	// Time is when the run was recorded.
+	Time time.Time `json:"time"`
+
+	// Issues maps rule IDs, e.g. "doculint/spelling", or linter names for linters without
+	// rules, to the number of issues, errors and warnings alike, reported under them.
+	Issues map[string]int `json:"issues"`
+
+	// Suppressed maps linter names to the number of their issues that were suppressed.
+	Suppressed map[string]int `json:"suppressed"`
+
+	// Score is the quality score of the repository, if scoring was enabled.
+	Score *float64 `json:"score,omitempty"`
+}
+
+// NewRecord returns the record of the run with the given ID, time, and summary. The score
+// report is nil unless scoring was enabled.
+func NewRecord(id string, t time.Time, summary *reporter.Summary, report *score.Report) *Record {
+	r := Record{
+		ID:         id,
+		Time:       t.UTC(),
+		Issues:     make(map[string]int),
+		Suppressed: make(map[string]int),
+	}
+
+	for i := range summary.Linters {
+		ls := &summary.Linters[i]
+
+		// Issues reported under a rule are counted under the rule, any others under the
+		// linter itself.
+		rest := ls.Errors + ls.Warnings + ls.Info
+		for rule, n := range ls.Rules {
+			r.Issues[ls.Linter+"/"+rule] = n
+			rest -= n
+		}
+		if rest > 0 {
+			r.Issues[ls.Linter] = rest
+		}
+
+		if ls.Suppressed > 0 {
+			r.Suppressed[ls.Linter] = ls.Suppressed
+		}
+	}
+
+	if report != nil {
+		s := report.Score
+		r.Score = &s
+	}
+
+	return &r
+}
+
+// Delta is the change of a single count between two records.
+type Delta struct {
+	// Key is the rule ID, or linter name, the count belongs to.
+	Key string `json:"key"`
+
+	// Baseline is the count in the baseline record.
+	Baseline int `json:"baseline"`
+
+	// Current is the count in the current record.
+	Current int `json:"current"`
+
+	// Change is Current minus Baseline.
+	Change int `json:"change"`
+}
+
+// Comparison is the difference between a baseline record and a current record.
+type Comparison struct {
+	// Baseline is the ID of the baseline record.
+	Baseline string `json:"baseline"`
+
+	// Current is the ID of the current record.
+	Current string `json:"current"`
+
+	// Issues contains the change of the issues of every rule that has issues in either
+	// record, sorted by rule ID.
+	Issues []Delta `json:"issues"`
+
+	// Suppressed contains the change of the suppressed issues of every linter that has
+	// suppressed issues in either record, sorted by linter name.
+	Suppressed []Delta `json:"suppressed"`
+
+	// ScoreChange is the change of the score, if both records have one.
+	ScoreChange *float64 `json:"scoreChange,omitempty"`
+
+	// Regressions contains the rule IDs, of the rules given to Compare, whose issues
+	// increased.
+	Regressions []string `json:"regressions"`
+}
+
+// Compare returns the difference between the given baseline and current records. An
+// increase of the issues of any of the given rule IDs, linter names covering every rule
+// of the linter, or "*" covering every rule, is a regression.
+func Compare(baseline, current *Record, failOn []string) *Comparison {
+	c := Comparison{
+		Baseline:    baseline.ID,
+		Current:     current.ID,
+		Issues:      deltas(baseline.Issues, current.Issues),
+		Suppressed:  deltas(baseline.Suppressed, current.Suppressed),
+		Regressions: []string{},
+	}
+
+	if baseline.Score != nil && current.Score != nil {
+		change := *current.Score - *baseline.Score
+		c.ScoreChange = &change
+	}
+
+	for i := range c.Issues {
+		if c.Issues[i].Change > 0 && matchesAny(c.Issues[i].Key, failOn) {
+			c.Regressions = append(c.Regressions, c.Issues[i].Key)
+		}
+	}
+
+	return &c
+}
+
+// deltas returns the change of every key present in either of the given counts, sorted by
+// key.
+func deltas(baseline, current map[string]int) []Delta {
+	keys := make(map[string]struct{}, len(baseline)+len(current))
+	for k := range baseline {
+		keys[k] = struct{}{}
+	}
+	for k := range current {
+		keys[k] = struct{}{}
+	}
+
+	d := make([]Delta, 0, len(keys))
+	for k := range keys {
+		d = append(d, Delta{Key: k, Baseline: baseline[k], Current: current[k], Change: current[k] - baseline[k]})
+	}
+	sort.Slice(d, func(i, j int) bool {
+		return d[i].Key < d[j].Key
+	})
+
+	return d
+}
+
+// matchesAny reports whether or not the given rule ID is any of the given rule IDs, is a
+// rule of any of the given linters, or any of them is "*".
+func matchesAny(key string, patterns []string) bool {
+	linter, _, _ := strings.Cut(key, "/")
+	for _, p := range patterns {
+		if p = strings.TrimSpace(p); p == "*" || p == key || p == linter {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Write writes the comparison to w in the given format, either config.ReportFormatJSON or
+// config.ReportFormatText.
+func (c *Comparison) Write(w io.Writer, format string) error {
+	switch format {
+	case config.ReportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return errors.Wrap(enc.Encode(c), "encode comparison")
+	case config.ReportFormatText:
+	default:
+		return fmt.Errorf("format %q is not one of %q or %q", format, config.ReportFormatJSON, config.ReportFormatText)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "comparing %s to baseline %s\n\n", c.Current, c.Baseline)
+
+	fmt.Fprintln(tw, "RULE\tBASELINE\tCURRENT\tCHANGE")
+	for i := range c.Issues {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%+d\n", c.Issues[i].Key, c.Issues[i].Baseline, c.Issues[i].Current, c.Issues[i].Change)
+	}
+
+	if len(c.Suppressed) > 0 {
+		fmt.Fprintln(tw, "\nSUPPRESSED\tBASELINE\tCURRENT\tCHANGE")
+		for i := range c.Suppressed {
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%+d\n", c.Suppressed[i].Key, c.Suppressed[i].Baseline, c.Suppressed[i].Current,
+				c.Suppressed[i].Change)
+		}
+	}
+
+	if c.ScoreChange != nil {
+		fmt.Fprintf(tw, "\nscore change\t%+.1f\n", *c.ScoreChange)
+	}
+
+	if len(c.Regressions) > 0 {
+		fmt.Fprintf(tw, "\nregressions: %s\n", strings.Join(c.Regressions, ", "))
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package trend
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getoutreach/lintroller/internal/config"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"github.com/getoutreach/lintroller/internal/score"
+	"gotest.tools/v3/assert"
+)
+
+func TestNewRecord(t *testing.T) {
+	summary := reporter.Summary{
+		Linters: []reporter.LinterSummary{
+			{Linter: "doculint", Errors: 4, Warnings: 1, Rules: map[string]int{"spelling": 2, "funlen": 1}},
+			{Linter: "todo", Warnings: 2, Suppressed: 3},
+			{Linter: "why", Suppressed: 1},
+		},
+	}
+
+	r := NewRecord("abc123", time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), &summary, &score.Report{Score: 87.5})
+
+	score := 87.5
+	assert.DeepEqual(t, r, &Record{
+		ID:         "abc123",
+		Time:       time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+		Issues:     map[string]int{"doculint": 2, "doculint/spelling": 2, "doculint/funlen": 1, "todo": 2},
+		Suppressed: map[string]int{"todo": 3, "why": 1},
+		Score:      &score,
+	})
+}
+
+func TestCompare(t *testing.T) {
+	baselineScore, currentScore := 80.0, 75.5
+	baseline := Record{
+		ID:         "base",
+		Issues:     map[string]int{"doculint/spelling": 2, "todo": 5},
+		Suppressed: map[string]int{"todo": 1},
+		Score:      &baselineScore,
+	}
+	current := Record{
+		ID:         "head",
+		Issues:     map[string]int{"doculint/spelling": 3, "errorlint": 1, "todo": 4},
+		Suppressed: map[string]int{"todo": 2},
+		Score:      &currentScore,
+	}
+
+	tt := []struct {
+		name        string
+		failOn      []string
+		regressions []string
+	}{
+		{name: "No rules fail", regressions: []string{}},
+		{name: "Linters cover their rules", failOn: []string{"doculint", "todo"}, regressions: []string{"doculint/spelling"}},
+		{name: "Wildcards cover every rule", failOn: []string{"*"}, regressions: []string{"doculint/spelling", "errorlint"}},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			c := Compare(&baseline, &current, test.failOn)
+			assert.DeepEqual(t, c.Regressions, test.regressions)
+		})
+	}
+
+	c := Compare(&baseline, &current, []string{"errorlint"})
+	assert.DeepEqual(t, c.Issues, []Delta{
+		{Key: "doculint/spelling", Baseline: 2, Current: 3, Change: 1},
+		{Key: "errorlint", Baseline: 0, Current: 1, Change: 1},
+		{Key: "todo", Baseline: 5, Current: 4, Change: -1},
+	})
+	assert.DeepEqual(t, c.Suppressed, []Delta{{Key: "todo", Baseline: 1, Current: 2, Change: 1}})
+	assert.Equal(t, *c.ScoreChange, -4.5)
+
+	var buf bytes.Buffer
+	assert.NilError(t, c.Write(&buf, config.ReportFormatText))
+	assert.Equal(t, buf.String(), `comparing head to baseline base
+
+RULE               BASELINE  CURRENT  CHANGE
+doculint/spelling  2         3        +1
+errorlint          0         1        +1
+todo               5         4        -1
+
+SUPPRESSED  BASELINE  CURRENT  CHANGE
+todo        1         2        +1
+
+score change  -4.5
+
+regressions: errorlint
+`)
+}
+
+func TestDirStore(t *testing.T) {
+	s, err := OpenStore(t.TempDir() + "/trend")
+	assert.NilError(t, err)
+
+	ids, err := s.IDs()
+	assert.NilError(t, err)
+	assert.Equal(t, len(ids), 0)
+
+	older := Record{ID: "older", Time: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), Issues: map[string]int{"todo": 1}}
+	newer := Record{ID: "newer", Time: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), Issues: map[string]int{"todo": 2}}
+	assert.NilError(t, s.Put(&older))
+	assert.NilError(t, s.Put(&newer))
+
+	latest, err := Latest(s, 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, latest, []*Record{&newer, &older})
+
+	assert.ErrorContains(t, s.Put(&Record{ID: "../escape"}), "must only contain letters")
+}
+
+func TestS3Store(t *testing.T) {
+	objects := make(map[string][]byte)
+	s := &s3Store{
+		location: "s3://bucket/trend",
+		command: func(stdin io.Reader, args ...string) ([]byte, error) {
+			switch {
+			case args[1] == "cp" && args[2] == "-":
+				data, err := io.ReadAll(stdin)
+				objects[args[3]] = data
+				return nil, err
+			case args[1] == "cp":
+				return objects[args[2]], nil
+			default:
+				var out strings.Builder
+				for key := range objects {
+					out.WriteString("2026-10-16 12:00:00        100 " + strings.TrimPrefix(key, "s3://bucket/trend/") + "\n")
+				}
+				return []byte(out.String()), nil
+			}
+		},
+	}
+
+	r := Record{ID: "abc123", Time: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), Issues: map[string]int{"todo": 1}}
+	assert.NilError(t, s.Put(&r))
+
+	ids, err := s.IDs()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ids, []string{"abc123"})
+
+	got, err := s.Get("abc123")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &r)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: See package comment for this one file package.
+
+// Package upload implements uploading the results of a lint run to a central service, e.g.
+// an inventory of the lint results of every repository, rather than each team scraping
+// them from the logs of their CI jobs. The results are posted as JSON along with the
+// repository, the commit, and the tier they were linted at.
+package upload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxErrorBody is the number of bytes of the body of a rejected upload included in the
+// error it results in.
+const maxErrorBody = 512
+
+// Result is the document uploaded for a lint run.
+type Result struct {
+	// Repository is the repository that was linted, e.g. getoutreach/lintroller.
+	Repository string `json:"repository"`
+
+	// Commit is the SHA of the commit that was linted.
+	Commit string `json:"commit"`
+
+	// Tier is the tier the repository was linted at, if it has one.
+	Tier string `json:"tier,omitempty"`
+
+	// ExitCode is the exit code of the run, zero if it passed.
+	ExitCode int `json:"exitCode"`
+
+	// Results are the results of the run as written by the json format, with the summary
+	// and the scores of the run when they're enabled.
+	Results json.RawMessage `json:"results"`
+}
+
+// Send posts the given result as JSON to the given URL with the given HTTP client,
+// authenticated by the given bearer token unless it is empty. Responses with a status
+// other than 2xx are errors.
+func Send(ctx context.Context, client *http.Client, url, token string, r *Result) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "marshal result")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "lintroller")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "post result")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		// The start of the body usually says why the result was rejected.
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("post result: %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
+
+// Repository returns the repository being linted, in the form of <owner>/<name>, from the
+// environment variables of the CI system running lintroller, falling back to the URL of
+// the origin remote of the git repository in the working directory. It returns an empty
+// string if neither is available.
+func Repository() string {
+	if repo := os.Getenv("GITHUB_REPOSITORY"); repo != "" {
+		return repo
+	}
+
+	if owner, name := os.Getenv("CIRCLE_PROJECT_USERNAME"), os.Getenv("CIRCLE_PROJECT_REPONAME"); owner != "" && name != "" {
+		return owner + "/" + name
+	}
+
+	if repo := os.Getenv("CI_PROJECT_PATH"); repo != "" {
+		return repo
+	}
+
+	remote, err := exec.Command("git", "remote", "get-url", "origin").Output()
+	if err != nil {
+		return ""
+	}
+
+	return repositoryFromRemote(strings.TrimSpace(string(remote)))
+}
+
+// repositoryFromRemote returns the <owner>/<name> path of the given git remote URL, e.g.
+// getoutreach/lintroller for git@github.com:getoutreach/lintroller.git.
+func repositoryFromRemote(remote string) string {
+	remote = strings.TrimSuffix(remote, ".git")
+
+	// Both scp-like remotes, e.g. git@github.com:owner/name, and URLs, e.g.
+	// https://github.com/owner/name, end in the path after the host.
+	if i := strings.Index(remote, "://"); i >= 0 {
+		remote = remote[i+len("://"):]
+		if j := strings.Index(remote, "/"); j >= 0 {
+			return remote[j+1:]
+		}
+		return ""
+	}
+
+	if i := strings.Index(remote, ":"); i >= 0 {
+		return remote[i+1:]
+	}
+
+	return ""
+}
+
+// Commit returns the SHA of the commit being linted, from the environment variables of the
+// CI system running lintroller, falling back to the HEAD of the git repository in the
+// working directory. It returns an empty string if neither is available.
+func Commit() string {
+	for _, env := range []string{"GITHUB_SHA", "CIRCLE_SHA1", "CI_COMMIT_SHA"} {
+		if sha := os.Getenv(env); sha != "" {
+			return sha
+		}
+	}
+
+	head, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(head))
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSend(t *testing.T) {
+	result := Result{
+		Repository: "getoutreach/lintroller",
+		Commit:     "0123abc",
+		Tier:       "gold",
+		ExitCode:   1,
+		Results:    json.RawMessage(`[{"file":"foo.go","line":1,"column":1}]`),
+	}
+
+	tt := []struct {
+		name          string
+		token         string
+		status        int
+		expectedAuth  string
+		expectedError string
+	}{
+		{
+			name:         "Posts the result with the token",
+			token:        "secret",
+			status:       http.StatusAccepted,
+			expectedAuth: "Bearer secret",
+		},
+		{
+			name:   "Posts the result without a token",
+			status: http.StatusOK,
+		},
+		{
+			name:          "Fails on rejected results",
+			token:         "wrong",
+			status:        http.StatusUnauthorized,
+			expectedAuth:  "Bearer wrong",
+			expectedError: "post result: 401 Unauthorized: bad token",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			var received Result
+			var auth string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.Method, http.MethodPost)
+				assert.Equal(t, r.Header.Get("Content-Type"), "application/json")
+				auth = r.Header.Get("Authorization")
+
+				body, err := io.ReadAll(r.Body)
+				assert.NilError(t, err)
+				assert.NilError(t, json.Unmarshal(body, &received))
+
+				w.WriteHeader(test.status)
+				if test.status == http.StatusUnauthorized {
+					_, _ = w.Write([]byte("bad token\n"))
+				}
+			}))
+			defer srv.Close()
+
+			err := Send(context.Background(), srv.Client(), srv.URL, test.token, &result)
+			if test.expectedError != "" {
+				assert.Error(t, err, test.expectedError)
+			} else {
+				assert.NilError(t, err)
+			}
+
+			assert.Equal(t, auth, test.expectedAuth)
+			assert.DeepEqual(t, received, result)
+		})
+	}
+}
+
+func TestRepositoryFromRemote(t *testing.T) {
+	tt := []struct {
+		remote   string
+		expected string
+	}{
+		{remote: "git@github.com:getoutreach/lintroller.git", expected: "getoutreach/lintroller"},
+		{remote: "https://github.com/getoutreach/lintroller.git", expected: "getoutreach/lintroller"},
+		{remote: "https://github.com/getoutreach/lintroller", expected: "getoutreach/lintroller"},
+		{remote: "ssh://git@gitlab.example.com/group/sub/repo.git", expected: "group/sub/repo"},
+		{remote: "lintroller", expected: ""},
+	}
+
+	for _, test := range tt {
+		t.Run(test.remote, func(t *testing.T) {
+			assert.Equal(t, repositoryFromRemote(test.remote), test.expected)
+		})
+	}
+}
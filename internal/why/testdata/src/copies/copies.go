@@ -0,0 +1,17 @@
+// Package copies has nolint directives copied throughout it.
+package copies
+
+//nolint:magicnumber,dupstring // Why: Legacy values. // want `nolint directive for dupstring,magicnumber with the same reason is one of 3 copies in package "copies", more than 2`
+var a = 1
+
+//nolint:dupstring,magicnumber // Why: Legacy values. // want `nolint directive for dupstring,magicnumber with the same reason is one of 3 copies in package "copies", more than 2`
+var b = 2
+
+//nolint:magicnumber,dupstring // Why: Legacy values. // want `nolint directive for dupstring,magicnumber with the same reason is one of 3 copies in package "copies", more than 2`
+var c = 3
+
+//nolint:magicnumber // Why: Legacy values.
+var d = 4
+
+//nolint:doculint // Why: Platform specific.
+var e = 5
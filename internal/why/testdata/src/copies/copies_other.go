@@ -0,0 +1,6 @@
+//go:build !windows
+
+package copies
+
+//nolint:doculint // Why: Platform specific.
+func open() {}
@@ -0,0 +1,18 @@
+// Package why has nolint directives with and without reasons.
+package why
+
+//nolint:magicnumber // Why: The answer.
+var answer = 42
+
+//nolint:magicnumber // want "nolint comment must immediately be followed by"
+var question = 6 * 9
+
+//nolint-end // want "nolint-end directive doesn't close any nolint-start directive"
+
+//nolint-start:magicnumber // Why: Legacy values.
+var legacy = []int{1, 2, 3}
+
+//nolint-end
+
+//nolint-start:magicnumber // Why: Legacy values. // want "nolint-start directive must be closed by a nolint-end directive"
+var unclosed = 4
@@ -8,8 +8,14 @@
 package why
 
 import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/getoutreach/lintroller/internal/common"
 	"github.com/getoutreach/lintroller/internal/reporter"
@@ -24,13 +30,36 @@ const doc = `Ensures that each nolint comment also has a // Why: <reason> immedi
 
 A valid example is the following:
 
-	func foo() { //nolint:doculint // Why: This comment doesn't need a function for some reason.`
+	func foo() { //nolint:doculint // Why: This comment doesn't need a function for some reason.
+
+With -maxCopies set, nolint directives for the same linters with the same reason may only be
+copied that many times within a package.`
 
 // Analyzer exports the why analyzer (linter).
 var Analyzer = analysis.Analyzer{
-	Name: name,
-	Doc:  doc,
-	Run:  why,
+	Name:     name,
+	Doc:      doc,
+	Run:      why,
+	Requires: []*analysis.Analyzer{&reporter.NoLintAnalyzer, &common.FilesAnalyzer},
+}
+
+// NewAnalyzerWithOptions returns the Analyzer package-level variable, with the options
+// that would have been defined via flags if this was ran as a vet tool. This is so the
+// analyzers can be ran outside of the context of a vet tool and config can be gathered
+// from elsewhere.
+func NewAnalyzerWithOptions(_maxCopies int) *analysis.Analyzer {
+	maxCopies = _maxCopies
+	return &Analyzer
+}
+
+// maxCopies is a variable that gets collected via flags. This variable contains the number
+// of times a nolint directive for the same linters with the same reason may be copied
+// within a package, zero meaning any.
+var maxCopies int
+
+func init() { //nolint:gochecknoinits // Why: This is necessary to grab flags.
+	Analyzer.Flags.IntVar(&maxCopies, "maxCopies", 0,
+		"the number of times a nolint directive for the same linters with the same reason may be copied within a package, 0 allows any")
 }
 
 // whyPattern is a regular expression fragment that matches just a "Why"
@@ -39,46 +68,187 @@ const whyPattern = `//\s?Why:.+`
 
 // reNoLintWhy is the regular expression that every nolint comment must match, which
 // ensures it contains a // Why: <reason> immediately proceeding the nolint directive.
-var reNoLintWhy = regexp.MustCompile(`^nolint(?::\s?[\w\-,]+)?\s+` + whyPattern + `$`)
+var reNoLintWhy = regexp.MustCompile(`^nolint(?:-start)?(?::\s?[\w\-,/*]+)?\s+` + whyPattern + `$`)
 
 // reNoLintNaked is the regular expression that every nolint comment is checked against
 // to ensure no naked nolint directives exist. This matches `nolint` comments
 // without a directive and with an optional Why comment.
-var reNoLintNaked = regexp.MustCompile(`^nolint\s*(?:` + whyPattern + `)?$`)
+var reNoLintNaked = regexp.MustCompile(`^nolint(?:-start)?\s*(?:` + whyPattern + `)?$`)
 
 // why is the function that gets passed to the Analyzer which runs the actual analysis
 // for the why linter on a set of files.
 func why(_pass *analysis.Pass) (interface{}, error) {
 	// Ignore test packages.
-	if common.IsTestPackage(_pass) {
+	if common.SkipPackage(_pass) {
 		return nil, nil
 	}
 
 	// Wrap _pass with reporter.Pass to take nolint directives into account.
 	pass := reporter.NewPass(name, _pass)
 
-	for _, file := range pass.Files {
-		// Ignore generated files and test files.
-		if common.IsGenerated(file) || common.IsTestFile(pass.Pass, file) {
-			continue
+	// copies contains the copies of each nolint directive of the package, by the linters it
+	// applies to and its reason, guarded by mu since files are processed concurrently.
+	copies := make(map[string][]directiveCopy)
+	var mu sync.Mutex
+
+	pass.ForEachFile(common.FilesWithExcluded(pass.Pass), func(r *reporter.Buffer, file *ast.File) {
+		// Ignore generated files, test files, files in ignored paths, and files without any
+		// directive to suppress linters in them.
+		if common.SkipFile(pass.Pass, file) || !common.HasMarker(pass.Pass, file, common.MarkerNoLint) {
+			return
 		}
 
+		// open contains the positions of the nolint-start directives that haven't been
+		// closed by a nolint-end directive yet, innermost last.
+		var open []token.Pos
+
 		for _, commentGroup := range file.Comments {
 			for _, comment := range commentGroup.List {
 				text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+				if !strings.HasPrefix(text, "nolint") {
+					continue
+				}
 
-				if strings.HasPrefix(text, "nolint") {
-					if reNoLintNaked.MatchString(text) {
-						pass.Reportf(comment.Pos(), "nolint directive must contain the specific linters it is nolinting against")
-					}
+				directive, ok := reporter.ParseDirective(comment.Text)
+
+				// Ends don't need a reason, the start they close gives it.
+				switch {
+				case directive.End && len(open) == 0:
+					r.Reportf(comment.Pos(), "nolint-end directive doesn't close any nolint-start directive")
+					continue
+				case directive.End:
+					open = open[:len(open)-1]
+					continue
+				case directive.Start:
+					open = append(open, comment.Pos())
+				}
 
-					if !reNoLintWhy.MatchString(text) {
-						pass.Reportf(comment.Pos(), "nolint comment must immediately be followed by // Why: <reason> on the same line.")
-					}
+				if reNoLintNaked.MatchString(text) {
+					r.Reportf(comment.Pos(), "nolint directive must contain the specific linters it is nolinting against")
+				}
+
+				if !reNoLintWhy.MatchString(text) {
+					r.Reportf(comment.Pos(), "nolint comment must immediately be followed by // Why: <reason> on the same line.")
+				}
+
+				if ok && !directive.Naked && directive.Reason != "" {
+					key, c := copyKey(directive), newDirectiveCopy(pass.Pass, file, comment)
+
+					mu.Lock()
+					copies[key] = append(copies[key], c)
+					mu.Unlock()
 				}
 			}
 		}
+
+		for _, pos := range open {
+			r.Reportf(pos, "nolint-start directive must be closed by a nolint-end directive")
+		}
+	})
+
+	if maxCopies > 0 {
+		reportCopies(pass, copies)
 	}
 
 	return nil, nil
 }
+
+// directiveCopy is a single copy of a nolint directive.
+type directiveCopy struct {
+	// pos is the position of the directive.
+	pos token.Pos
+
+	// id identifies the copy. Copies with the same id count once, see newDirectiveCopy.
+	id string
+}
+
+// newDirectiveCopy returns the given nolint directive comment of the given file as a copy
+// of it. A copy is identified by its position, unless its file has a //go:build constraint:
+// files only built for some configurations, e.g. foo_linux.go and foo_darwin.go, often
+// hold alternative implementations of the same declaration, so copies within declarations
+// of the same name in them are one and the same.
+func newDirectiveCopy(pass *analysis.Pass, file *ast.File, comment *ast.Comment) directiveCopy {
+	c := directiveCopy{pos: comment.Pos(), id: pass.Fset.PositionFor(comment.Pos(), false).String()}
+
+	if common.ClassifyFile(pass, file).Constrained {
+		if decl := enclosingDeclName(file, comment.Pos()); decl != "" {
+			c.id = decl
+		}
+	}
+
+	return c
+}
+
+// copyKey returns the key the copies of the given directive are grouped by: the linters it
+// applies to, in any order, and its reason.
+func copyKey(directive reporter.Directive) string {
+	linters := append([]string(nil), directive.Linters...)
+	sort.Strings(linters)
+
+	return strings.Join(linters, ",") + " " + strings.TrimSpace(directive.Reason)
+}
+
+// reportCopies reports every copy of each nolint directive that is copied more than
+// maxCopies times, counting the copies with the same id once, see newDirectiveCopy.
+func reportCopies(pass *reporter.Pass, copies map[string][]directiveCopy) {
+	keys := make([]string, 0, len(copies))
+	for key := range copies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		// The copies of each file are collected concurrently, see Pass.ForEachFile, so they
+		// are put back in the order they appear in.
+		sort.Slice(copies[key], func(i, j int) bool { return copies[key][i].pos < copies[key][j].pos })
+
+		ids := make(map[string]bool)
+		for _, c := range copies[key] {
+			ids[c.id] = true
+		}
+
+		if len(ids) <= maxCopies {
+			continue
+		}
+
+		linters, _, _ := strings.Cut(key, " ")
+		for _, c := range copies[key] {
+			pass.Reportf(c.pos,
+				"nolint directive for %s with the same reason is one of %d copies in package \"%s\", more than %d: "+
+					"fix the underlying issues or cover them with a single //nolint-start range",
+				linters, len(ids), pass.Pkg.Name(), maxCopies)
+		}
+	}
+}
+
+// enclosingDeclName returns the name of the top-level declaration of the given file that
+// contains, or follows, the given position, qualified by the receiver type for methods, or
+// an empty string if there is none.
+func enclosingDeclName(file *ast.File, pos token.Pos) string {
+	for _, decl := range file.Decls {
+		if decl.End() < pos {
+			continue
+		}
+
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv != nil && len(decl.Recv.List) > 0 {
+				return fmt.Sprintf("(%s).%s", types.ExprString(decl.Recv.List[0].Type), decl.Name.Name)
+			}
+			return decl.Name.Name
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					return spec.Name.Name
+				case *ast.ValueSpec:
+					return spec.Names[0].Name
+				}
+			}
+		}
+
+		return ""
+	}
+
+	return ""
+}
@@ -5,9 +5,26 @@ package why
 import (
 	"testing"
 
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/linttest"
 	"gotest.tools/v3/assert"
 )
 
+func TestAnalyzer(t *testing.T) {
+	linttest.Run(t, &Analyzer, "why")
+}
+
+func TestAnalyzerCopies(t *testing.T) {
+	NewAnalyzerWithOptions(2)
+	defer NewAnalyzerWithOptions(0)
+
+	// Copies in the files excluded by their build constraints count as well.
+	common.SetLintExcluded(true)
+	defer common.SetLintExcluded(false)
+
+	linttest.Run(t, &Analyzer, "copies")
+}
+
 func TestMatchNoLintWhy(t *testing.T) {
 	tt := []struct {
 		name     string
@@ -44,6 +61,21 @@ func TestMatchNoLintWhy(t *testing.T) {
 			text:     "nolint:errcheck // Don't like this.",
 			expected: false,
 		},
+		{
+			name:     "Matches wildcard and rule targets",
+			text:     "nolint:doculint/*,errorlint/span-name,lintroller // Why: Generated names.",
+			expected: true,
+		},
+		{
+			name:     "Matches a well-formed nolint-start",
+			text:     "nolint-start:magicnumber // Why: Legacy table.",
+			expected: true,
+		},
+		{
+			name:     "Does not match a nolint-start without a Why",
+			text:     "nolint-start:magicnumber",
+			expected: false,
+		},
 		{
 			name:     "Matches when there is a Why on a naked nolint",
 			text:     "nolint // Why: No one should do this.",
@@ -74,6 +106,11 @@ func TestMatchNoLintNaked(t *testing.T) {
 			text:     "nolint // Why: We're unit testing.",
 			expected: true,
 		},
+		{
+			name:     "Matches a naked nolint-start",
+			text:     "nolint-start // Why: We're unit testing.",
+			expected: true,
+		},
 		{
 			name:     "Does not match a well-formed nolint",
 			text:     "nolint: errcheck // Why: We're unit testing.",
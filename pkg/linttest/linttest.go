@@ -0,0 +1,126 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+// Description: This file implements running analyzers over golden fixtures the way
+// lintroller runs them.
+
+// Package linttest runs analyzers over fixture packages and checks the issues they report,
+// and the fixes they suggest, against expectations written in the fixtures, the same way
+// lintroller runs its own linters in its tests. It is meant for teams extending lintroller,
+// e.g. with their own analyzers or configuration, who want to validate its behavior without
+// writing the boilerplate of a driver.
+//
+// # Usage
+//
+// Fixtures live in GOPATH layout beneath a directory, usually testdata: each package in
+// its own directory beneath src, e.g. testdata/src/foo. Every issue the analyzer reports
+// must be expected by a "// want" comment on its line, holding a regular expression that
+// matches its message, and every "// want" comment must be met:
+//
+//	package foo // want `package "foo" has no comment associated with it`
+//
+// Suggested fixes are checked against golden files: when the fixtures contain any file
+// ending in .golden, the fixes of every issue reported in foo.go are applied and the
+// result compared to foo.go.golden, see analysistest.RunWithSuggestedFixes.
+//
+//	func TestAnalyzer(t *testing.T) {
+//		linttest.Run(t, &doculint.Analyzer, "foo")
+//	}
+package linttest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"github.com/getoutreach/lintroller/internal/reporter"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// IgnoredFile is the name of the fixture files that are treated as being in an ignored
+// path. Issues in them are never reported, so they can't have "// want" comments.
+const IgnoredFile = "ignored.go"
+
+// Testing is what failures are reported to, e.g. a *testing.T.
+type Testing = analysistest.Testing
+
+// Run runs the given analyzer over the fixture packages matching the given patterns within
+// the testdata directory of the calling test's package, see RunDir.
+func Run(t *testing.T, a *analysis.Analyzer, patterns ...string) {
+	t.Helper()
+
+	RunDir(t, analysistest.TestData(), a, patterns...)
+}
+
+// RunDir runs the given analyzer over the fixture packages matching the given patterns
+// within the src directory of the given directory, and reports every issue that isn't
+// expected by a "// want" comment, every "// want" comment that isn't met, and, when the
+// fixtures have golden files, every fix that doesn't produce its golden file to t.
+//
+// Issues go through the same filtering as when lintroller runs the analyzer, so nolint
+// directives are honored, test packages are skipped, and files named IgnoredFile are in an
+// ignored path. Test files in fixtures belong to external test packages: test files within
+// a package have it analyzed a second time, and issues are only reported the first time
+// around. Paths are matched relative to the src directory, which is the working directory
+// while the analyzer runs, so that fixtures within testdata aren't ignored like testdata
+// is otherwise. Since the working directory is process-wide, RunDir can't run in parallel.
+func RunDir(t Testing, dir string, a *analysis.Analyzer, patterns ...string) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Errorf("get the working directory: %v", err)
+		return
+	}
+
+	// The fixtures are loaded from within src, so a relative directory would no longer
+	// point at them.
+	if dir, err = filepath.Abs(dir); err != nil {
+		t.Errorf("resolve the fixtures: %v", err)
+		return
+	}
+
+	src := filepath.Join(dir, "src")
+	if err := os.Chdir(src); err != nil {
+		t.Errorf("change into the fixtures: %v", err)
+		return
+	}
+	common.SetIgnoredPaths([]string{"**/" + IgnoredFile})
+	reporter.SetDedupe(reporter.NewDedupe())
+
+	defer func() {
+		common.SetIgnoredPaths(nil)
+		reporter.SetDedupe(nil)
+		if err := os.Chdir(wd); err != nil {
+			t.Errorf("change back into the working directory: %v", err)
+		}
+	}()
+
+	if hasGoldenFiles(src) {
+		analysistest.RunWithSuggestedFixes(t, dir, a, patterns...)
+		return
+	}
+
+	analysistest.Run(t, dir, a, patterns...)
+}
+
+// hasGoldenFiles reports whether or not any file beneath the given directory is a golden
+// file, i.e. ends in .golden.
+func hasGoldenFiles(dir string) bool {
+	var found bool
+
+	// The walk never fails, unreadable directories just hold no golden files.
+	_ = filepath.WalkDir(dir, func(path string, _ os.DirEntry, err error) error {
+		if err == nil && strings.HasSuffix(path, ".golden") {
+			found = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+
+	return found
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Outreach Corporation. All Rights Reserved.
+
+package linttest
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+	"testing"
+
+	"github.com/getoutreach/lintroller/internal/common"
+	"golang.org/x/tools/go/analysis"
+	"gotest.tools/v3/assert"
+)
+
+// renamer reports every function named Bad, outside of ignored files, along with a fix
+// that renames it to Good.
+var renamer = &analysis.Analyzer{
+	Name: "renamer",
+	Doc:  "reports functions named Bad",
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		for _, file := range pass.Files {
+			if common.SkipFile(pass, file) {
+				continue
+			}
+
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Name.Name != "Bad" {
+					continue
+				}
+
+				pass.Report(analysis.Diagnostic{
+					Pos:     fn.Name.Pos(),
+					Message: fmt.Sprintf("function %q should be named %q", fn.Name.Name, "Good"),
+					SuggestedFixes: []analysis.SuggestedFix{{
+						Message:   "Rename to Good",
+						TextEdits: []analysis.TextEdit{{Pos: fn.Name.Pos(), End: fn.Name.End(), NewText: []byte("Good")}},
+					}},
+				})
+			}
+		}
+
+		return nil, nil
+	},
+}
+
+// recorder records the failures reported to it.
+type recorder struct {
+	failures []string
+}
+
+// Errorf implements the Testing interface.
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestRun(t *testing.T) {
+	Run(t, renamer, "fixture")
+}
+
+func TestRunDirReportsFailures(t *testing.T) {
+	var r recorder
+	RunDir(&r, "does-not-exist", renamer, "fixture")
+	assert.Equal(t, len(r.failures), 1)
+	assert.Assert(t, strings.HasPrefix(r.failures[0], "change into the fixtures"), r.failures[0])
+}
@@ -0,0 +1,5 @@
+// Package fixture has a function named Bad.
+package fixture
+
+// Bad is renamed by the fix.
+func Bad() {} // want `function "Bad" should be named "Good"`